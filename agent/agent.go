@@ -281,7 +281,17 @@ func (a *Agent) run(ctx context.Context) {
 			// TODO(stevvooe): This may actually block if a session is closed
 			// but no error was sent. Session.close must only be called here
 			// for this to work.
-			if err != nil {
+			switch err {
+			case nil:
+			case errSessionDisconnect:
+				// the manager asked us to go elsewhere, so there's no
+				// need to back off before reconnecting
+				log.G(ctx).Info("agent: session disconnected by manager")
+			case errNodeExpired:
+				// the manager marked us down, so register again right
+				// away to keep our tasks
+				log.G(ctx).Info("agent: node expired, re-registering")
+			default:
 				log.G(ctx).WithError(err).Error("agent: session failed")
 				backoff = initialSessionFailureBackoff + 2*backoff
 				if backoff > maxSessionFailureBackoff {
@@ -368,6 +378,14 @@ func (a *Agent) handleSessionMessage(ctx context.Context, message *api.SessionMe
 				log.G(ctx).WithError(err).Error("node configure failed")
 			}
 		}
+
+		if message.RenewCertificate && a.config.NotifyNodeChange != nil {
+			// the certificate is renewed the same way as when the CA
+			// asks for it to be rotated
+			node := message.Node.Copy()
+			node.Certificate.Status.State = api.IssuanceStateRotate
+			a.config.NotifyNodeChange <- node
+		}
 	}
 
 	// prune managers not in list.
@@ -1,6 +1,8 @@
 package agent
 
 import (
+	"time"
+
 	"github.com/boltdb/bolt"
 	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/api"
@@ -29,6 +31,27 @@ type Config struct {
 
 	// Credentials is credentials for grpc connection to manager.
 	Credentials credentials.TransportCredentials
+
+	// AdvertiseAddr is the IP address other nodes should use to reach this
+	// node. If it is empty, managers record the address the agent connects
+	// from.
+	AdvertiseAddr string
+
+	// MinHeartbeatPeriod and MaxHeartbeatPeriod, if MaxHeartbeatPeriod is
+	// set, are the range of heartbeat periods the agent supports, so that
+	// managers may use longer periods than their default.
+	MinHeartbeatPeriod time.Duration
+	MaxHeartbeatPeriod time.Duration
+
+	// Version is the agent's version, as MAJOR.MINOR.PATCH, reported to the
+	// managers, which may refuse agents older than they support.
+	Version string
+
+	// Metadata is inventory information about the node, such as its
+	// container runtime, OS, kernel and engine versions, which is reported
+	// to the managers when the agent registers and recorded on the node
+	// object.
+	Metadata map[string]string
 }
 
 func (c *Config) validate() error {
@@ -44,5 +67,10 @@ func (c *Config) validate() error {
 		return errors.New("agent: database required")
 	}
 
+	if c.MinHeartbeatPeriod < 0 || c.MaxHeartbeatPeriod < 0 ||
+		(c.MaxHeartbeatPeriod > 0 && c.MinHeartbeatPeriod > c.MaxHeartbeatPeriod) {
+		return errors.New("agent: invalid heartbeat period range")
+	}
+
 	return nil
 }
@@ -9,6 +9,7 @@ var (
 	ErrClosed = errors.New("agent: closed")
 
 	errNodeNotRegistered = errors.New("node not registered")
+	errNodeExpired       = errors.New("node expired")
 
 	errAgentStarted    = errors.New("agent: already started")
 	errAgentNotStarted = errors.New("agent: not started")
@@ -122,8 +122,13 @@ func (s *session) start(ctx context.Context, description *api.NodeDescription) e
 		client := api.NewDispatcherClient(s.conn.ClientConn)
 
 		stream, err = client.Session(sessionCtx, &api.SessionRequest{
-			Description: description,
-			SessionID:   s.sessionID,
+			Description:        description,
+			SessionID:          s.sessionID,
+			AdvertiseAddr:      s.agent.config.AdvertiseAddr,
+			MinHeartbeatPeriod: s.agent.config.MinHeartbeatPeriod,
+			MaxHeartbeatPeriod: s.agent.config.MaxHeartbeatPeriod,
+			AgentVersion:       s.agent.config.Version,
+			Metadata:           s.agent.config.Metadata,
 		})
 		if err != nil {
 			errChan <- err
@@ -165,13 +170,21 @@ func (s *session) heartbeat(ctx context.Context) error {
 			})
 			cancel()
 			if err != nil {
-				if grpc.Code(err) == codes.NotFound {
+				switch grpc.Code(err) {
+				case codes.NotFound:
 					err = errNodeNotRegistered
+				case codes.Aborted:
+					err = errNodeExpired
 				}
 
 				return err
 			}
 
+			if resp.Reconnect {
+				// the manager is going away, so find another one
+				return errSessionDisconnect
+			}
+
 			heartbeat.Reset(resp.Period)
 		case <-s.closed:
 			return errSessionClosed
@@ -193,6 +206,11 @@ func (s *session) listen(ctx context.Context) error {
 		if err := s.handleSessionMessage(ctx, msg); err != nil {
 			return err
 		}
+
+		if msg.DisconnectReason != api.SessionMessage_DisconnectReasonNone {
+			log.G(ctx).WithField("reason", msg.DisconnectReason).Info("manager closed the session")
+			return errSessionDisconnect
+		}
 	}
 }
 
@@ -251,10 +269,17 @@ func (s *session) watch(ctx context.Context) error {
 		resp            *api.AssignmentsMessage
 		assignmentWatch api.Dispatcher_AssignmentsClient
 		tasksWatch      api.Dispatcher_TasksClient
+		tasksCancel     context.CancelFunc
+		tasksVersion    uint64
 		streamReference string
 		tasksFallback   bool
 		err             error
 	)
+	defer func() {
+		if tasksCancel != nil {
+			tasksCancel()
+		}
+	}()
 
 	client := api.NewDispatcherClient(s.conn.ClientConn)
 	for {
@@ -283,7 +308,11 @@ func (s *session) watch(ctx context.Context) error {
 		// This code is here for backwards compatibility (so that newer clients can use the
 		// older method Tasks)
 		if tasksWatch == nil && tasksFallback {
-			tasksWatch, err = client.Tasks(ctx, &api.TasksRequest{SessionID: s.sessionID})
+			// each Tasks stream gets its own context, so that it can be
+			// closed when re-syncing
+			var tasksCtx context.Context
+			tasksCtx, tasksCancel = context.WithCancel(ctx)
+			tasksWatch, err = client.Tasks(tasksCtx, &api.TasksRequest{SessionID: s.sessionID})
 			if err != nil {
 				return err
 			}
@@ -296,6 +325,16 @@ func (s *session) watch(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+			// If there seems to be a gap in the stream, start a new one
+			// to re-sync.
+			if taskResp.PreviousVersion != tasksVersion {
+				log.Infof("missed tasks message (expected version %d, got %d), re-syncing", tasksVersion, taskResp.PreviousVersion)
+				tasksCancel()
+				tasksWatch = nil
+				tasksVersion = 0
+				continue
+			}
+			tasksVersion = taskResp.Version
 			for _, t := range taskResp.Tasks {
 				taskChange := &api.AssignmentChange{
 					Assignment: &api.Assignment{
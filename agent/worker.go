@@ -177,6 +177,13 @@ func reconcileTaskState(ctx context.Context, w *worker, assignments []*api.Assig
 	)
 	for _, a := range assignments {
 		if t := a.Assignment.GetTask(); t != nil {
+			if a.Reason != "" {
+				log.G(ctx).WithFields(logrus.Fields{
+					"task.id": t.ID,
+					"action":  a.Action,
+					"reason":  a.Reason,
+				}).Debug("task assignment changed")
+			}
 			switch a.Action {
 			case api.AssignmentChange_AssignmentActionUpdate:
 				updatedTasks = append(updatedTasks, t)
@@ -8,6 +8,8 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf1 "github.com/gogo/protobuf/types"
+import google_protobuf "github.com/gogo/protobuf/types"
 import _ "github.com/docker/swarmkit/protobuf/plugin"
 
 import github_com_docker_swarmkit_api_deepcopy "github.com/docker/swarmkit/api/deepcopy"
@@ -33,6 +35,29 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type CertificateAuditEvent_Action int32
+
+const (
+	CertificateAuditActionIssued  CertificateAuditEvent_Action = 0
+	CertificateAuditActionRevoked CertificateAuditEvent_Action = 1
+)
+
+var CertificateAuditEvent_Action_name = map[int32]string{
+	0: "ISSUED",
+	1: "REVOKED",
+}
+var CertificateAuditEvent_Action_value = map[string]int32{
+	"ISSUED":  0,
+	"REVOKED": 1,
+}
+
+func (x CertificateAuditEvent_Action) String() string {
+	return proto.EnumName(CertificateAuditEvent_Action_name, int32(x))
+}
+func (CertificateAuditEvent_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorCa, []int{7, 0}
+}
+
 type NodeCertificateStatusRequest struct {
 	NodeID string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
@@ -60,6 +85,10 @@ type IssueNodeCertificateRequest struct {
 	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	// Availability allows a user to control the current scheduling status of a node
 	Availability NodeSpec_Availability `protobuf:"varint,4,opt,name=availability,proto3,enum=docker.swarmkit.v1.NodeSpec_Availability" json:"availability,omitempty"`
+	// RequestedExpiry, if set, asks for a certificate that is valid for
+	// less time than the CA would issue by default. It is ignored if it is
+	// not shorter than that.
+	RequestedExpiry *google_protobuf1.Duration `protobuf:"bytes,5,opt,name=requested_expiry,json=requestedExpiry" json:"requested_expiry,omitempty"`
 }
 
 func (m *IssueNodeCertificateRequest) Reset()                    { *m = IssueNodeCertificateRequest{} }
@@ -76,6 +105,9 @@ func (*IssueNodeCertificateResponse) ProtoMessage()               {}
 func (*IssueNodeCertificateResponse) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{3} }
 
 type GetRootCACertificateRequest struct {
+	// IfNoneMatch is the ETag of the root CA bundle the requester already
+	// has, if any.  If it is still current, the bundle is not sent again.
+	IfNoneMatch string `protobuf:"bytes,1,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 }
 
 func (m *GetRootCACertificateRequest) Reset()                    { *m = GetRootCACertificateRequest{} }
@@ -84,18 +116,51 @@ func (*GetRootCACertificateRequest) Descriptor() ([]byte, []int) { return fileDe
 
 type GetRootCACertificateResponse struct {
 	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// ETag identifies the current root CA bundle.
+	ETag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// NotModified is set, and Certificate left empty, if the requester
+	// already has the current root CA bundle.
+	NotModified bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 }
 
 func (m *GetRootCACertificateResponse) Reset()                    { *m = GetRootCACertificateResponse{} }
 func (*GetRootCACertificateResponse) ProtoMessage()               {}
 func (*GetRootCACertificateResponse) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{5} }
 
+type WatchCertificateAuditRequest struct {
+}
+
+func (m *WatchCertificateAuditRequest) Reset()                    { *m = WatchCertificateAuditRequest{} }
+func (*WatchCertificateAuditRequest) ProtoMessage()               {}
+func (*WatchCertificateAuditRequest) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{6} }
+
+// CertificateAuditEvent records a node certificate being issued, or a node's
+// certificates being revoked.
+type CertificateAuditEvent struct {
+	Action CertificateAuditEvent_Action `protobuf:"varint,1,opt,name=action,proto3,enum=docker.swarmkit.v1.CertificateAuditEvent_Action" json:"action,omitempty"`
+	// NodeID is the ID of the node, which is the CN of its certificates.
+	NodeID string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Role is the role the certificate was issued for. It is only set for
+	// issuances.
+	Role NodeRole `protobuf:"varint,3,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
+	// Issuance describes the issued certificate. It is only set for
+	// issuances.
+	Issuance *CertificateIssuance `protobuf:"bytes,4,opt,name=issuance" json:"issuance,omitempty"`
+	// RevokedUntil is the latest expiry of the node's revoked certificates,
+	// if it is known. It is only set for revocations.
+	RevokedUntil *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=revoked_until,json=revokedUntil" json:"revoked_until,omitempty"`
+}
+
+func (m *CertificateAuditEvent) Reset()                    { *m = CertificateAuditEvent{} }
+func (*CertificateAuditEvent) ProtoMessage()               {}
+func (*CertificateAuditEvent) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{7} }
+
 type GetUnlockKeyRequest struct {
 }
 
 func (m *GetUnlockKeyRequest) Reset()                    { *m = GetUnlockKeyRequest{} }
 func (*GetUnlockKeyRequest) ProtoMessage()               {}
-func (*GetUnlockKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{6} }
+func (*GetUnlockKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{8} }
 
 type GetUnlockKeyResponse struct {
 	UnlockKey []byte  `protobuf:"bytes,1,opt,name=unlock_key,json=unlockKey,proto3" json:"unlock_key,omitempty"`
@@ -104,7 +169,7 @@ type GetUnlockKeyResponse struct {
 
 func (m *GetUnlockKeyResponse) Reset()                    { *m = GetUnlockKeyResponse{} }
 func (*GetUnlockKeyResponse) ProtoMessage()               {}
-func (*GetUnlockKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{7} }
+func (*GetUnlockKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{9} }
 
 func init() {
 	proto.RegisterType((*NodeCertificateStatusRequest)(nil), "docker.swarmkit.v1.NodeCertificateStatusRequest")
@@ -113,8 +178,11 @@ func init() {
 	proto.RegisterType((*IssueNodeCertificateResponse)(nil), "docker.swarmkit.v1.IssueNodeCertificateResponse")
 	proto.RegisterType((*GetRootCACertificateRequest)(nil), "docker.swarmkit.v1.GetRootCACertificateRequest")
 	proto.RegisterType((*GetRootCACertificateResponse)(nil), "docker.swarmkit.v1.GetRootCACertificateResponse")
+	proto.RegisterType((*WatchCertificateAuditRequest)(nil), "docker.swarmkit.v1.WatchCertificateAuditRequest")
+	proto.RegisterType((*CertificateAuditEvent)(nil), "docker.swarmkit.v1.CertificateAuditEvent")
 	proto.RegisterType((*GetUnlockKeyRequest)(nil), "docker.swarmkit.v1.GetUnlockKeyRequest")
 	proto.RegisterType((*GetUnlockKeyResponse)(nil), "docker.swarmkit.v1.GetUnlockKeyResponse")
+	proto.RegisterEnum("docker.swarmkit.v1.CertificateAuditEvent_Action", CertificateAuditEvent_Action_name, CertificateAuditEvent_Action_value)
 }
 
 type authenticatedWrapperCAServer struct {
@@ -142,6 +210,14 @@ func (p *authenticatedWrapperCAServer) GetUnlockKey(ctx context.Context, r *GetU
 	return p.local.GetUnlockKey(ctx, r)
 }
 
+func (p *authenticatedWrapperCAServer) WatchCertificateAudit(r *WatchCertificateAuditRequest, stream CA_WatchCertificateAuditServer) error {
+
+	if err := p.authorize(stream.Context(), []string{"swarm-manager"}); err != nil {
+		return err
+	}
+	return p.local.WatchCertificateAudit(r, stream)
+}
+
 type authenticatedWrapperNodeCAServer struct {
 	local     NodeCAServer
 	authorize func(context.Context, []string) error
@@ -215,6 +291,10 @@ func (m *IssueNodeCertificateRequest) CopyFrom(src interface{}) {
 
 	o := src.(*IssueNodeCertificateRequest)
 	*m = *o
+	if o.RequestedExpiry != nil {
+		m.RequestedExpiry = &google_protobuf1.Duration{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.RequestedExpiry, o.RequestedExpiry)
+	}
 }
 
 func (m *IssueNodeCertificateResponse) Copy() *IssueNodeCertificateResponse {
@@ -241,7 +321,12 @@ func (m *GetRootCACertificateRequest) Copy() *GetRootCACertificateRequest {
 	return o
 }
 
-func (m *GetRootCACertificateRequest) CopyFrom(src interface{}) {}
+func (m *GetRootCACertificateRequest) CopyFrom(src interface{}) {
+
+	o := src.(*GetRootCACertificateRequest)
+	*m = *o
+}
+
 func (m *GetRootCACertificateResponse) Copy() *GetRootCACertificateResponse {
 	if m == nil {
 		return nil
@@ -257,6 +342,39 @@ func (m *GetRootCACertificateResponse) CopyFrom(src interface{}) {
 	*m = *o
 }
 
+func (m *WatchCertificateAuditRequest) Copy() *WatchCertificateAuditRequest {
+	if m == nil {
+		return nil
+	}
+	o := &WatchCertificateAuditRequest{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *WatchCertificateAuditRequest) CopyFrom(src interface{}) {}
+func (m *CertificateAuditEvent) Copy() *CertificateAuditEvent {
+	if m == nil {
+		return nil
+	}
+	o := &CertificateAuditEvent{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *CertificateAuditEvent) CopyFrom(src interface{}) {
+
+	o := src.(*CertificateAuditEvent)
+	*m = *o
+	if o.Issuance != nil {
+		m.Issuance = &CertificateIssuance{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.Issuance, o.Issuance)
+	}
+	if o.RevokedUntil != nil {
+		m.RevokedUntil = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.RevokedUntil, o.RevokedUntil)
+	}
+}
+
 func (m *GetUnlockKeyRequest) Copy() *GetUnlockKeyRequest {
 	if m == nil {
 		return nil
@@ -298,6 +416,10 @@ type CAClient interface {
 	// GetUnlockKey returns the current unlock key for the cluster for the role of the client
 	// asking.
 	GetUnlockKey(ctx context.Context, in *GetUnlockKeyRequest, opts ...grpc.CallOption) (*GetUnlockKeyResponse, error)
+	// WatchCertificateAudit streams an audit event for every node certificate
+	// the CA issues and every node whose certificates are revoked, from when
+	// the call is made for as long as the caller listens.
+	WatchCertificateAudit(ctx context.Context, in *WatchCertificateAuditRequest, opts ...grpc.CallOption) (CA_WatchCertificateAuditClient, error)
 }
 
 type cAClient struct {
@@ -326,6 +448,38 @@ func (c *cAClient) GetUnlockKey(ctx context.Context, in *GetUnlockKeyRequest, op
 	return out, nil
 }
 
+func (c *cAClient) WatchCertificateAudit(ctx context.Context, in *WatchCertificateAuditRequest, opts ...grpc.CallOption) (CA_WatchCertificateAuditClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CA_serviceDesc.Streams[0], c.cc, "/docker.swarmkit.v1.CA/WatchCertificateAudit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cAWatchCertificateAuditClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CA_WatchCertificateAuditClient interface {
+	Recv() (*CertificateAuditEvent, error)
+	grpc.ClientStream
+}
+
+type cAWatchCertificateAuditClient struct {
+	grpc.ClientStream
+}
+
+func (x *cAWatchCertificateAuditClient) Recv() (*CertificateAuditEvent, error) {
+	m := new(CertificateAuditEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for CA service
 
 type CAServer interface {
@@ -333,6 +487,10 @@ type CAServer interface {
 	// GetUnlockKey returns the current unlock key for the cluster for the role of the client
 	// asking.
 	GetUnlockKey(context.Context, *GetUnlockKeyRequest) (*GetUnlockKeyResponse, error)
+	// WatchCertificateAudit streams an audit event for every node certificate
+	// the CA issues and every node whose certificates are revoked, from when
+	// the call is made for as long as the caller listens.
+	WatchCertificateAudit(*WatchCertificateAuditRequest, CA_WatchCertificateAuditServer) error
 }
 
 func RegisterCAServer(s *grpc.Server, srv CAServer) {
@@ -375,6 +533,27 @@ func _CA_GetUnlockKey_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CA_WatchCertificateAudit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCertificateAuditRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CAServer).WatchCertificateAudit(m, &cAWatchCertificateAuditServer{stream})
+}
+
+type CA_WatchCertificateAuditServer interface {
+	Send(*CertificateAuditEvent) error
+	grpc.ServerStream
+}
+
+type cAWatchCertificateAuditServer struct {
+	grpc.ServerStream
+}
+
+func (x *cAWatchCertificateAuditServer) Send(m *CertificateAuditEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _CA_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "docker.swarmkit.v1.CA",
 	HandlerType: (*CAServer)(nil),
@@ -388,7 +567,13 @@ var _CA_serviceDesc = grpc.ServiceDesc{
 			Handler:    _CA_GetUnlockKey_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCertificateAudit",
+			Handler:       _CA_WatchCertificateAudit_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ca.proto",
 }
 
@@ -588,6 +773,16 @@ func (m *IssueNodeCertificateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCa(dAtA, i, uint64(m.Availability))
 	}
+	if m.RequestedExpiry != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(m.RequestedExpiry.Size()))
+		n3, err := m.RequestedExpiry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -635,6 +830,12 @@ func (m *GetRootCACertificateRequest) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IfNoneMatch) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.IfNoneMatch)))
+		i += copy(dAtA[i:], m.IfNoneMatch)
+	}
 	return i, nil
 }
 
@@ -659,6 +860,94 @@ func (m *GetRootCACertificateResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCa(dAtA, i, uint64(len(m.Certificate)))
 		i += copy(dAtA[i:], m.Certificate)
 	}
+	if len(m.ETag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.ETag)))
+		i += copy(dAtA[i:], m.ETag)
+	}
+	if m.NotModified {
+		dAtA[i] = 0x18
+		i++
+		if m.NotModified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *WatchCertificateAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchCertificateAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CertificateAuditEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateAuditEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(m.Action))
+	}
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
+	if m.Role != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(m.Role))
+	}
+	if m.Issuance != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(m.Issuance.Size()))
+		n4, err := m.Issuance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.RevokedUntil != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(m.RevokedUntil.Size()))
+		n5, err := m.RevokedUntil.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -704,11 +993,11 @@ func (m *GetUnlockKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintCa(dAtA, i, uint64(m.Version.Size()))
-	n3, err := m.Version.MarshalTo(dAtA[i:])
+	n6, err := m.Version.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n6
 	return i, nil
 }
 
@@ -882,6 +1171,57 @@ func (p *raftProxyCAServer) GetUnlockKey(ctx context.Context, r *GetUnlockKeyReq
 	return resp, err
 }
 
+type CA_WatchCertificateAuditServerWrapper struct {
+	CA_WatchCertificateAuditServer
+	ctx context.Context
+}
+
+func (s CA_WatchCertificateAuditServerWrapper) Context() context.Context {
+	return s.ctx
+}
+
+func (p *raftProxyCAServer) WatchCertificateAudit(r *WatchCertificateAuditRequest, stream CA_WatchCertificateAuditServer) error {
+	ctx := stream.Context()
+	conn, err := p.connSelector.LeaderConn(ctx)
+	if err != nil {
+		if err == raftselector.ErrIsLeader {
+			ctx, err = p.runCtxMods(ctx, p.localCtxMods)
+			if err != nil {
+				return err
+			}
+			streamWrapper := CA_WatchCertificateAuditServerWrapper{
+				CA_WatchCertificateAuditServer: stream,
+				ctx:                            ctx,
+			}
+			return p.local.WatchCertificateAudit(r, streamWrapper)
+		}
+		return err
+	}
+	ctx, err = p.runCtxMods(ctx, p.remoteCtxMods)
+	if err != nil {
+		return err
+	}
+	clientStream, err := NewCAClient(conn).WatchCertificateAudit(ctx, r)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := clientStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type raftProxyNodeCAServer struct {
 	local                       NodeCAServer
 	connSelector                raftselector.ConnProvider
@@ -1065,6 +1405,10 @@ func (m *IssueNodeCertificateRequest) Size() (n int) {
 	if m.Availability != 0 {
 		n += 1 + sovCa(uint64(m.Availability))
 	}
+	if m.RequestedExpiry != nil {
+		l = m.RequestedExpiry.Size()
+		n += 1 + l + sovCa(uint64(l))
+	}
 	return n
 }
 
@@ -1084,6 +1428,10 @@ func (m *IssueNodeCertificateResponse) Size() (n int) {
 func (m *GetRootCACertificateRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.IfNoneMatch)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
 	return n
 }
 
@@ -1094,6 +1442,43 @@ func (m *GetRootCACertificateResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCa(uint64(l))
 	}
+	l = len(m.ETag)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	if m.NotModified {
+		n += 2
+	}
+	return n
+}
+
+func (m *WatchCertificateAuditRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CertificateAuditEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovCa(uint64(m.Action))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovCa(uint64(m.Role))
+	}
+	if m.Issuance != nil {
+		l = m.Issuance.Size()
+		n += 1 + l + sovCa(uint64(l))
+	}
+	if m.RevokedUntil != nil {
+		l = m.RevokedUntil.Size()
+		n += 1 + l + sovCa(uint64(l))
+	}
 	return n
 }
 
@@ -1158,6 +1543,7 @@ func (this *IssueNodeCertificateRequest) String() string {
 		`CSR:` + fmt.Sprintf("%v", this.CSR) + `,`,
 		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
 		`Availability:` + fmt.Sprintf("%v", this.Availability) + `,`,
+		`RequestedExpiry:` + strings.Replace(fmt.Sprintf("%v", this.RequestedExpiry), "Duration", "google_protobuf1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1178,6 +1564,7 @@ func (this *GetRootCACertificateRequest) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&GetRootCACertificateRequest{`,
+		`IfNoneMatch:` + fmt.Sprintf("%v", this.IfNoneMatch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1188,6 +1575,31 @@ func (this *GetRootCACertificateResponse) String() string {
 	}
 	s := strings.Join([]string{`&GetRootCACertificateResponse{`,
 		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`ETag:` + fmt.Sprintf("%v", this.ETag) + `,`,
+		`NotModified:` + fmt.Sprintf("%v", this.NotModified) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchCertificateAuditRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchCertificateAuditRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CertificateAuditEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateAuditEvent{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Issuance:` + strings.Replace(fmt.Sprintf("%v", this.Issuance), "CertificateIssuance", "CertificateIssuance", 1) + `,`,
+		`RevokedUntil:` + strings.Replace(fmt.Sprintf("%v", this.RevokedUntil), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1542,6 +1954,39 @@ func (m *IssueNodeCertificateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestedExpiry == nil {
+				m.RequestedExpiry = &google_protobuf1.Duration{}
+			}
+			if err := m.RequestedExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCa(dAtA[iNdEx:])
@@ -1690,12 +2135,41 @@ func (m *GetRootCACertificateRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GetRootCACertificateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCa(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNoneMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfNoneMatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if skippy < 0 {
 				return ErrInvalidLengthCa
 			}
@@ -1771,6 +2245,288 @@ func (m *GetRootCACertificateResponse) Unmarshal(dAtA []byte) error {
 				m.Certificate = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ETag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ETag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotModified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotModified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCertificateAuditRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCertificateAuditRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCertificateAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CertificateAuditEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateAuditEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateAuditEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= (CertificateAuditEvent_Action(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= (NodeRole(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Issuance == nil {
+				m.Issuance = &CertificateIssuance{}
+			}
+			if err := m.Issuance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevokedUntil == nil {
+				m.RevokedUntil = &google_protobuf.Timestamp{}
+			}
+			if err := m.RevokedUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCa(dAtA[iNdEx:])
@@ -2061,44 +2817,63 @@ var (
 func init() { proto.RegisterFile("ca.proto", fileDescriptorCa) }
 
 var fileDescriptorCa = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xee, 0xba, 0x25, 0x6d, 0x27, 0xa1, 0x45, 0xdb, 0x56, 0x32, 0x69, 0xea, 0x54, 0xe6, 0xd0,
-	0x72, 0xc0, 0x6d, 0x03, 0x27, 0xb8, 0x90, 0x04, 0xa9, 0x8a, 0x50, 0x11, 0xda, 0x08, 0xae, 0x95,
-	0xe3, 0x2c, 0xc1, 0x8a, 0xe3, 0x35, 0xde, 0x75, 0x20, 0x37, 0x24, 0x10, 0x6f, 0x80, 0xe0, 0xc4,
-	0x23, 0xf0, 0x1c, 0x11, 0x27, 0x24, 0x2e, 0x9c, 0x22, 0xea, 0x07, 0xe0, 0x19, 0x90, 0xd7, 0x36,
-	0xcd, 0x8f, 0x13, 0xca, 0xc9, 0xbb, 0xb3, 0xdf, 0xf7, 0xcd, 0xcc, 0xb7, 0xe3, 0x85, 0x35, 0xcb,
-	0x34, 0x3c, 0x9f, 0x09, 0x86, 0x71, 0x9b, 0x59, 0x5d, 0xea, 0x1b, 0xfc, 0xb5, 0xe9, 0xf7, 0xba,
-	0xb6, 0x30, 0xfa, 0x27, 0xc5, 0xbc, 0x18, 0x78, 0x94, 0xc7, 0x80, 0x62, 0x9e, 0x7b, 0xd4, 0x4a,
-	0x37, 0xdb, 0x1d, 0xd6, 0x61, 0x72, 0x79, 0x14, 0xad, 0x92, 0xe8, 0x96, 0xe7, 0x04, 0x1d, 0xdb,
-	0x3d, 0x8a, 0x3f, 0x71, 0x50, 0xaf, 0x43, 0xe9, 0x09, 0x6b, 0xd3, 0x3a, 0xf5, 0x85, 0xfd, 0xc2,
-	0xb6, 0x4c, 0x41, 0x9b, 0xc2, 0x14, 0x01, 0x27, 0xf4, 0x55, 0x40, 0xb9, 0xc0, 0xb7, 0x60, 0xd5,
-	0x65, 0x6d, 0x7a, 0x6e, 0xb7, 0x55, 0xb4, 0x8f, 0x0e, 0xd7, 0x6b, 0x10, 0x8e, 0xca, 0xb9, 0x88,
-	0xd2, 0x78, 0x44, 0x72, 0xd1, 0x51, 0xa3, 0xad, 0x7f, 0x41, 0xb0, 0x37, 0x47, 0x85, 0x7b, 0xcc,
-	0xe5, 0x14, 0xdf, 0x87, 0x1c, 0x97, 0x11, 0xa9, 0x92, 0xaf, 0xe8, 0xc6, 0x6c, 0x43, 0x46, 0x83,
-	0xf3, 0xc0, 0x74, 0xad, 0x94, 0x9b, 0x30, 0x70, 0x15, 0xf2, 0xd6, 0xa5, 0xb0, 0xaa, 0x48, 0x81,
-	0x72, 0x96, 0xc0, 0x58, 0x7e, 0x32, 0xce, 0xd1, 0x7f, 0x20, 0xd8, 0x8d, 0xd4, 0xe9, 0x54, 0x95,
-	0x69, 0x97, 0xf7, 0x60, 0xc5, 0x67, 0x0e, 0x95, 0xc5, 0x6d, 0x54, 0x4a, 0x59, 0xda, 0x11, 0x93,
-	0x30, 0x87, 0xd6, 0x14, 0x15, 0x11, 0x89, 0xc6, 0x37, 0x61, 0xd9, 0xe2, 0xbe, 0x2c, 0xa8, 0x50,
-	0x5b, 0x0d, 0x47, 0xe5, 0xe5, 0x7a, 0x93, 0x90, 0x28, 0x86, 0xb7, 0xe1, 0x9a, 0x60, 0x5d, 0xea,
-	0xaa, 0xcb, 0x91, 0x69, 0x24, 0xde, 0xe0, 0x33, 0x28, 0x98, 0x7d, 0xd3, 0x76, 0xcc, 0x96, 0xed,
-	0xd8, 0x62, 0xa0, 0xae, 0xc8, 0x74, 0xb7, 0xe7, 0xa5, 0x6b, 0x7a, 0xd4, 0x32, 0xaa, 0x63, 0x04,
-	0x32, 0x41, 0xd7, 0x3f, 0x22, 0x28, 0x65, 0x77, 0x95, 0xb8, 0x7e, 0x95, 0xcb, 0xc3, 0x4f, 0x61,
-	0x53, 0x82, 0x7a, 0xb4, 0xd7, 0xa2, 0x3e, 0x7f, 0x69, 0x7b, 0xb2, 0xa3, 0x8d, 0xca, 0xc1, 0xc2,
-	0xba, 0xce, 0xfe, 0xc2, 0xc9, 0x46, 0xc4, 0xbf, 0xdc, 0xeb, 0x7b, 0xb0, 0x7b, 0x4a, 0x05, 0x61,
-	0x4c, 0xd4, 0xab, 0xb3, 0x66, 0xeb, 0x0f, 0xa1, 0x94, 0x7d, 0x9c, 0x54, 0xbd, 0x3f, 0x79, 0xdf,
-	0x51, 0xe5, 0x85, 0xc9, 0xeb, 0xdc, 0x81, 0xad, 0x53, 0x2a, 0x9e, 0xb9, 0x0e, 0xb3, 0xba, 0x8f,
-	0xe9, 0x20, 0x15, 0xf6, 0x61, 0x7b, 0x32, 0x9c, 0x08, 0xee, 0x01, 0x04, 0x32, 0x78, 0xde, 0xa5,
-	0x83, 0x44, 0x6f, 0x3d, 0x48, 0x61, 0xf8, 0x01, 0xac, 0xf6, 0xa9, 0xcf, 0x6d, 0xe6, 0x26, 0xb3,
-	0xb5, 0x9b, 0xd5, 0xf8, 0xf3, 0x18, 0x52, 0x5b, 0x19, 0x8e, 0xca, 0x4b, 0x24, 0x65, 0x54, 0xde,
-	0x2b, 0xa0, 0xd4, 0xab, 0xf8, 0x1d, 0x92, 0xb9, 0x67, 0x9a, 0xc2, 0x47, 0x59, 0x5a, 0x0b, 0xdc,
-	0x29, 0x1e, 0x5f, 0x9d, 0x10, 0xb7, 0xa7, 0xaf, 0x7d, 0xfb, 0xfa, 0xfb, 0xb3, 0xa2, 0xdc, 0x40,
-	0xf8, 0x0d, 0x14, 0xc6, 0x0d, 0xc0, 0x07, 0x73, 0xb4, 0xa6, 0x9d, 0x2b, 0x1e, 0xfe, 0x1b, 0x98,
-	0x24, 0xdb, 0x91, 0xc9, 0x36, 0xe1, 0xba, 0x44, 0xde, 0xe9, 0x99, 0xae, 0xd9, 0xa1, 0x7e, 0xe5,
-	0x93, 0x02, 0x72, 0xae, 0x12, 0x2b, 0xb2, 0xa6, 0x32, 0xdb, 0x8a, 0x05, 0x7f, 0x65, 0xb6, 0x15,
-	0x8b, 0x06, 0x7e, 0xcc, 0x8a, 0x0f, 0x08, 0x76, 0x32, 0x9f, 0x24, 0x7c, 0x3c, 0x6f, 0xac, 0xe7,
-	0xbd, 0x81, 0xc5, 0x93, 0xff, 0x60, 0x4c, 0x17, 0x52, 0x53, 0x87, 0x17, 0xda, 0xd2, 0xcf, 0x0b,
-	0x6d, 0xe9, 0x6d, 0xa8, 0xa1, 0x61, 0xa8, 0xa1, 0xef, 0xa1, 0x86, 0x7e, 0x85, 0x1a, 0x6a, 0xe5,
-	0xe4, 0x0b, 0x7c, 0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0xad, 0xed, 0x8f, 0xe6, 0x05,
-	0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x93, 0x6c, 0x9a, 0xbe, 0x49, 0x3f, 0x34, 0xdb, 0x48, 0x59, 0x37, 0x4d, 0xb2, 0xe6,
+	0xd0, 0x5d, 0x09, 0x9c, 0x6c, 0xe0, 0x04, 0x07, 0x94, 0x2f, 0x2d, 0xd5, 0xaa, 0x0b, 0x9a, 0x6c,
+	0x97, 0x63, 0xe4, 0xda, 0x93, 0xec, 0x28, 0x89, 0xc7, 0xd8, 0xe3, 0xb0, 0xb9, 0x81, 0x90, 0x10,
+	0x5a, 0x89, 0x1b, 0x08, 0x38, 0x2c, 0x17, 0xae, 0x88, 0xdf, 0x51, 0x71, 0xe2, 0xc8, 0xa9, 0xa2,
+	0xf9, 0x01, 0xfc, 0x06, 0xe4, 0xf1, 0xb8, 0x4d, 0x53, 0x27, 0x2d, 0xa7, 0x78, 0xde, 0x79, 0x9e,
+	0xf7, 0xf3, 0x79, 0xed, 0x40, 0xd6, 0x34, 0x74, 0xc7, 0x65, 0x9c, 0x21, 0x64, 0x31, 0x73, 0x44,
+	0x5c, 0xdd, 0xfb, 0xd2, 0x70, 0x27, 0x23, 0xca, 0xf5, 0xe9, 0x13, 0x35, 0xc7, 0x67, 0x0e, 0xf1,
+	0x42, 0x80, 0x9a, 0xf3, 0x1c, 0x62, 0x46, 0x87, 0xbd, 0x21, 0x1b, 0x32, 0xf1, 0x58, 0x0b, 0x9e,
+	0xa4, 0xb5, 0x3c, 0x64, 0x6c, 0x38, 0x26, 0x35, 0x71, 0x3a, 0xf5, 0x07, 0x35, 0xcb, 0x77, 0x0d,
+	0x4e, 0x99, 0x2d, 0xef, 0x2b, 0xcb, 0xf7, 0x9c, 0x4e, 0x88, 0xc7, 0x8d, 0x89, 0x23, 0x01, 0xf7,
+	0x9d, 0xb1, 0x3f, 0xa4, 0x76, 0x2d, 0xfc, 0x09, 0x8d, 0x5a, 0x1b, 0x4a, 0xcf, 0x99, 0x45, 0xda,
+	0xc4, 0xe5, 0x74, 0x40, 0x4d, 0x83, 0x93, 0x1e, 0x37, 0xb8, 0xef, 0x61, 0xf2, 0x85, 0x4f, 0x3c,
+	0x8e, 0xde, 0x81, 0x0d, 0x9b, 0x59, 0xa4, 0x4f, 0xad, 0xa2, 0x52, 0x55, 0x1e, 0x6d, 0xb6, 0x60,
+	0x7e, 0x5e, 0xc9, 0x04, 0x94, 0xa3, 0x0e, 0xce, 0x04, 0x57, 0x47, 0x96, 0xf6, 0xab, 0x02, 0x07,
+	0x2b, 0xbc, 0x78, 0x0e, 0xb3, 0x3d, 0x82, 0x3e, 0x84, 0x8c, 0x27, 0x2c, 0xc2, 0x4b, 0xae, 0xa1,
+	0xe9, 0x37, 0x3b, 0xa2, 0x1f, 0x79, 0x9e, 0x6f, 0xd8, 0x66, 0xc4, 0x95, 0x0c, 0xd4, 0x84, 0x9c,
+	0x79, 0xe5, 0xb8, 0x98, 0x14, 0x0e, 0x2a, 0x71, 0x0e, 0x16, 0xe2, 0xe3, 0x45, 0x8e, 0xf6, 0x4b,
+	0x12, 0xf6, 0x03, 0xef, 0x64, 0x29, 0xcb, 0xa8, 0xca, 0x0f, 0x20, 0xed, 0xb2, 0x31, 0x11, 0xc9,
+	0x6d, 0x37, 0x4a, 0x71, 0xbe, 0x03, 0x26, 0x66, 0x63, 0xd2, 0x4a, 0x16, 0x15, 0x2c, 0xd0, 0xe8,
+	0x01, 0xa4, 0x4c, 0xcf, 0x15, 0x09, 0xe5, 0x5b, 0x1b, 0xf3, 0xf3, 0x4a, 0xaa, 0xdd, 0xc3, 0x38,
+	0xb0, 0xa1, 0x3d, 0xb8, 0xc7, 0xd9, 0x88, 0xd8, 0xc5, 0x54, 0xd0, 0x34, 0x1c, 0x1e, 0xd0, 0x31,
+	0xe4, 0x8d, 0xa9, 0x41, 0xc7, 0xc6, 0x29, 0x1d, 0x53, 0x3e, 0x2b, 0xa6, 0x45, 0xb8, 0xc7, 0xab,
+	0xc2, 0xf5, 0x1c, 0x62, 0xea, 0xcd, 0x05, 0x02, 0xbe, 0x46, 0x47, 0x1d, 0xd8, 0x75, 0xc3, 0x02,
+	0x88, 0xd5, 0x27, 0xaf, 0x1d, 0xea, 0xce, 0x8a, 0xf7, 0x44, 0x77, 0x1e, 0xe8, 0xa1, 0x18, 0xf4,
+	0x48, 0x0c, 0x7a, 0x47, 0x8a, 0x05, 0xef, 0x5c, 0x52, 0xba, 0x82, 0xa1, 0xfd, 0xa8, 0x40, 0x29,
+	0xbe, 0x37, 0x72, 0x76, 0x77, 0x91, 0x00, 0xfa, 0x0c, 0x76, 0x04, 0x68, 0x42, 0x26, 0xa7, 0xc4,
+	0xf5, 0x5e, 0x51, 0x47, 0xf4, 0x65, 0xbb, 0x71, 0xb8, 0xb6, 0xba, 0xe3, 0x4b, 0x38, 0xde, 0x0e,
+	0xf8, 0x57, 0x67, 0xad, 0x09, 0xfb, 0x4f, 0x09, 0xc7, 0x8c, 0xf1, 0x76, 0x33, 0x66, 0x64, 0x1a,
+	0x6c, 0xd1, 0x41, 0xdf, 0x66, 0x36, 0xe9, 0x4f, 0x0c, 0x6e, 0xbe, 0x0a, 0x73, 0xc3, 0x39, 0x3a,
+	0x78, 0xce, 0x6c, 0x72, 0x1c, 0x98, 0xb4, 0xaf, 0x15, 0x28, 0xc5, 0xfb, 0x90, 0xa5, 0x55, 0xaf,
+	0x4b, 0x2b, 0x70, 0x91, 0xbf, 0xa6, 0x1c, 0x54, 0x82, 0x34, 0xe1, 0xc6, 0x50, 0x14, 0xb3, 0xd9,
+	0xca, 0xce, 0xcf, 0x2b, 0xe9, 0xee, 0x0b, 0x63, 0x88, 0x85, 0x15, 0x3d, 0x84, 0xbc, 0xcd, 0x78,
+	0x7f, 0xc2, 0x2c, 0x3a, 0xa0, 0xc4, 0x12, 0xd3, 0xce, 0xe2, 0x9c, 0xcd, 0xf8, 0xb1, 0x34, 0x69,
+	0x65, 0x28, 0x7d, 0x1e, 0x24, 0xb3, 0x10, 0xbe, 0xe9, 0x5b, 0x94, 0xcb, 0x3a, 0xb4, 0xdf, 0x53,
+	0x50, 0x58, 0xbe, 0xeb, 0x4e, 0x89, 0xcd, 0xd1, 0x27, 0x90, 0x31, 0xcc, 0x60, 0x66, 0x52, 0x96,
+	0xf5, 0x5b, 0x24, 0x7f, 0x45, 0xd5, 0x9b, 0x82, 0x87, 0x25, 0x7f, 0x71, 0x82, 0xc9, 0x95, 0x13,
+	0xac, 0xcb, 0x1d, 0x48, 0xdd, 0xbe, 0x03, 0x52, 0xff, 0x6d, 0xc8, 0x52, 0xb9, 0xb2, 0x42, 0xca,
+	0xb9, 0xc6, 0xe1, 0x2d, 0x29, 0x46, 0x1b, 0x8e, 0x2f, 0x89, 0xe8, 0x63, 0xd8, 0x72, 0xc9, 0x94,
+	0x8d, 0x88, 0xd5, 0xf7, 0x6d, 0x4e, 0xc7, 0x52, 0xc1, 0xea, 0x0d, 0x05, 0xbf, 0x88, 0x5e, 0x67,
+	0x38, 0x2f, 0x09, 0x27, 0x01, 0x5e, 0x1b, 0x43, 0x26, 0x2c, 0x17, 0xbd, 0x0b, 0x99, 0xa3, 0x5e,
+	0xef, 0xa4, 0xdb, 0xd9, 0x4d, 0xa8, 0xd5, 0x37, 0x6f, 0xab, 0xa5, 0xe5, 0xe6, 0x84, 0x38, 0x21,
+	0x76, 0x0b, 0xe9, 0xb0, 0x81, 0xbb, 0x2f, 0x3f, 0x7d, 0xd6, 0xed, 0xec, 0x2a, 0xea, 0xc3, 0x37,
+	0x6f, 0xab, 0x07, 0xf1, 0x70, 0x1c, 0x06, 0x53, 0xd3, 0xdf, 0xfd, 0x56, 0x4e, 0x68, 0x05, 0xb8,
+	0xff, 0x94, 0xf0, 0x13, 0x7b, 0xcc, 0xcc, 0xd1, 0x33, 0x32, 0x8b, 0xa6, 0xe8, 0xc2, 0xde, 0x75,
+	0xb3, 0x14, 0xd8, 0x01, 0x80, 0x2f, 0x8c, 0xfd, 0x11, 0x99, 0x49, 0x7d, 0x6d, 0xfa, 0x11, 0x0c,
+	0x7d, 0x04, 0x1b, 0x53, 0xe2, 0x7a, 0xc1, 0x8c, 0xc3, 0xd7, 0xda, 0x7e, 0x5c, 0x03, 0x5f, 0x86,
+	0x90, 0x56, 0xfa, 0xec, 0xbc, 0x92, 0xc0, 0x11, 0xa3, 0xf1, 0x43, 0x0a, 0x92, 0xed, 0x26, 0xfa,
+	0x46, 0x81, 0xbd, 0x38, 0x91, 0xa3, 0x5a, 0x9c, 0xaf, 0x35, 0x2b, 0xa5, 0xd6, 0xef, 0x4e, 0x08,
+	0xcb, 0xd3, 0xb2, 0x7f, 0xfe, 0xf1, 0xef, 0xcf, 0xc9, 0xe4, 0xae, 0x82, 0x5e, 0x43, 0x7e, 0xb1,
+	0x01, 0xe8, 0x70, 0x85, 0xaf, 0xe5, 0xce, 0xa9, 0x8f, 0x6e, 0x07, 0xca, 0x60, 0x05, 0x11, 0x6c,
+	0x07, 0xb6, 0x04, 0xf2, 0xbd, 0x89, 0x61, 0x1b, 0x43, 0xe2, 0xa2, 0xef, 0x15, 0x28, 0xc4, 0x6e,
+	0x18, 0x8a, 0xad, 0x67, 0xdd, 0x32, 0xaa, 0x8f, 0xef, 0xbc, 0x62, 0x2b, 0xb2, 0xa9, 0x2b, 0x8d,
+	0x9f, 0x92, 0x20, 0x56, 0x4b, 0x8e, 0x26, 0xee, 0xd5, 0x1a, 0x3f, 0x9a, 0x35, 0x1f, 0x28, 0xb5,
+	0x7e, 0x77, 0xc2, 0x8d, 0xd1, 0x7c, 0xab, 0x40, 0x21, 0xf6, 0xeb, 0x1c, 0xdf, 0xa0, 0x75, 0x7f,
+	0x07, 0xd4, 0x27, 0xff, 0x83, 0xb1, 0x9c, 0x48, 0xab, 0x78, 0x76, 0x51, 0x4e, 0xfc, 0x7d, 0x51,
+	0x4e, 0x7c, 0x35, 0x2f, 0x2b, 0x67, 0xf3, 0xb2, 0xf2, 0xd7, 0xbc, 0xac, 0xfc, 0x33, 0x2f, 0x2b,
+	0xa7, 0x19, 0xb1, 0xe5, 0xef, 0xff, 0x37, 0x00, 0xc3, 0xab, 0x42, 0x73, 0x32, 0x09, 0x00, 0x00,
 }
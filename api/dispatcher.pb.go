@@ -30,6 +30,7 @@ import rafttime "time"
 
 import strings "strings"
 import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -38,6 +39,45 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type SessionMessage_DisconnectReason int32
+
+const (
+	SessionMessage_DisconnectReasonNone SessionMessage_DisconnectReason = 0
+	// DRAIN means that the dispatcher is moving its sessions to other
+	// managers ahead of a shutdown.
+	SessionMessage_DisconnectReasonDrain SessionMessage_DisconnectReason = 1
+	// LEADERSHIP_LOST means that the manager is no longer the leader, so
+	// the agent must find the new one.
+	SessionMessage_DisconnectReasonLeadershipLost SessionMessage_DisconnectReason = 2
+	// SHUTDOWN means that the manager is shutting down.
+	SessionMessage_DisconnectReasonShutdown SessionMessage_DisconnectReason = 3
+	// NODE_REMOVED means that the node has been removed from the cluster,
+	// so it must not reconnect.
+	SessionMessage_DisconnectReasonNodeRemoved SessionMessage_DisconnectReason = 4
+)
+
+var SessionMessage_DisconnectReason_name = map[int32]string{
+	0: "NONE",
+	1: "DRAIN",
+	2: "LEADERSHIP_LOST",
+	3: "SHUTDOWN",
+	4: "NODE_REMOVED",
+}
+var SessionMessage_DisconnectReason_value = map[string]int32{
+	"NONE":            0,
+	"DRAIN":           1,
+	"LEADERSHIP_LOST": 2,
+	"SHUTDOWN":        3,
+	"NODE_REMOVED":    4,
+}
+
+func (x SessionMessage_DisconnectReason) String() string {
+	return proto.EnumName(SessionMessage_DisconnectReason_name, int32(x))
+}
+func (SessionMessage_DisconnectReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorDispatcher, []int{1, 0}
+}
+
 type AssignmentChange_AssignmentAction int32
 
 const (
@@ -94,6 +134,30 @@ type SessionRequest struct {
 	//
 	// See SessionMessage.SessionID for details.
 	SessionID string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// AdvertiseAddr, if set, is the IP address that other nodes should use to
+	// reach this node. If it is empty, the IP address the node connects to the
+	// dispatcher from is recorded instead.
+	AdvertiseAddr string `protobuf:"bytes,3,opt,name=advertise_addr,json=advertiseAddr,proto3" json:"advertise_addr,omitempty"`
+	// MinHeartbeatPeriod and MaxHeartbeatPeriod, if MaxHeartbeatPeriod is
+	// set, are the range of heartbeat periods the agent supports. The
+	// dispatcher then gives the agent the longest period within both this
+	// range and its own policy. Agents that don't set them are given the
+	// dispatcher's default period.
+	MinHeartbeatPeriod time.Duration `protobuf:"bytes,4,opt,name=min_heartbeat_period,json=minHeartbeatPeriod,stdduration" json:"min_heartbeat_period"`
+	MaxHeartbeatPeriod time.Duration `protobuf:"bytes,5,opt,name=max_heartbeat_period,json=maxHeartbeatPeriod,stdduration" json:"max_heartbeat_period"`
+	// AgentVersion is the version of the agent, as MAJOR.MINOR.PATCH. The
+	// dispatcher may refuse agents that are older than it supports, which
+	// includes agents that don't report their version.
+	AgentVersion string `protobuf:"bytes,6,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	// NodeID, if set, is the ID of the node the request is made for. It
+	// must match the node ID in the certificate the request is authenticated
+	// with, or the request is rejected with PermissionDenied.
+	NodeID string `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Metadata, if set, is inventory information about the node, such as
+	// its container runtime, OS, kernel and engine versions. It replaces
+	// the metadata recorded on the node object. It is not used for
+	// scheduling.
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *SessionRequest) Reset()                    { *m = SessionRequest{} }
@@ -154,6 +218,13 @@ type SessionMessage struct {
 	// Symmetric encryption key distributed by the lead manager. Used by agents
 	// for securing network bootstrapping and communication.
 	NetworkBootstrapKeys []*EncryptionKey `protobuf:"bytes,4,rep,name=network_bootstrap_keys,json=networkBootstrapKeys" json:"network_bootstrap_keys,omitempty"`
+	// DisconnectReason is set on the last message of a session, when the
+	// dispatcher is about to close it, to explain why.
+	DisconnectReason SessionMessage_DisconnectReason `protobuf:"varint,5,opt,name=disconnect_reason,json=disconnectReason,proto3,enum=docker.swarmkit.v1.SessionMessage_DisconnectReason" json:"disconnect_reason,omitempty"`
+	// RenewCertificate is set when the node's certificate is about to
+	// expire, to have the agent renew it right away rather than when its
+	// own renewal timer fires.
+	RenewCertificate bool `protobuf:"varint,6,opt,name=renew_certificate,json=renewCertificate,proto3" json:"renew_certificate,omitempty"`
 }
 
 func (m *SessionMessage) Reset()                    { *m = SessionMessage{} }
@@ -163,6 +234,10 @@ func (*SessionMessage) Descriptor() ([]byte, []int) { return fileDescriptorDispa
 // HeartbeatRequest provides identifying properties for a single heartbeat.
 type HeartbeatRequest struct {
 	SessionID string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// NodeID, if set, is the ID of the node the request is made for. It
+	// must match the node ID in the certificate the request is authenticated
+	// with, or the request is rejected with PermissionDenied.
+	NodeID string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
 func (m *HeartbeatRequest) Reset()                    { *m = HeartbeatRequest{} }
@@ -173,6 +248,10 @@ type HeartbeatResponse struct {
 	// Period is the duration to wait before sending the next heartbeat.
 	// Well-behaved agents should update this on every heartbeat round trip.
 	Period time.Duration `protobuf:"bytes,1,opt,name=period,stdduration" json:"period"`
+	// Reconnect is set when the dispatcher is about to shut down. Agents
+	// should close their session and reconnect to another manager before
+	// this one stops.
+	Reconnect bool `protobuf:"varint,2,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
 }
 
 func (m *HeartbeatResponse) Reset()                    { *m = HeartbeatResponse{} }
@@ -184,6 +263,10 @@ type UpdateTaskStatusRequest struct {
 	// field must be set. The spec is not required.
 	SessionID string                                      `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	Updates   []*UpdateTaskStatusRequest_TaskStatusUpdate `protobuf:"bytes,3,rep,name=updates" json:"updates,omitempty"`
+	// NodeID, if set, is the ID of the node the request is made for. It
+	// must match the node ID in the certificate the request is authenticated
+	// with, or the request is rejected with PermissionDenied.
+	NodeID string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
 func (m *UpdateTaskStatusRequest) Reset()      { *m = UpdateTaskStatusRequest{} }
@@ -216,6 +299,14 @@ func (*UpdateTaskStatusResponse) Descriptor() ([]byte, []int) {
 
 type TasksRequest struct {
 	SessionID string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// NodeID, if set, is the ID of the node the request is made for. It
+	// must match the node ID in the certificate the request is authenticated
+	// with, or the request is rejected with PermissionDenied.
+	NodeID string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// DesiredStates, if set, restricts the tasks sent to those whose desired
+	// state is one of these. By default, all the tasks assigned to the node
+	// are sent.
+	DesiredStates []TaskState `protobuf:"varint,3,rep,packed,name=desired_states,json=desiredStates,enum=docker.swarmkit.v1.TaskState" json:"desired_states,omitempty"`
 }
 
 func (m *TasksRequest) Reset()                    { *m = TasksRequest{} }
@@ -226,6 +317,15 @@ type TasksMessage struct {
 	// Tasks is the set of tasks that should be running on the node.
 	// Tasks outside of this set running on the node should be terminated.
 	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	// Version identifies this set of tasks. It increases with every
+	// message sent to the node, and is at least the highest store version
+	// of the tasks it was built from.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// PreviousVersion is the Version of the previous message sent on this
+	// stream, or 0 for the first message of a stream. If it does not match
+	// the Version last received, the consumer of the stream missed a
+	// message and should start a new Tasks stream to re-sync.
+	PreviousVersion uint64 `protobuf:"varint,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
 }
 
 func (m *TasksMessage) Reset()                    { *m = TasksMessage{} }
@@ -234,6 +334,10 @@ func (*TasksMessage) Descriptor() ([]byte, []int) { return fileDescriptorDispatc
 
 type AssignmentsRequest struct {
 	SessionID string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// NodeID, if set, is the ID of the node the request is made for. It
+	// must match the node ID in the certificate the request is authenticated
+	// with, or the request is rejected with PermissionDenied.
+	NodeID string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
 func (m *AssignmentsRequest) Reset()                    { *m = AssignmentsRequest{} }
@@ -365,6 +469,10 @@ func _Assignment_OneofSizer(msg proto.Message) (n int) {
 type AssignmentChange struct {
 	Assignment *Assignment                       `protobuf:"bytes,1,opt,name=assignment" json:"assignment,omitempty"`
 	Action     AssignmentChange_AssignmentAction `protobuf:"varint,2,opt,name=action,proto3,enum=docker.swarmkit.v1.AssignmentChange_AssignmentAction" json:"action,omitempty"`
+	// Reason is a human-readable explanation of why the assignment was
+	// updated or removed, such as the task being scheduled or its service
+	// being removed. It is only informational, and may be empty.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *AssignmentChange) Reset()                    { *m = AssignmentChange{} }
@@ -405,6 +513,7 @@ func init() {
 	proto.RegisterType((*Assignment)(nil), "docker.swarmkit.v1.Assignment")
 	proto.RegisterType((*AssignmentChange)(nil), "docker.swarmkit.v1.AssignmentChange")
 	proto.RegisterType((*AssignmentsMessage)(nil), "docker.swarmkit.v1.AssignmentsMessage")
+	proto.RegisterEnum("docker.swarmkit.v1.SessionMessage_DisconnectReason", SessionMessage_DisconnectReason_name, SessionMessage_DisconnectReason_value)
 	proto.RegisterEnum("docker.swarmkit.v1.AssignmentChange_AssignmentAction", AssignmentChange_AssignmentAction_name, AssignmentChange_AssignmentAction_value)
 	proto.RegisterEnum("docker.swarmkit.v1.AssignmentsMessage_Type", AssignmentsMessage_Type_name, AssignmentsMessage_Type_value)
 }
@@ -445,6 +554,14 @@ func (p *authenticatedWrapperDispatcherServer) UpdateTaskStatus(ctx context.Cont
 	return p.local.UpdateTaskStatus(ctx, r)
 }
 
+func (p *authenticatedWrapperDispatcherServer) UpdateTaskStatusStream(stream Dispatcher_UpdateTaskStatusStreamServer) error {
+
+	if err := p.authorize(stream.Context(), []string{"swarm-worker", "swarm-manager"}); err != nil {
+		return err
+	}
+	return p.local.UpdateTaskStatusStream(stream)
+}
+
 func (p *authenticatedWrapperDispatcherServer) Tasks(r *TasksRequest, stream Dispatcher_TasksServer) error {
 
 	if err := p.authorize(stream.Context(), []string{"swarm-worker", "swarm-manager"}); err != nil {
@@ -478,6 +595,15 @@ func (m *SessionRequest) CopyFrom(src interface{}) {
 		m.Description = &NodeDescription{}
 		github_com_docker_swarmkit_api_deepcopy.Copy(m.Description, o.Description)
 	}
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.MinHeartbeatPeriod, &o.MinHeartbeatPeriod)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.MaxHeartbeatPeriod, &o.MaxHeartbeatPeriod)
+	if o.Metadata != nil {
+		m.Metadata = make(map[string]string, len(o.Metadata))
+		for k, v := range o.Metadata {
+			m.Metadata[k] = v
+		}
+	}
+
 }
 
 func (m *SessionMessage) Copy() *SessionMessage {
@@ -611,6 +737,11 @@ func (m *TasksRequest) CopyFrom(src interface{}) {
 
 	o := src.(*TasksRequest)
 	*m = *o
+	if o.DesiredStates != nil {
+		m.DesiredStates = make([]TaskState, len(o.DesiredStates))
+		copy(m.DesiredStates, o.DesiredStates)
+	}
+
 }
 
 func (m *TasksMessage) Copy() *TasksMessage {
@@ -756,6 +887,12 @@ type DispatcherClient interface {
 	// If a task is unknown the dispatcher, the status update should be
 	// accepted regardless.
 	UpdateTaskStatus(ctx context.Context, in *UpdateTaskStatusRequest, opts ...grpc.CallOption) (*UpdateTaskStatusResponse, error)
+	// UpdateTaskStatusStream is like UpdateTaskStatus, but lets a node stream
+	// any number of status updates over a single call. The dispatcher
+	// coalesces them into as few store transactions as it can. The response
+	// is sent once the node closes the stream. If the call fails, the updates
+	// sent over it may not have been applied, and should be sent again.
+	UpdateTaskStatusStream(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_UpdateTaskStatusStreamClient, error)
 	// Tasks is a stream of tasks state for node. Each message contains full list
 	// of tasks which should be run on node, if task is not present in that list,
 	// it should be terminated.
@@ -825,8 +962,42 @@ func (c *dispatcherClient) UpdateTaskStatus(ctx context.Context, in *UpdateTaskS
 	return out, nil
 }
 
+func (c *dispatcherClient) UpdateTaskStatusStream(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_UpdateTaskStatusStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Dispatcher_serviceDesc.Streams[1], c.cc, "/docker.swarmkit.v1.Dispatcher/UpdateTaskStatusStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dispatcherUpdateTaskStatusStreamClient{stream}
+	return x, nil
+}
+
+type Dispatcher_UpdateTaskStatusStreamClient interface {
+	Send(*UpdateTaskStatusRequest) error
+	CloseAndRecv() (*UpdateTaskStatusResponse, error)
+	grpc.ClientStream
+}
+
+type dispatcherUpdateTaskStatusStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *dispatcherUpdateTaskStatusStreamClient) Send(m *UpdateTaskStatusRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dispatcherUpdateTaskStatusStreamClient) CloseAndRecv() (*UpdateTaskStatusResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UpdateTaskStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dispatcherClient) Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (Dispatcher_TasksClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Dispatcher_serviceDesc.Streams[1], c.cc, "/docker.swarmkit.v1.Dispatcher/Tasks", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Dispatcher_serviceDesc.Streams[2], c.cc, "/docker.swarmkit.v1.Dispatcher/Tasks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -858,7 +1029,7 @@ func (x *dispatcherTasksClient) Recv() (*TasksMessage, error) {
 }
 
 func (c *dispatcherClient) Assignments(ctx context.Context, in *AssignmentsRequest, opts ...grpc.CallOption) (Dispatcher_AssignmentsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Dispatcher_serviceDesc.Streams[2], c.cc, "/docker.swarmkit.v1.Dispatcher/Assignments", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Dispatcher_serviceDesc.Streams[3], c.cc, "/docker.swarmkit.v1.Dispatcher/Assignments", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -912,6 +1083,12 @@ type DispatcherServer interface {
 	// If a task is unknown the dispatcher, the status update should be
 	// accepted regardless.
 	UpdateTaskStatus(context.Context, *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error)
+	// UpdateTaskStatusStream is like UpdateTaskStatus, but lets a node stream
+	// any number of status updates over a single call. The dispatcher
+	// coalesces them into as few store transactions as it can. The response
+	// is sent once the node closes the stream. If the call fails, the updates
+	// sent over it may not have been applied, and should be sent again.
+	UpdateTaskStatusStream(Dispatcher_UpdateTaskStatusStreamServer) error
 	// Tasks is a stream of tasks state for node. Each message contains full list
 	// of tasks which should be run on node, if task is not present in that list,
 	// it should be terminated.
@@ -984,6 +1161,32 @@ func _Dispatcher_UpdateTaskStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_UpdateTaskStatusStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DispatcherServer).UpdateTaskStatusStream(&dispatcherUpdateTaskStatusStreamServer{stream})
+}
+
+type Dispatcher_UpdateTaskStatusStreamServer interface {
+	SendAndClose(*UpdateTaskStatusResponse) error
+	Recv() (*UpdateTaskStatusRequest, error)
+	grpc.ServerStream
+}
+
+type dispatcherUpdateTaskStatusStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *dispatcherUpdateTaskStatusStreamServer) SendAndClose(m *UpdateTaskStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dispatcherUpdateTaskStatusStreamServer) Recv() (*UpdateTaskStatusRequest, error) {
+	m := new(UpdateTaskStatusRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Dispatcher_Tasks_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(TasksRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1045,6 +1248,11 @@ var _Dispatcher_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Dispatcher_Session_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UpdateTaskStatusStream",
+			Handler:       _Dispatcher_UpdateTaskStatusStream_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Tasks",
 			Handler:       _Dispatcher_Tasks_Handler,
@@ -1090,6 +1298,57 @@ func (m *SessionRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.SessionID)))
 		i += copy(dAtA[i:], m.SessionID)
 	}
+	if len(m.AdvertiseAddr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.AdvertiseAddr)))
+		i += copy(dAtA[i:], m.AdvertiseAddr)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintDispatcher(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinHeartbeatPeriod)))
+	n2, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinHeartbeatPeriod, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintDispatcher(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxHeartbeatPeriod)))
+	n3, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxHeartbeatPeriod, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.AgentVersion) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.AgentVersion)))
+		i += copy(dAtA[i:], m.AgentVersion)
+	}
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x42
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovDispatcher(uint64(len(k))) + 1 + len(v) + sovDispatcher(uint64(len(v)))
+			i = encodeVarintDispatcher(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintDispatcher(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDispatcher(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -1118,11 +1377,11 @@ func (m *SessionMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Node.Size()))
-		n2, err := m.Node.MarshalTo(dAtA[i:])
+		n4, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n4
 	}
 	if len(m.Managers) > 0 {
 		for _, msg := range m.Managers {
@@ -1148,6 +1407,21 @@ func (m *SessionMessage) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.DisconnectReason != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(m.DisconnectReason))
+	}
+	if m.RenewCertificate {
+		dAtA[i] = 0x30
+		i++
+		if m.RenewCertificate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1172,6 +1446,12 @@ func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.SessionID)))
 		i += copy(dAtA[i:], m.SessionID)
 	}
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
 	return i, nil
 }
 
@@ -1193,11 +1473,21 @@ func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintDispatcher(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)))
-	n3, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i:])
+	n5, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n5
+	if m.Reconnect {
+		dAtA[i] = 0x10
+		i++
+		if m.Reconnect {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1234,6 +1524,12 @@ func (m *UpdateTaskStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
 	return i, nil
 }
 
@@ -1262,11 +1558,11 @@ func (m *UpdateTaskStatusRequest_TaskStatusUpdate) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Status.Size()))
-		n4, err := m.Status.MarshalTo(dAtA[i:])
+		n6, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	return i, nil
 }
@@ -1310,6 +1606,29 @@ func (m *TasksRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.SessionID)))
 		i += copy(dAtA[i:], m.SessionID)
 	}
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
+	if len(m.DesiredStates) > 0 {
+		dAtA8 := make([]byte, len(m.DesiredStates)*10)
+		var j7 int
+		for _, num := range m.DesiredStates {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
 	return i, nil
 }
 
@@ -1340,6 +1659,16 @@ func (m *TasksMessage) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(m.Version))
+	}
+	if m.PreviousVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(m.PreviousVersion))
+	}
 	return i, nil
 }
 
@@ -1364,6 +1693,12 @@ func (m *AssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.SessionID)))
 		i += copy(dAtA[i:], m.SessionID)
 	}
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
 	return i, nil
 }
 
@@ -1383,11 +1718,11 @@ func (m *Assignment) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Item != nil {
-		nn5, err := m.Item.MarshalTo(dAtA[i:])
+		nn9, err := m.Item.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn5
+		i += nn9
 	}
 	return i, nil
 }
@@ -1398,11 +1733,11 @@ func (m *Assignment_Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Task.Size()))
-		n6, err := m.Task.MarshalTo(dAtA[i:])
+		n10, err := m.Task.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n10
 	}
 	return i, nil
 }
@@ -1412,11 +1747,11 @@ func (m *Assignment_Secret) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Secret.Size()))
-		n7, err := m.Secret.MarshalTo(dAtA[i:])
+		n11, err := m.Secret.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n11
 	}
 	return i, nil
 }
@@ -1439,17 +1774,23 @@ func (m *AssignmentChange) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Assignment.Size()))
-		n8, err := m.Assignment.MarshalTo(dAtA[i:])
+		n12, err := m.Assignment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	if m.Action != 0 {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Action))
 	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
 	return i, nil
 }
 
@@ -1721,6 +2062,63 @@ func (p *raftProxyDispatcherServer) UpdateTaskStatus(ctx context.Context, r *Upd
 	return resp, err
 }
 
+type Dispatcher_UpdateTaskStatusStreamServerWrapper struct {
+	Dispatcher_UpdateTaskStatusStreamServer
+	ctx context.Context
+}
+
+func (s Dispatcher_UpdateTaskStatusStreamServerWrapper) Context() context.Context {
+	return s.ctx
+}
+
+func (p *raftProxyDispatcherServer) UpdateTaskStatusStream(stream Dispatcher_UpdateTaskStatusStreamServer) error {
+	ctx := stream.Context()
+	conn, err := p.connSelector.LeaderConn(ctx)
+	if err != nil {
+		if err == raftselector.ErrIsLeader {
+			ctx, err = p.runCtxMods(ctx, p.localCtxMods)
+			if err != nil {
+				return err
+			}
+			streamWrapper := Dispatcher_UpdateTaskStatusStreamServerWrapper{
+				Dispatcher_UpdateTaskStatusStreamServer: stream,
+				ctx:                                     ctx,
+			}
+			return p.local.UpdateTaskStatusStream(streamWrapper)
+		}
+		return err
+	}
+	ctx, err = p.runCtxMods(ctx, p.remoteCtxMods)
+	if err != nil {
+		return err
+	}
+	clientStream, err := NewDispatcherClient(conn).UpdateTaskStatusStream(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := clientStream.Send(msg); err != nil {
+			return err
+		}
+	}
+
+	reply, err := clientStream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(reply)
+}
+
 type Dispatcher_TasksServerWrapper struct {
 	Dispatcher_TasksServer
 	ctx context.Context
@@ -1834,6 +2232,30 @@ func (m *SessionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDispatcher(uint64(l))
 	}
+	l = len(m.AdvertiseAddr)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinHeartbeatPeriod)
+	n += 1 + l + sovDispatcher(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxHeartbeatPeriod)
+	n += 1 + l + sovDispatcher(uint64(l))
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDispatcher(uint64(len(k))) + 1 + len(v) + sovDispatcher(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDispatcher(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1860,6 +2282,12 @@ func (m *SessionMessage) Size() (n int) {
 			n += 1 + l + sovDispatcher(uint64(l))
 		}
 	}
+	if m.DisconnectReason != 0 {
+		n += 1 + sovDispatcher(uint64(m.DisconnectReason))
+	}
+	if m.RenewCertificate {
+		n += 2
+	}
 	return n
 }
 
@@ -1870,6 +2298,10 @@ func (m *HeartbeatRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDispatcher(uint64(l))
 	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
 	return n
 }
 
@@ -1878,6 +2310,9 @@ func (m *HeartbeatResponse) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
 	n += 1 + l + sovDispatcher(uint64(l))
+	if m.Reconnect {
+		n += 2
+	}
 	return n
 }
 
@@ -1894,6 +2329,10 @@ func (m *UpdateTaskStatusRequest) Size() (n int) {
 			n += 1 + l + sovDispatcher(uint64(l))
 		}
 	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
 	return n
 }
 
@@ -1924,6 +2363,17 @@ func (m *TasksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDispatcher(uint64(l))
 	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	if len(m.DesiredStates) > 0 {
+		l = 0
+		for _, e := range m.DesiredStates {
+			l += sovDispatcher(uint64(e))
+		}
+		n += 1 + sovDispatcher(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1936,6 +2386,12 @@ func (m *TasksMessage) Size() (n int) {
 			n += 1 + l + sovDispatcher(uint64(l))
 		}
 	}
+	if m.Version != 0 {
+		n += 1 + sovDispatcher(uint64(m.Version))
+	}
+	if m.PreviousVersion != 0 {
+		n += 1 + sovDispatcher(uint64(m.PreviousVersion))
+	}
 	return n
 }
 
@@ -1946,6 +2402,10 @@ func (m *AssignmentsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDispatcher(uint64(l))
 	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
 	return n
 }
 
@@ -1986,6 +2446,10 @@ func (m *AssignmentChange) Size() (n int) {
 	if m.Action != 0 {
 		n += 1 + sovDispatcher(uint64(m.Action))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
 	return n
 }
 
@@ -2029,9 +2493,25 @@ func (this *SessionRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k, _ := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
 	s := strings.Join([]string{`&SessionRequest{`,
 		`Description:` + strings.Replace(fmt.Sprintf("%v", this.Description), "NodeDescription", "NodeDescription", 1) + `,`,
 		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`AdvertiseAddr:` + fmt.Sprintf("%v", this.AdvertiseAddr) + `,`,
+		`MinHeartbeatPeriod:` + strings.Replace(strings.Replace(this.MinHeartbeatPeriod.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`MaxHeartbeatPeriod:` + strings.Replace(strings.Replace(this.MaxHeartbeatPeriod.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`AgentVersion:` + fmt.Sprintf("%v", this.AgentVersion) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2045,6 +2525,8 @@ func (this *SessionMessage) String() string {
 		`Node:` + strings.Replace(fmt.Sprintf("%v", this.Node), "Node", "Node", 1) + `,`,
 		`Managers:` + strings.Replace(fmt.Sprintf("%v", this.Managers), "WeightedPeer", "WeightedPeer", 1) + `,`,
 		`NetworkBootstrapKeys:` + strings.Replace(fmt.Sprintf("%v", this.NetworkBootstrapKeys), "EncryptionKey", "EncryptionKey", 1) + `,`,
+		`DisconnectReason:` + fmt.Sprintf("%v", this.DisconnectReason) + `,`,
+		`RenewCertificate:` + fmt.Sprintf("%v", this.RenewCertificate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2055,6 +2537,7 @@ func (this *HeartbeatRequest) String() string {
 	}
 	s := strings.Join([]string{`&HeartbeatRequest{`,
 		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2065,6 +2548,7 @@ func (this *HeartbeatResponse) String() string {
 	}
 	s := strings.Join([]string{`&HeartbeatResponse{`,
 		`Period:` + strings.Replace(strings.Replace(this.Period.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Reconnect:` + fmt.Sprintf("%v", this.Reconnect) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2076,6 +2560,7 @@ func (this *UpdateTaskStatusRequest) String() string {
 	s := strings.Join([]string{`&UpdateTaskStatusRequest{`,
 		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
 		`Updates:` + strings.Replace(fmt.Sprintf("%v", this.Updates), "UpdateTaskStatusRequest_TaskStatusUpdate", "UpdateTaskStatusRequest_TaskStatusUpdate", 1) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2106,6 +2591,8 @@ func (this *TasksRequest) String() string {
 	}
 	s := strings.Join([]string{`&TasksRequest{`,
 		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`DesiredStates:` + fmt.Sprintf("%v", this.DesiredStates) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2116,6 +2603,8 @@ func (this *TasksMessage) String() string {
 	}
 	s := strings.Join([]string{`&TasksMessage{`,
 		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "Task", "Task", 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`PreviousVersion:` + fmt.Sprintf("%v", this.PreviousVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2126,6 +2615,7 @@ func (this *AssignmentsRequest) String() string {
 	}
 	s := strings.Join([]string{`&AssignmentsRequest{`,
 		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2167,6 +2657,7 @@ func (this *AssignmentChange) String() string {
 	s := strings.Join([]string{`&AssignmentChange{`,
 		`Assignment:` + strings.Replace(fmt.Sprintf("%v", this.Assignment), "Assignment", "Assignment", 1) + `,`,
 		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2283,59 +2774,322 @@ func (m *SessionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDispatcher(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDispatcher
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SessionMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDispatcher
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SessionMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvertiseAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdvertiseAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeartbeatPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinHeartbeatPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeartbeatPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxHeartbeatPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDispatcher
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDispatcher
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthDispatcher
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispatcher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispatcher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2457,6 +3211,45 @@ func (m *SessionMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisconnectReason", wireType)
+			}
+			m.DisconnectReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisconnectReason |= (SessionMessage_DisconnectReason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewCertificate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenewCertificate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -2536,6 +3329,35 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -2616,6 +3438,26 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconnect", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reconnect = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -2726,6 +3568,35 @@ func (m *UpdateTaskStatusRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -2967,6 +3838,97 @@ func (m *TasksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDispatcher
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDispatcher
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v TaskState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDispatcher
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (TaskState(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DesiredStates = append(m.DesiredStates, v)
+				}
+			} else if wireType == 0 {
+				var v TaskState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDispatcher
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (TaskState(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DesiredStates = append(m.DesiredStates, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredStates", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -3048,6 +4010,44 @@ func (m *TasksMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			m.PreviousVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -3127,6 +4127,35 @@ func (m *AssignmentsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -3343,6 +4372,35 @@ func (m *AssignmentChange) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -3630,65 +4688,94 @@ var (
 func init() { proto.RegisterFile("dispatcher.proto", fileDescriptorDispatcher) }
 
 var fileDescriptorDispatcher = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xe3, 0x54,
-	0x14, 0x8e, 0xd3, 0xd4, 0x6d, 0x4f, 0xda, 0x12, 0x2e, 0xa3, 0xc1, 0x58, 0x9a, 0x34, 0xb8, 0x4c,
-	0x54, 0x69, 0x8a, 0x3b, 0x84, 0x9f, 0x0d, 0x55, 0xa1, 0x69, 0x22, 0x35, 0x9a, 0x49, 0xa7, 0xba,
-	0xcd, 0xcc, 0x2c, 0x23, 0x27, 0x3e, 0xb8, 0x26, 0x8d, 0xaf, 0xf1, 0xbd, 0x99, 0x21, 0x48, 0x48,
-	0x48, 0x30, 0x12, 0x62, 0x85, 0x58, 0x75, 0xc3, 0x2b, 0xf0, 0x1c, 0x15, 0x2b, 0x96, 0xac, 0x0a,
-	0x93, 0x07, 0xe0, 0x01, 0x58, 0x21, 0xdb, 0xd7, 0x49, 0xc8, 0x24, 0x9d, 0xb4, 0xab, 0xc4, 0xe7,
-	0x7c, 0xdf, 0x39, 0x9f, 0xbf, 0x7b, 0x7c, 0x2e, 0xe4, 0x6c, 0x97, 0xfb, 0x96, 0x68, 0x9f, 0x62,
-	0x60, 0xfa, 0x01, 0x13, 0x8c, 0x10, 0x9b, 0xb5, 0x3b, 0x18, 0x98, 0xfc, 0xb9, 0x15, 0x74, 0x3b,
-	0xae, 0x30, 0x9f, 0x7d, 0xa0, 0x67, 0x45, 0xdf, 0x47, 0x1e, 0x03, 0xf4, 0x35, 0xd6, 0xfa, 0x12,
-	0xdb, 0x22, 0x79, 0xbc, 0xe5, 0x30, 0x87, 0x45, 0x7f, 0x77, 0xc2, 0x7f, 0x32, 0xfa, 0x96, 0x7f,
-	0xd6, 0x73, 0x5c, 0x6f, 0x27, 0xfe, 0x91, 0xc1, 0xbc, 0xc3, 0x98, 0x73, 0x86, 0x3b, 0xd1, 0x53,
-	0xab, 0xf7, 0xc5, 0x8e, 0xdd, 0x0b, 0x2c, 0xe1, 0x32, 0x99, 0x37, 0x5e, 0x28, 0xb0, 0x7e, 0x82,
-	0x9c, 0xbb, 0xcc, 0xa3, 0xf8, 0x55, 0x0f, 0xb9, 0x20, 0x55, 0xc8, 0xda, 0xc8, 0xdb, 0x81, 0xeb,
-	0x87, 0x38, 0x4d, 0x29, 0x28, 0x5b, 0xd9, 0xd2, 0xa6, 0xf9, 0xaa, 0x46, 0xf3, 0x88, 0xd9, 0x58,
-	0x19, 0x41, 0xe9, 0x38, 0x8f, 0x6c, 0x03, 0xf0, 0xb8, 0x70, 0xd3, 0xb5, 0xb5, 0x74, 0x41, 0xd9,
-	0x5a, 0x29, 0xaf, 0x0d, 0x2e, 0x37, 0x56, 0x64, 0xbb, 0x5a, 0x85, 0xae, 0x48, 0x40, 0xcd, 0x36,
-	0xbe, 0x4f, 0x0f, 0x75, 0xd4, 0x91, 0x73, 0xcb, 0xc1, 0x89, 0x02, 0xca, 0xd5, 0x05, 0xc8, 0x36,
-	0x64, 0x3c, 0x66, 0x63, 0xd4, 0x28, 0x5b, 0xd2, 0x66, 0xc9, 0xa5, 0x11, 0x8a, 0xec, 0xc2, 0x72,
-	0xd7, 0xf2, 0x2c, 0x07, 0x03, 0xae, 0x2d, 0x14, 0x16, 0xb6, 0xb2, 0xa5, 0xc2, 0x34, 0xc6, 0x53,
-	0x74, 0x9d, 0x53, 0x81, 0xf6, 0x31, 0x62, 0x40, 0x87, 0x0c, 0xf2, 0x14, 0x6e, 0x7b, 0x28, 0x9e,
-	0xb3, 0xa0, 0xd3, 0x6c, 0x31, 0x26, 0xb8, 0x08, 0x2c, 0xbf, 0xd9, 0xc1, 0x3e, 0xd7, 0x32, 0x51,
-	0xad, 0x77, 0xa7, 0xd5, 0xaa, 0x7a, 0xed, 0xa0, 0x1f, 0x59, 0xf3, 0x00, 0xfb, 0xf4, 0x96, 0x2c,
-	0x50, 0x4e, 0xf8, 0x0f, 0xb0, 0xcf, 0x8d, 0xcf, 0x21, 0x77, 0x88, 0x56, 0x20, 0x5a, 0x68, 0x89,
-	0xe4, 0x38, 0xae, 0x65, 0x83, 0x71, 0x0c, 0x6f, 0x8e, 0x55, 0xe0, 0x3e, 0xf3, 0x38, 0x92, 0x4f,
-	0x41, 0xf5, 0x31, 0x70, 0x99, 0x2d, 0x0f, 0xf3, 0x1d, 0x33, 0x9e, 0x0a, 0x33, 0x99, 0x0a, 0xb3,
-	0x22, 0xa7, 0xa2, 0xbc, 0x7c, 0x71, 0xb9, 0x91, 0x3a, 0xff, 0x6b, 0x43, 0xa1, 0x92, 0x62, 0xfc,
-	0x9c, 0x86, 0xb7, 0x1f, 0xfb, 0xb6, 0x25, 0xb0, 0x61, 0xf1, 0xce, 0x89, 0xb0, 0x44, 0x8f, 0xdf,
-	0x48, 0x1b, 0x79, 0x02, 0x4b, 0xbd, 0xa8, 0x50, 0xe2, 0xf9, 0xee, 0x34, 0x9f, 0x66, 0xf4, 0x32,
-	0x47, 0x91, 0x18, 0x41, 0x93, 0x62, 0x3a, 0x83, 0xdc, 0x64, 0x92, 0x6c, 0xc2, 0x92, 0xb0, 0x78,
-	0x67, 0x24, 0x0b, 0x06, 0x97, 0x1b, 0x6a, 0x08, 0xab, 0x55, 0xa8, 0x1a, 0xa6, 0x6a, 0x36, 0xf9,
-	0x04, 0x54, 0x1e, 0x91, 0xe4, 0xd4, 0xe4, 0xa7, 0xe9, 0x19, 0x53, 0x22, 0xd1, 0x86, 0x0e, 0xda,
-	0xab, 0x2a, 0x63, 0xaf, 0x8d, 0x5d, 0x58, 0x0d, 0xa3, 0x37, 0xb3, 0xc8, 0xd8, 0x93, 0xec, 0xe4,
-	0x1b, 0x30, 0x61, 0x31, 0xd4, 0xca, 0x35, 0x25, 0x32, 0x4c, 0x9b, 0x25, 0x90, 0xc6, 0x30, 0xa3,
-	0x0c, 0x64, 0x9f, 0x73, 0xd7, 0xf1, 0xba, 0xe8, 0x89, 0x1b, 0x6a, 0xf8, 0x06, 0x60, 0x54, 0x83,
-	0x98, 0x90, 0x09, 0x4b, 0xcb, 0xc9, 0x99, 0x29, 0xe0, 0x30, 0x45, 0x23, 0x1c, 0xf9, 0x08, 0x54,
-	0x8e, 0xed, 0x00, 0x85, 0xf4, 0x54, 0x9f, 0xc6, 0x38, 0x89, 0x10, 0x87, 0x29, 0x2a, 0xb1, 0x65,
-	0x15, 0x32, 0xae, 0xc0, 0xae, 0xf1, 0x22, 0x0d, 0xb9, 0x51, 0xf3, 0x83, 0x53, 0xcb, 0x73, 0x90,
-	0xec, 0x01, 0x58, 0xc3, 0x98, 0x14, 0x32, 0xf5, 0xa8, 0x46, 0x4c, 0x3a, 0xc6, 0x20, 0x75, 0x50,
-	0xad, 0x76, 0xb4, 0xcb, 0x42, 0x49, 0xeb, 0xa5, 0x8f, 0xaf, 0xe6, 0xc6, 0x5d, 0xc7, 0x02, 0xfb,
-	0x11, 0x99, 0xca, 0x22, 0x46, 0x6b, 0x5c, 0x62, 0x9c, 0x23, 0x45, 0x50, 0x1f, 0x1f, 0x57, 0xf6,
-	0x1b, 0xd5, 0x5c, 0x4a, 0xd7, 0x7f, 0xfa, 0xb5, 0x70, 0x7b, 0x12, 0x21, 0xc7, 0xb2, 0x08, 0x2a,
-	0xad, 0xd6, 0x1f, 0x3d, 0xa9, 0xe6, 0x94, 0xe9, 0x38, 0x8a, 0x5d, 0xf6, 0x0c, 0x8d, 0x7f, 0x95,
-	0xff, 0x1d, 0x64, 0x32, 0x0e, 0x9f, 0x41, 0x26, 0xbc, 0x16, 0x22, 0x0f, 0xd6, 0x4b, 0xf7, 0xae,
-	0x7e, 0x8f, 0x84, 0x65, 0x36, 0xfa, 0x3e, 0xd2, 0x88, 0x48, 0xee, 0x00, 0x58, 0xbe, 0x7f, 0xe6,
-	0x22, 0x6f, 0x0a, 0x16, 0x2f, 0x65, 0xba, 0x22, 0x23, 0x0d, 0x16, 0xa6, 0x03, 0xe4, 0xbd, 0x33,
-	0xc1, 0x9b, 0xae, 0xa7, 0x2d, 0xc4, 0x69, 0x19, 0xa9, 0x79, 0x64, 0x0f, 0x96, 0xda, 0x91, 0x39,
-	0xc9, 0xa2, 0x7b, 0x6f, 0x1e, 0x27, 0x69, 0x42, 0x32, 0xee, 0x42, 0x26, 0xd4, 0x42, 0x56, 0x61,
-	0xf9, 0xe0, 0x51, 0xfd, 0xf8, 0x61, 0x35, 0xf4, 0x8b, 0xbc, 0x01, 0xd9, 0xda, 0xd1, 0x01, 0xad,
-	0xd6, 0xab, 0x47, 0x8d, 0xfd, 0x87, 0x39, 0xa5, 0x74, 0xbe, 0x08, 0x50, 0x19, 0xde, 0x91, 0xe4,
-	0x6b, 0x58, 0x92, 0x73, 0x4a, 0x8c, 0xe9, 0xc3, 0x34, 0x7e, 0x7d, 0xe9, 0x57, 0x61, 0xa4, 0x23,
-	0xc6, 0xe6, 0xef, 0xbf, 0xfd, 0x73, 0x9e, 0xbe, 0x03, 0xab, 0x11, 0xe6, 0xfd, 0x70, 0x11, 0x63,
-	0x00, 0x6b, 0xf1, 0x93, 0x5c, 0xf3, 0xf7, 0x15, 0xf2, 0x2d, 0xac, 0x0c, 0x97, 0x29, 0x99, 0xfa,
-	0xae, 0x93, 0xdb, 0x5a, 0xbf, 0xfb, 0x1a, 0x94, 0xdc, 0x12, 0xf3, 0x08, 0x20, 0xbf, 0x28, 0x90,
-	0x9b, 0xdc, 0x33, 0xe4, 0xde, 0x35, 0x76, 0xa6, 0xbe, 0x3d, 0x1f, 0xf8, 0x3a, 0xa2, 0x7a, 0xb0,
-	0x18, 0x6d, 0x28, 0x52, 0x98, 0xb5, 0x0a, 0x86, 0xdd, 0x67, 0x23, 0x92, 0x73, 0x28, 0xce, 0xd1,
-	0xf1, 0xc7, 0xb4, 0x72, 0x5f, 0x21, 0x3f, 0x28, 0x90, 0x1d, 0x1b, 0x6d, 0x52, 0x7c, 0xcd, 0xec,
-	0x27, 0x1a, 0x8a, 0xf3, 0x7d, 0x23, 0x73, 0x4e, 0x44, 0x59, 0xbb, 0x78, 0x99, 0x4f, 0xfd, 0xf9,
-	0x32, 0x9f, 0xfa, 0x6e, 0x90, 0x57, 0x2e, 0x06, 0x79, 0xe5, 0x8f, 0x41, 0x5e, 0xf9, 0x7b, 0x90,
-	0x57, 0x5a, 0x6a, 0x74, 0x97, 0x7e, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0xa6, 0xd5,
-	0x2c, 0xde, 0x09, 0x00, 0x00,
+	// 1414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x65, 0x59, 0x96, 0xc6, 0x1f, 0x61, 0xf6, 0xf9, 0xf9, 0x31, 0x7c, 0x89, 0xac, 0x47,
+	0x27, 0x86, 0x5f, 0x93, 0xca, 0x89, 0xd2, 0x2f, 0x34, 0x41, 0x0a, 0xdb, 0x12, 0x60, 0x21, 0xb6,
+	0x6c, 0xac, 0xe5, 0xe4, 0xa8, 0xae, 0xc5, 0x89, 0xcc, 0xda, 0x22, 0x55, 0xee, 0xca, 0x8e, 0x0a,
+	0x14, 0x28, 0xd0, 0x1e, 0x5a, 0x1f, 0x7b, 0x0a, 0x0a, 0xf8, 0xdc, 0x5b, 0x7b, 0xca, 0xff, 0x10,
+	0xf4, 0xd4, 0x63, 0x4f, 0x69, 0xe3, 0x3f, 0xa0, 0xa7, 0x9e, 0x7a, 0x2a, 0xb8, 0x5c, 0x4a, 0x8a,
+	0x2c, 0xf9, 0xa3, 0x40, 0xd0, 0x13, 0xb9, 0xb3, 0xbf, 0xdf, 0xec, 0xcc, 0xec, 0xcc, 0xec, 0x80,
+	0x6e, 0x3b, 0xbc, 0xc1, 0x44, 0x75, 0x07, 0xfd, 0x6c, 0xc3, 0xf7, 0x84, 0x47, 0x88, 0xed, 0x55,
+	0x77, 0xd1, 0xcf, 0xf2, 0x03, 0xe6, 0xd7, 0x77, 0x1d, 0x91, 0xdd, 0xbf, 0x63, 0x8e, 0x89, 0x56,
+	0x03, 0x79, 0x08, 0x30, 0x27, 0xbc, 0xed, 0x4f, 0xb0, 0x2a, 0xa2, 0xe5, 0x54, 0xcd, 0xab, 0x79,
+	0xf2, 0x77, 0x21, 0xf8, 0x53, 0xd2, 0x7f, 0x35, 0xf6, 0x9a, 0x35, 0xc7, 0x5d, 0x08, 0x3f, 0x4a,
+	0x98, 0xae, 0x79, 0x5e, 0x6d, 0x0f, 0x17, 0xe4, 0x6a, 0xbb, 0xf9, 0x64, 0xc1, 0x6e, 0xfa, 0x4c,
+	0x38, 0x9e, 0xda, 0xb7, 0x7e, 0x8c, 0xc3, 0xe4, 0x26, 0x72, 0xee, 0x78, 0x2e, 0xc5, 0x4f, 0x9b,
+	0xc8, 0x05, 0x29, 0xc0, 0x98, 0x8d, 0xbc, 0xea, 0x3b, 0x8d, 0x00, 0x67, 0x68, 0x19, 0x6d, 0x7e,
+	0x2c, 0x37, 0x9b, 0x3d, 0x69, 0x63, 0xb6, 0xe4, 0xd9, 0x98, 0xef, 0x40, 0x69, 0x37, 0x8f, 0xdc,
+	0x02, 0xe0, 0xa1, 0xe2, 0x8a, 0x63, 0x1b, 0xb1, 0x8c, 0x36, 0x9f, 0x5a, 0x9a, 0x38, 0x7e, 0x39,
+	0x93, 0x52, 0xc7, 0x15, 0xf3, 0x34, 0xa5, 0x00, 0x45, 0x9b, 0xdc, 0x80, 0x49, 0x66, 0xef, 0xa3,
+	0x2f, 0x1c, 0x8e, 0x15, 0x66, 0xdb, 0xbe, 0x31, 0x1c, 0x30, 0xe8, 0x44, 0x5b, 0xba, 0x68, 0xdb,
+	0x3e, 0xd9, 0x82, 0xa9, 0xba, 0xe3, 0x56, 0x76, 0x90, 0xf9, 0x62, 0x1b, 0x99, 0xa8, 0x34, 0xd0,
+	0x77, 0x3c, 0xdb, 0x88, 0x4b, 0x23, 0xaf, 0x64, 0x43, 0x6f, 0xb3, 0x91, 0xb7, 0xd9, 0xbc, 0xf2,
+	0x76, 0x29, 0xf9, 0xe2, 0xe5, 0xcc, 0xd0, 0xb3, 0x5f, 0x67, 0x34, 0x4a, 0xea, 0x8e, 0xbb, 0x12,
+	0xf1, 0x37, 0x24, 0x5d, 0xaa, 0x65, 0x4f, 0x4f, 0xaa, 0x1d, 0xb9, 0x88, 0x5a, 0xf6, 0xb4, 0x57,
+	0xed, 0x2c, 0x4c, 0xb0, 0x1a, 0xba, 0xa2, 0xb2, 0x8f, 0x7e, 0xe0, 0xa7, 0x91, 0x90, 0x3e, 0x8d,
+	0x4b, 0xe1, 0xa3, 0x50, 0x46, 0x66, 0x61, 0xd4, 0xf5, 0x6c, 0x0c, 0x82, 0x34, 0x2a, 0x83, 0x04,
+	0xc7, 0x2f, 0x67, 0x12, 0x41, 0x68, 0x8b, 0x79, 0x9a, 0x08, 0xb6, 0x8a, 0x36, 0x59, 0x85, 0x64,
+	0x1d, 0x05, 0xb3, 0x99, 0x60, 0x46, 0x32, 0x33, 0x3c, 0x3f, 0x96, 0xbb, 0xdd, 0xef, 0x42, 0x5e,
+	0xbf, 0xc9, 0xec, 0x9a, 0xa2, 0x14, 0x5c, 0xe1, 0xb7, 0x68, 0x5b, 0x83, 0x79, 0x0f, 0x26, 0x5e,
+	0xdb, 0x22, 0x3a, 0x0c, 0xef, 0x62, 0x4b, 0x5e, 0x75, 0x8a, 0x06, 0xbf, 0x64, 0x0a, 0x46, 0xf6,
+	0xd9, 0x5e, 0x13, 0xc3, 0x8b, 0xa3, 0xe1, 0xe2, 0xc3, 0xd8, 0x07, 0x9a, 0xf5, 0xcd, 0x48, 0x3b,
+	0x63, 0xd6, 0x90, 0x73, 0x56, 0xc3, 0x9e, 0xab, 0xd6, 0xce, 0xb8, 0xea, 0x5b, 0x10, 0x0f, 0xbc,
+	0x92, 0x9a, 0xc7, 0x72, 0xc6, 0xa0, 0xc4, 0xa2, 0x12, 0x45, 0xee, 0x43, 0xb2, 0xce, 0x5c, 0x56,
+	0x43, 0x9f, 0x1b, 0xc3, 0xd2, 0xf3, 0x4c, 0x3f, 0xc6, 0x63, 0x74, 0x6a, 0x3b, 0x02, 0xed, 0x0d,
+	0x44, 0x9f, 0xb6, 0x19, 0xe4, 0x31, 0x4c, 0xbb, 0x28, 0x0e, 0x3c, 0x7f, 0xb7, 0xb2, 0xed, 0x79,
+	0x82, 0x0b, 0x9f, 0x35, 0x2a, 0xbb, 0xd8, 0xe2, 0x46, 0x5c, 0xea, 0xfa, 0x5f, 0x3f, 0x5d, 0x05,
+	0xb7, 0xea, 0xb7, 0x64, 0x12, 0x3f, 0xc4, 0x16, 0x9d, 0x52, 0x0a, 0x96, 0x22, 0xfe, 0x43, 0x6c,
+	0x71, 0xf2, 0x31, 0x5c, 0xb6, 0x1d, 0x5e, 0xf5, 0x5c, 0x17, 0xab, 0xa2, 0xe2, 0x23, 0xe3, 0x9e,
+	0x2b, 0xd3, 0x65, 0x32, 0x77, 0xf7, 0x94, 0x9b, 0x51, 0x11, 0xcb, 0xe6, 0xdb, 0x5c, 0x2a, 0xa9,
+	0x54, 0xb7, 0x7b, 0x24, 0xe4, 0x26, 0x5c, 0xf6, 0xd1, 0xc5, 0x83, 0x4a, 0x35, 0xc8, 0xff, 0x27,
+	0x4e, 0x95, 0x09, 0x94, 0x09, 0x94, 0xa4, 0xba, 0xdc, 0x58, 0xee, 0xc8, 0xad, 0x3f, 0x34, 0xd0,
+	0x7b, 0x75, 0x12, 0x0b, 0xe2, 0xa5, 0xf5, 0x52, 0x41, 0x1f, 0x32, 0x8d, 0xc3, 0xa3, 0xcc, 0x54,
+	0xef, 0x7e, 0xc9, 0x73, 0x91, 0x5c, 0x87, 0x91, 0x3c, 0x5d, 0x2c, 0x96, 0x74, 0xcd, 0xbc, 0x72,
+	0x78, 0x94, 0xf9, 0x77, 0x2f, 0x28, 0xef, 0x33, 0xc7, 0x25, 0xef, 0xc3, 0xa5, 0xd5, 0xc2, 0x62,
+	0xbe, 0x40, 0x37, 0x57, 0x8a, 0x1b, 0x95, 0xd5, 0xf5, 0xcd, 0xb2, 0x1e, 0x33, 0xad, 0xc3, 0xa3,
+	0x4c, 0xba, 0x17, 0xbf, 0x8a, 0xcc, 0x46, 0x9f, 0xef, 0x38, 0x8d, 0x55, 0x8f, 0x0b, 0xf2, 0x16,
+	0x24, 0x37, 0x57, 0xb6, 0xca, 0xf9, 0xf5, 0xc7, 0x25, 0x7d, 0xd8, 0xbc, 0x7a, 0x78, 0x94, 0x31,
+	0x7a, 0x19, 0x9b, 0x3b, 0x4d, 0x61, 0x7b, 0x07, 0x2e, 0xb9, 0x03, 0xe3, 0xa5, 0xf5, 0x7c, 0xa1,
+	0x42, 0x0b, 0x6b, 0xeb, 0x8f, 0x0a, 0x79, 0x3d, 0x6e, 0xce, 0x1c, 0x1e, 0x65, 0xfe, 0x7b, 0xd2,
+	0x6c, 0x1b, 0x29, 0xd6, 0xbd, 0x7d, 0xb4, 0x2d, 0x04, 0xbd, 0x5d, 0x73, 0x51, 0xfb, 0xba, 0x58,
+	0x32, 0x76, 0x55, 0x5f, 0x6c, 0x50, 0xf5, 0x59, 0x2e, 0x5c, 0xee, 0x3a, 0x86, 0x37, 0x3c, 0x97,
+	0x23, 0xb9, 0x07, 0x09, 0xd5, 0x25, 0xb4, 0xf3, 0x77, 0x09, 0x45, 0x21, 0x57, 0x21, 0xe5, 0xa3,
+	0x72, 0x4b, 0x1e, 0x9c, 0xa4, 0x1d, 0x81, 0xf5, 0x3c, 0x06, 0xff, 0xd9, 0x6a, 0xd8, 0x4c, 0x60,
+	0x99, 0xf1, 0xdd, 0x4d, 0xc1, 0x44, 0x93, 0xff, 0x3d, 0xf7, 0x1e, 0xc1, 0x68, 0x53, 0x2a, 0x8a,
+	0x8a, 0xe7, 0x7e, 0xbf, 0xe4, 0x1c, 0x70, 0x56, 0xb6, 0x23, 0x09, 0x11, 0x34, 0x52, 0xd6, 0x1d,
+	0xb6, 0xf8, 0xa0, 0xb0, 0x99, 0x1e, 0xe8, 0xbd, 0x1a, 0x02, 0xa2, 0x60, 0x7c, 0xb7, 0x63, 0xbb,
+	0x24, 0x06, 0xb0, 0x80, 0x18, 0x6c, 0x15, 0x6d, 0xf2, 0x1e, 0x24, 0xb8, 0x24, 0xa9, 0x1e, 0x91,
+	0xee, 0x67, 0x74, 0x97, 0xb9, 0x0a, 0x6d, 0x99, 0x60, 0x9c, 0x74, 0x25, 0xbc, 0x2e, 0xeb, 0x7b,
+	0x0d, 0xc6, 0x03, 0x31, 0x7f, 0x73, 0x79, 0x42, 0xf2, 0x30, 0x69, 0x23, 0x77, 0x7c, 0xb4, 0x2b,
+	0x5c, 0xb4, 0x83, 0x3e, 0x99, 0xbb, 0x76, 0x9a, 0xfd, 0x48, 0x27, 0x14, 0x49, 0xae, 0xb8, 0xf5,
+	0x65, 0x64, 0x69, 0xd4, 0x5e, 0xb3, 0x30, 0x12, 0x04, 0x86, 0x1b, 0x5a, 0x66, 0x78, 0x50, 0xc7,
+	0x0c, 0x08, 0x34, 0x84, 0x11, 0x03, 0x46, 0xa3, 0x07, 0x27, 0xb0, 0x35, 0x4e, 0xa3, 0x25, 0xf9,
+	0x3f, 0xe8, 0x0d, 0x1f, 0xf7, 0x1d, 0xaf, 0xc9, 0xdb, 0x6f, 0xd2, 0xb0, 0x84, 0x5c, 0x8a, 0xe4,
+	0xea, 0x59, 0xb2, 0x6a, 0x40, 0x16, 0x39, 0x77, 0x6a, 0x6e, 0x1d, 0x5d, 0xf1, 0x06, 0x83, 0x66,
+	0x7d, 0x06, 0xd0, 0x39, 0x88, 0x64, 0x21, 0x1e, 0x38, 0xa1, 0x6a, 0x6a, 0xa0, 0xab, 0x2b, 0x43,
+	0x54, 0xe2, 0xc8, 0x3b, 0x90, 0xe0, 0x58, 0xf5, 0x51, 0xa8, 0x54, 0x31, 0xfb, 0x37, 0xdf, 0x00,
+	0xb1, 0x32, 0x44, 0x15, 0x76, 0x29, 0x01, 0x71, 0x47, 0x60, 0xdd, 0x3a, 0x8a, 0x81, 0xde, 0x39,
+	0x7c, 0x79, 0x87, 0xb9, 0x35, 0x24, 0x0f, 0x00, 0x58, 0x5b, 0x66, 0x68, 0x83, 0x33, 0xb0, 0xc3,
+	0xa4, 0x5d, 0x0c, 0xb2, 0x06, 0x09, 0x56, 0x15, 0x51, 0xf4, 0x27, 0x73, 0xef, 0x9e, 0xce, 0x0d,
+	0x4f, 0xed, 0x12, 0x2c, 0x4a, 0x32, 0x55, 0x4a, 0xc8, 0x34, 0x24, 0xd4, 0xf3, 0x12, 0x4e, 0x44,
+	0x6a, 0x65, 0x6d, 0x83, 0xde, 0xcb, 0x21, 0x73, 0x90, 0xd8, 0xda, 0xc8, 0x2f, 0x96, 0x83, 0x9e,
+	0x6f, 0x1e, 0x1e, 0x65, 0xa6, 0x7b, 0x11, 0xaa, 0x0a, 0xe7, 0x20, 0x11, 0x76, 0x59, 0x5d, 0xeb,
+	0x8f, 0x0b, 0x1b, 0xac, 0xf5, 0xa7, 0xf6, 0x5a, 0x16, 0x44, 0x09, 0xf9, 0x11, 0xc4, 0x83, 0xe9,
+	0x54, 0xc6, 0x66, 0x32, 0x77, 0xf3, 0x74, 0xff, 0x22, 0x56, 0xb6, 0xdc, 0x6a, 0x20, 0x95, 0x44,
+	0x72, 0x0d, 0x80, 0x35, 0x1a, 0x7b, 0x0e, 0xf2, 0x8a, 0xf0, 0xd4, 0x88, 0x91, 0x52, 0x92, 0xb2,
+	0x17, 0x6c, 0xfb, 0xc8, 0x9b, 0x7b, 0x82, 0x57, 0x9c, 0xc8, 0xed, 0x94, 0x92, 0x14, 0x5d, 0xf2,
+	0x00, 0x46, 0xab, 0x32, 0x68, 0xd1, 0x2b, 0x7e, 0xfd, 0x3c, 0x11, 0xa6, 0x11, 0xc9, 0xba, 0x01,
+	0xf1, 0xc0, 0x16, 0x32, 0x0e, 0xc9, 0xe5, 0xf5, 0xb5, 0x8d, 0xd5, 0x42, 0x10, 0x2f, 0x72, 0x09,
+	0xc6, 0x8a, 0xa5, 0x65, 0x5a, 0x58, 0x2b, 0x94, 0xca, 0x8b, 0xab, 0xba, 0x96, 0x7b, 0x9e, 0x00,
+	0xc8, 0xb7, 0x47, 0x75, 0xf2, 0x14, 0x46, 0x55, 0x92, 0x13, 0xeb, 0xec, 0xd9, 0xcb, 0xb4, 0xce,
+	0x9e, 0x02, 0xac, 0xd9, 0x9f, 0x7e, 0xf8, 0xfd, 0x59, 0xec, 0x1a, 0x8c, 0x4b, 0xcc, 0xdb, 0xc1,
+	0x94, 0x81, 0x3e, 0x4c, 0x84, 0x2b, 0x35, 0xc3, 0xdc, 0xd6, 0xc8, 0xe7, 0x90, 0x6a, 0x3f, 0x3f,
+	0xa4, 0xaf, 0xaf, 0xbd, 0x8f, 0xa0, 0x79, 0xe3, 0x0c, 0x94, 0x6a, 0x8a, 0xe7, 0x31, 0x80, 0x7c,
+	0xab, 0x81, 0xde, 0xdb, 0x56, 0xc9, 0xcd, 0x0b, 0xbc, 0x23, 0xe6, 0xad, 0xf3, 0x81, 0x2f, 0x62,
+	0xd4, 0x77, 0x1a, 0x4c, 0xf7, 0x6a, 0xd8, 0x14, 0x3e, 0xb2, 0xfa, 0x3f, 0x6d, 0xda, 0xbc, 0x46,
+	0x9a, 0x30, 0x52, 0x96, 0x9d, 0x38, 0x33, 0xa8, 0x7f, 0xb5, 0xcf, 0x1f, 0x8c, 0x88, 0x92, 0x64,
+	0xee, 0x1c, 0x67, 0x7e, 0x1d, 0xd3, 0x6e, 0x6b, 0xe4, 0x2b, 0x0d, 0xc6, 0xba, 0xea, 0x8e, 0xcc,
+	0x9d, 0x51, 0x98, 0x91, 0x0d, 0x73, 0xe7, 0x2b, 0xe0, 0x73, 0xa6, 0xeb, 0x92, 0xf1, 0xe2, 0x55,
+	0x7a, 0xe8, 0x97, 0x57, 0xe9, 0xa1, 0x2f, 0x8e, 0xd3, 0xda, 0x8b, 0xe3, 0xb4, 0xf6, 0xf3, 0x71,
+	0x5a, 0xfb, 0xed, 0x38, 0xad, 0x6d, 0x27, 0xe4, 0x68, 0x74, 0xf7, 0xaf, 0x01, 0x00, 0xe1, 0xb8,
+	0x39, 0x67, 0x02, 0x0f, 0x00, 0x00,
 }
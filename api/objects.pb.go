@@ -71,6 +71,22 @@ type Node struct {
 	// shows the privilege level that the CA would currently grant when
 	// issuing or renewing the node's certificate.
 	Role NodeRole `protobuf:"varint,9,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
+	// CertificateHistory lists the most recent certificates issued for this
+	// node, oldest first. Only a bounded number of entries is kept.
+	CertificateHistory []*CertificateIssuance `protobuf:"bytes,10,rep,name=certificate_history,json=certificateHistory" json:"certificate_history,omitempty"`
+	// LastForcedCertificateRotation is the value of
+	// Spec.ForceCertificateRotation that the CA last acted on by asking the
+	// node to rotate its certificate.
+	LastForcedCertificateRotation uint64 `protobuf:"varint,11,opt,name=last_forced_certificate_rotation,json=lastForcedCertificateRotation,proto3" json:"last_forced_certificate_rotation,omitempty"`
+	// SessionID is the ID of the node's current dispatcher session. It is
+	// only recorded if the dispatcher is configured to persist sessions, so
+	// that after a leadership change, the new leader can adopt the session
+	// instead of making the node register again.
+	SessionID string `protobuf:"bytes,12,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Metadata is the inventory information the node last reported when
+	// registering, such as its container runtime, OS, kernel and engine
+	// versions. It is only informational, and is not used for scheduling.
+	Metadata map[string]string `protobuf:"bytes,13,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *Node) Reset()                    { *m = Node{} }
@@ -387,6 +403,21 @@ func (m *Node) CopyFrom(src interface{}) {
 		github_com_docker_swarmkit_api_deepcopy.Copy(m.Attachment, o.Attachment)
 	}
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Certificate, &o.Certificate)
+	if o.CertificateHistory != nil {
+		m.CertificateHistory = make([]*CertificateIssuance, len(o.CertificateHistory))
+		for i := range m.CertificateHistory {
+			m.CertificateHistory[i] = &CertificateIssuance{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.CertificateHistory[i], o.CertificateHistory[i])
+		}
+	}
+
+	if o.Metadata != nil {
+		m.Metadata = make(map[string]string, len(o.Metadata))
+		for k, v := range o.Metadata {
+			m.Metadata[k] = v
+		}
+	}
+
 }
 
 func (m *Service) Copy() *Service {
@@ -801,6 +832,46 @@ func (m *Node) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Role))
 	}
+	if len(m.CertificateHistory) > 0 {
+		for _, msg := range m.CertificateHistory {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintObjects(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.LastForcedCertificateRotation != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.LastForcedCertificateRotation))
+	}
+	if len(m.SessionID) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.SessionID)))
+		i += copy(dAtA[i:], m.SessionID)
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x6a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovObjects(uint64(len(k))) + 1 + len(v) + sovObjects(uint64(len(v)))
+			i = encodeVarintObjects(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintObjects(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintObjects(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -1553,6 +1624,27 @@ func (m *Node) Size() (n int) {
 	if m.Role != 0 {
 		n += 1 + sovObjects(uint64(m.Role))
 	}
+	if len(m.CertificateHistory) > 0 {
+		for _, e := range m.CertificateHistory {
+			l = e.Size()
+			n += 1 + l + sovObjects(uint64(l))
+		}
+	}
+	if m.LastForcedCertificateRotation != 0 {
+		n += 1 + sovObjects(uint64(m.LastForcedCertificateRotation))
+	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovObjects(uint64(len(k))) + 1 + len(v) + sovObjects(uint64(len(v)))
+			n += mapEntrySize + 1 + sovObjects(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2941,6 +3033,16 @@ func (this *Node) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k, _ := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
 	s := strings.Join([]string{`&Node{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
@@ -2951,6 +3053,10 @@ func (this *Node) String() string {
 		`Attachment:` + strings.Replace(fmt.Sprintf("%v", this.Attachment), "NetworkAttachment", "NetworkAttachment", 1) + `,`,
 		`Certificate:` + strings.Replace(strings.Replace(this.Certificate.String(), "Certificate", "Certificate", 1), `&`, ``, 1) + `,`,
 		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`CertificateHistory:` + strings.Replace(fmt.Sprintf("%v", this.CertificateHistory), "CertificateIssuance", "CertificateIssuance", 1) + `,`,
+		`LastForcedCertificateRotation:` + fmt.Sprintf("%v", this.LastForcedCertificateRotation) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3561,6 +3667,201 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertificateHistory = append(m.CertificateHistory, &CertificateIssuance{})
+			if err := m.CertificateHistory[len(m.CertificateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastForcedCertificateRotation", wireType)
+			}
+			m.LastForcedCertificateRotation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastForcedCertificateRotation |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthObjects
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjects(dAtA[iNdEx:])
@@ -5947,89 +6248,97 @@ var (
 func init() { proto.RegisterFile("objects.proto", fileDescriptorObjects) }
 
 var fileDescriptorObjects = []byte{
-	// 1333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x57, 0xc1, 0x8f, 0x1b, 0xb5,
-	0x17, 0xde, 0x49, 0x66, 0x93, 0xcc, 0xcb, 0x66, 0xf5, 0xfb, 0xb9, 0xa5, 0x4c, 0x97, 0x25, 0x59,
-	0xb6, 0x02, 0x55, 0xa8, 0xca, 0x42, 0x29, 0x68, 0x5b, 0x28, 0x90, 0x64, 0x23, 0x88, 0x4a, 0x69,
-	0xe5, 0x96, 0xf6, 0x18, 0x79, 0x67, 0xdc, 0x30, 0x64, 0x32, 0x1e, 0xd9, 0x4e, 0x4a, 0x6e, 0x88,
-	0x23, 0x47, 0x84, 0xc4, 0x0d, 0x89, 0x0b, 0x67, 0xae, 0xfc, 0x07, 0x7b, 0xe4, 0xc8, 0x69, 0x45,
-	0x73, 0xe3, 0xc2, 0xdf, 0x80, 0xec, 0xf1, 0x64, 0x67, 0x9b, 0xc9, 0x6e, 0x8b, 0xaa, 0x15, 0xa7,
-	0xd8, 0xe3, 0xef, 0x7b, 0x7e, 0xcf, 0xfe, 0xfc, 0xd9, 0x81, 0x1a, 0xdb, 0xff, 0x8a, 0x7a, 0x52,
-	0x34, 0x63, 0xce, 0x24, 0x43, 0xc8, 0x67, 0xde, 0x90, 0xf2, 0xa6, 0x78, 0x4c, 0xf8, 0x68, 0x18,
-	0xc8, 0xe6, 0xe4, 0xed, 0x8d, 0xaa, 0x9c, 0xc6, 0xd4, 0x00, 0x36, 0xaa, 0x22, 0xa6, 0x5e, 0xda,
-	0x69, 0x0c, 0x18, 0x1b, 0x84, 0x74, 0x47, 0xf7, 0xf6, 0xc7, 0x8f, 0x76, 0x64, 0x30, 0xa2, 0x42,
-	0x92, 0x51, 0x6c, 0x00, 0xe7, 0x07, 0x6c, 0xc0, 0x74, 0x73, 0x47, 0xb5, 0xcc, 0xd7, 0x8b, 0x4f,
-	0xd3, 0x48, 0x34, 0x35, 0x43, 0xe7, 0xe2, 0x70, 0x3c, 0x08, 0xa2, 0x9d, 0xe4, 0x27, 0xf9, 0xb8,
-	0xfd, 0x9b, 0x05, 0xf6, 0x6d, 0x2a, 0x09, 0x7a, 0x1f, 0xca, 0x13, 0xca, 0x45, 0xc0, 0x22, 0xd7,
-	0xda, 0xb2, 0x2e, 0x57, 0xaf, 0xbe, 0xd2, 0x5c, 0xcc, 0xb7, 0xf9, 0x20, 0x81, 0xb4, 0xed, 0x83,
-	0xc3, 0xc6, 0x0a, 0x4e, 0x19, 0xe8, 0x3a, 0x80, 0xc7, 0x29, 0x91, 0xd4, 0xef, 0x13, 0xe9, 0x16,
-	0x34, 0x7f, 0xa3, 0x99, 0xa4, 0xd2, 0x4c, 0x53, 0x69, 0xde, 0x4f, 0x2b, 0xc0, 0x8e, 0x41, 0xb7,
-	0xa4, 0xa2, 0x8e, 0x63, 0x3f, 0xa5, 0x16, 0x4f, 0xa7, 0x1a, 0x74, 0x4b, 0x6e, 0xff, 0x6c, 0x83,
-	0xfd, 0x39, 0xf3, 0x29, 0xba, 0x00, 0x85, 0xc0, 0xd7, 0x69, 0x3b, 0xed, 0xd2, 0xec, 0xb0, 0x51,
-	0xe8, 0xed, 0xe1, 0x42, 0xe0, 0xa3, 0xab, 0x60, 0x8f, 0xa8, 0x24, 0x26, 0x21, 0x37, 0xaf, 0x20,
-	0x55, 0xbb, 0xa9, 0x46, 0x63, 0xd1, 0x7b, 0x60, 0xab, 0x6d, 0x30, 0x99, 0x6c, 0xe6, 0x71, 0xd4,
-	0x9c, 0xf7, 0x62, 0xea, 0xa5, 0x3c, 0x85, 0x47, 0x5d, 0xa8, 0xfa, 0x54, 0x78, 0x3c, 0x88, 0xa5,
-	0x5a, 0x43, 0x5b, 0xd3, 0x2f, 0x2d, 0xa3, 0xef, 0x1d, 0x41, 0x71, 0x96, 0x87, 0x3e, 0x80, 0x92,
-	0x90, 0x44, 0x8e, 0x85, 0xbb, 0xaa, 0x23, 0xd4, 0x97, 0x26, 0xa0, 0x51, 0x26, 0x05, 0xc3, 0x41,
-	0x9f, 0xc2, 0xfa, 0x88, 0x44, 0x64, 0x40, 0x79, 0xdf, 0x44, 0x29, 0xe9, 0x28, 0xaf, 0xe5, 0x96,
-	0x9e, 0x20, 0x93, 0x40, 0xb8, 0x36, 0xca, 0x76, 0x51, 0x17, 0x80, 0x48, 0x49, 0xbc, 0x2f, 0x47,
-	0x34, 0x92, 0x6e, 0x59, 0x47, 0x79, 0x3d, 0x37, 0x17, 0x2a, 0x1f, 0x33, 0x3e, 0x6c, 0xcd, 0xc1,
-	0x38, 0x43, 0x44, 0x9f, 0x40, 0xd5, 0xa3, 0x5c, 0x06, 0x8f, 0x02, 0x8f, 0x48, 0xea, 0x56, 0x74,
-	0x9c, 0x46, 0x5e, 0x9c, 0xce, 0x11, 0xcc, 0x14, 0x95, 0x65, 0xa2, 0xb7, 0xc0, 0xe6, 0x2c, 0xa4,
-	0xae, 0xb3, 0x65, 0x5d, 0x5e, 0x5f, 0xbe, 0x2d, 0x98, 0x85, 0x14, 0x6b, 0xe4, 0x0d, 0xfb, 0xbb,
-	0x1f, 0xb7, 0x57, 0xb6, 0xff, 0x2e, 0x42, 0xf9, 0x1e, 0xe5, 0x93, 0xc0, 0x7b, 0xb1, 0x32, 0xb9,
-	0x7e, 0x4c, 0x26, 0xb9, 0x15, 0x99, 0x69, 0x17, 0x94, 0xb2, 0x0b, 0x15, 0x1a, 0xf9, 0x31, 0x0b,
-	0x22, 0x69, 0x64, 0x92, 0x5b, 0x4e, 0xd7, 0x60, 0xf0, 0x1c, 0x8d, 0xba, 0x50, 0x4b, 0xd4, 0xdf,
-	0x3f, 0xa6, 0x91, 0xad, 0x3c, 0xfa, 0x17, 0x1a, 0x68, 0x36, 0x77, 0x6d, 0x9c, 0xe9, 0xa1, 0x3d,
-	0xa8, 0xc5, 0x9c, 0x4e, 0x02, 0x36, 0x16, 0x7d, 0x5d, 0x44, 0xe9, 0x99, 0x8a, 0xc0, 0x6b, 0x29,
-	0x4b, 0xf5, 0xd0, 0x87, 0xb0, 0xa6, 0xc8, 0xfd, 0xd4, 0x35, 0xe0, 0x54, 0xd7, 0xc0, 0xda, 0xe0,
-	0x4c, 0x07, 0xdd, 0x81, 0x97, 0x8e, 0x65, 0x31, 0x0f, 0x54, 0x3d, 0x3d, 0xd0, 0xb9, 0x6c, 0x26,
-	0xe6, 0xa3, 0xd9, 0xf0, 0x9f, 0x0a, 0x50, 0x49, 0x97, 0x0e, 0x5d, 0x33, 0xbb, 0x64, 0x2d, 0x5f,
-	0xa7, 0x14, 0xab, 0x2b, 0x4c, 0x36, 0xe8, 0x1a, 0xac, 0xc6, 0x8c, 0x4b, 0xe1, 0x16, 0xb6, 0x8a,
-	0xcb, 0x8e, 0xe0, 0x5d, 0xc6, 0x65, 0x87, 0x45, 0x8f, 0x82, 0x01, 0x4e, 0xc0, 0xe8, 0x21, 0x54,
-	0x27, 0x01, 0x97, 0x63, 0x12, 0xf6, 0x83, 0x58, 0xb8, 0x45, 0xcd, 0x7d, 0xe3, 0xa4, 0x29, 0x9b,
-	0x0f, 0x12, 0x7c, 0xef, 0x6e, 0x7b, 0x7d, 0x76, 0xd8, 0x80, 0x79, 0x57, 0x60, 0x30, 0xa1, 0x7a,
-	0xb1, 0xd8, 0xb8, 0x0d, 0xce, 0x7c, 0x04, 0x5d, 0x01, 0x88, 0x92, 0x13, 0xd7, 0x9f, 0x6b, 0xb9,
-	0x36, 0x3b, 0x6c, 0x38, 0xe6, 0x1c, 0xf6, 0xf6, 0xb0, 0x63, 0x00, 0x3d, 0x1f, 0x21, 0xb0, 0x89,
-	0xef, 0x73, 0xad, 0x6c, 0x07, 0xeb, 0xf6, 0xf6, 0xf7, 0x25, 0xb0, 0xef, 0x13, 0x31, 0x3c, 0x6b,
-	0xd7, 0x54, 0x73, 0x2e, 0x9c, 0x85, 0x2b, 0x00, 0x22, 0x51, 0x98, 0x2a, 0xc7, 0x3e, 0x2a, 0xc7,
-	0xe8, 0x4e, 0x95, 0x63, 0x00, 0x49, 0x39, 0x22, 0x64, 0x52, 0xcb, 0xde, 0xc6, 0xba, 0x8d, 0x2e,
-	0x41, 0x39, 0x62, 0xbe, 0xa6, 0x97, 0x34, 0x1d, 0x66, 0x87, 0x8d, 0x92, 0xf2, 0x82, 0xde, 0x1e,
-	0x2e, 0xa9, 0xa1, 0x9e, 0xaf, 0x6c, 0x88, 0x44, 0x11, 0x93, 0x44, 0x79, 0xac, 0x30, 0x76, 0x96,
-	0xab, 0xf7, 0xd6, 0x11, 0x2c, 0xb5, 0xa1, 0x0c, 0x13, 0x3d, 0x80, 0x73, 0x69, 0xbe, 0xd9, 0x80,
-	0x95, 0xe7, 0x09, 0x88, 0x4c, 0x84, 0xcc, 0x48, 0xc6, 0xf6, 0x9d, 0xe5, 0xb6, 0xaf, 0x57, 0x30,
-	0xcf, 0xf6, 0xdb, 0x50, 0xf3, 0xa9, 0x08, 0x38, 0xf5, 0xb5, 0x31, 0x50, 0x7d, 0x16, 0xd7, 0xaf,
-	0xbe, 0x7a, 0x52, 0x10, 0x8a, 0xd7, 0x0c, 0x47, 0xf7, 0x50, 0x0b, 0x2a, 0x46, 0x37, 0xc2, 0xad,
-	0x6a, 0xed, 0x3e, 0xa3, 0xdd, 0xcf, 0x69, 0xc7, 0x8c, 0x6d, 0xed, 0xb9, 0x8c, 0xed, 0x3a, 0x40,
-	0xc8, 0x06, 0x7d, 0x9f, 0x07, 0x13, 0xca, 0xdd, 0x9a, 0x79, 0x04, 0xe4, 0x70, 0xf7, 0x34, 0x02,
-	0x3b, 0x21, 0x1b, 0x24, 0xcd, 0x05, 0x1b, 0x5a, 0x7f, 0x3e, 0x1b, 0x32, 0xae, 0xf1, 0xad, 0x05,
-	0xff, 0x5f, 0x28, 0x0d, 0xbd, 0x0b, 0x65, 0x53, 0xdc, 0x49, 0x6f, 0x22, 0xc3, 0xc3, 0x29, 0x16,
-	0x6d, 0x82, 0xa3, 0x4e, 0x1a, 0x15, 0x82, 0x26, 0x1e, 0xe2, 0xe0, 0xa3, 0x0f, 0xc8, 0x85, 0x32,
-	0x09, 0x03, 0xa2, 0xc6, 0x8a, 0x7a, 0x2c, 0xed, 0x6e, 0xff, 0x50, 0x80, 0xb2, 0x09, 0x76, 0xd6,
-	0x77, 0x95, 0x99, 0x76, 0xe1, 0x7c, 0xde, 0x84, 0xb5, 0x64, 0x53, 0x8c, 0xb0, 0xec, 0x53, 0xb7,
-	0xa6, 0x9a, 0xe0, 0x13, 0x51, 0xdd, 0x04, 0x3b, 0x88, 0xc9, 0xc8, 0xdc, 0x53, 0xb9, 0x33, 0xf7,
-	0xee, 0xb6, 0x6e, 0xdf, 0x89, 0x93, 0xf3, 0x51, 0x99, 0x1d, 0x36, 0x6c, 0xf5, 0x01, 0x6b, 0x9a,
-	0xd9, 0x9b, 0x5f, 0x56, 0xa1, 0xdc, 0x09, 0xc7, 0x42, 0x52, 0x7e, 0xd6, 0xcb, 0x62, 0xa6, 0x5d,
-	0x58, 0x96, 0x0e, 0x94, 0x39, 0x63, 0xb2, 0xef, 0x91, 0x93, 0x56, 0x04, 0x33, 0x26, 0x3b, 0xad,
-	0xf6, 0xba, 0x22, 0x2a, 0x53, 0x4a, 0xfa, 0xb8, 0xa4, 0xa8, 0x1d, 0x82, 0x1e, 0xc2, 0x85, 0xd4,
-	0xca, 0xf7, 0x19, 0x93, 0x42, 0x72, 0x12, 0xf7, 0x87, 0x74, 0xaa, 0xae, 0xf5, 0xe2, 0xb2, 0x47,
-	0x5b, 0x37, 0xf2, 0xf8, 0x54, 0x2f, 0xd7, 0x2d, 0x3a, 0xc5, 0xe7, 0x4d, 0x80, 0x76, 0xca, 0xbf,
-	0x45, 0xa7, 0x02, 0x7d, 0x04, 0x9b, 0x74, 0x0e, 0x53, 0x11, 0xfb, 0x21, 0x19, 0xa9, 0x4b, 0xaa,
-	0xef, 0x85, 0xcc, 0x1b, 0x6a, 0x9f, 0xb4, 0xf1, 0x45, 0x9a, 0x0d, 0xf5, 0x59, 0x82, 0xe8, 0x28,
-	0x00, 0x12, 0xe0, 0xee, 0x87, 0xc4, 0x1b, 0x86, 0x81, 0x50, 0xef, 0xf2, 0xcc, 0x3b, 0x4c, 0x59,
-	0x9d, 0xca, 0x6d, 0xf7, 0x84, 0xd5, 0x6a, 0xb6, 0x8f, 0xb8, 0x99, 0x57, 0x9d, 0xe8, 0x46, 0x92,
-	0x4f, 0xf1, 0xcb, 0xfb, 0xf9, 0xa3, 0xa8, 0x0d, 0xd5, 0x71, 0xa4, 0xa6, 0x4f, 0xd6, 0xc0, 0x79,
-	0xd6, 0x35, 0x80, 0x84, 0xa5, 0x2a, 0xdf, 0x98, 0xc0, 0xe6, 0x49, 0x93, 0xa3, 0xff, 0x41, 0x71,
-	0x48, 0xa7, 0x89, 0x7e, 0xb0, 0x6a, 0xa2, 0x8f, 0x61, 0x75, 0x42, 0xc2, 0x31, 0x35, 0xca, 0x79,
-	0x33, 0x6f, 0xbe, 0xfc, 0x90, 0x38, 0x21, 0xde, 0x28, 0xec, 0x5a, 0x46, 0xa8, 0xbf, 0x5a, 0x50,
-	0xba, 0x47, 0x3d, 0x4e, 0xe5, 0x0b, 0xd5, 0xe9, 0xee, 0x31, 0x9d, 0xd6, 0xf3, 0x5f, 0x69, 0x6a,
-	0xd6, 0x05, 0x99, 0x6e, 0x40, 0x25, 0x88, 0x24, 0xe5, 0x11, 0x09, 0xb5, 0x4e, 0x2b, 0x78, 0xde,
-	0x37, 0x29, 0xff, 0x65, 0x41, 0x05, 0x53, 0xc1, 0xc6, 0xfc, 0x05, 0xbf, 0x8f, 0x9f, 0xba, 0x71,
-	0x8b, 0xff, 0xfa, 0xc6, 0x45, 0x60, 0x0f, 0x83, 0xc8, 0xbc, 0x0d, 0xb0, 0x6e, 0xa3, 0x26, 0x94,
-	0x63, 0x32, 0x0d, 0x19, 0xf1, 0x8d, 0xb3, 0x9c, 0x5f, 0xf8, 0xc3, 0xd8, 0x8a, 0xa6, 0x38, 0x05,
-	0x99, 0x5a, 0x0f, 0x2c, 0x70, 0xba, 0x5f, 0x4b, 0x1a, 0xe9, 0xe7, 0xe7, 0x7f, 0xb2, 0xd8, 0xad,
-	0xc5, 0x3f, 0x91, 0xce, 0xb1, 0xff, 0x87, 0x49, 0x29, 0x6d, 0xf7, 0xe0, 0x49, 0x7d, 0xe5, 0x8f,
-	0x27, 0xf5, 0x95, 0x6f, 0x66, 0x75, 0xeb, 0x60, 0x56, 0xb7, 0x7e, 0x9f, 0xd5, 0xad, 0x3f, 0x67,
-	0x75, 0x6b, 0xbf, 0xa4, 0x57, 0xe0, 0x9d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x6e, 0x08,
-	0x2c, 0x7c, 0x10, 0x00, 0x00,
+	// 1458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x73, 0x1b, 0x45,
+	0x16, 0xb6, 0xa4, 0xb1, 0x7e, 0x3c, 0x59, 0xae, 0xdd, 0xb6, 0x37, 0x3b, 0xf1, 0x3a, 0x92, 0xd6,
+	0xa9, 0x5d, 0x5c, 0x14, 0x25, 0x83, 0x09, 0x94, 0x93, 0x10, 0x40, 0xb2, 0x4d, 0xa2, 0x0a, 0x26,
+	0xa9, 0x76, 0x48, 0xb8, 0x4d, 0xb5, 0x67, 0xda, 0xca, 0xa0, 0xd1, 0xf4, 0xd4, 0x74, 0x4b, 0x41,
+	0x37, 0x8a, 0x23, 0x47, 0x8a, 0x2a, 0x6e, 0x1c, 0x39, 0x73, 0xe5, 0x3f, 0xf0, 0x91, 0x23, 0x27,
+	0x17, 0xd1, 0x8d, 0x0b, 0x17, 0xfe, 0x01, 0xaa, 0x7f, 0x8c, 0x3c, 0x8a, 0x24, 0x3b, 0xa1, 0x52,
+	0x29, 0x4e, 0xea, 0xee, 0xf9, 0xbe, 0xd7, 0xef, 0x75, 0xbf, 0xf7, 0xf5, 0x13, 0x54, 0xd8, 0xd1,
+	0xe7, 0xd4, 0x15, 0xbc, 0x11, 0xc5, 0x4c, 0x30, 0x84, 0x3c, 0xe6, 0x76, 0x69, 0xdc, 0xe0, 0x4f,
+	0x48, 0xdc, 0xeb, 0xfa, 0xa2, 0x31, 0x78, 0x6b, 0xad, 0x2c, 0x86, 0x11, 0x35, 0x80, 0xb5, 0x32,
+	0x8f, 0xa8, 0x9b, 0x4c, 0x6a, 0x1d, 0xc6, 0x3a, 0x01, 0xdd, 0x52, 0xb3, 0xa3, 0xfe, 0xf1, 0x96,
+	0xf0, 0x7b, 0x94, 0x0b, 0xd2, 0x8b, 0x0c, 0x60, 0xb5, 0xc3, 0x3a, 0x4c, 0x0d, 0xb7, 0xe4, 0xc8,
+	0xac, 0x5e, 0x7e, 0x96, 0x46, 0xc2, 0xa1, 0xf9, 0xb4, 0x12, 0x05, 0xfd, 0x8e, 0x1f, 0x6e, 0xe9,
+	0x1f, 0xbd, 0xb8, 0xf1, 0x53, 0x06, 0xac, 0x03, 0x2a, 0x08, 0xba, 0x09, 0x85, 0x01, 0x8d, 0xb9,
+	0xcf, 0x42, 0x3b, 0x53, 0xcf, 0x6c, 0x96, 0xb7, 0xff, 0xd3, 0x98, 0xf6, 0xb7, 0xf1, 0x50, 0x43,
+	0x5a, 0xd6, 0xc9, 0x69, 0x6d, 0x01, 0x27, 0x0c, 0x74, 0x1d, 0xc0, 0x8d, 0x29, 0x11, 0xd4, 0x73,
+	0x88, 0xb0, 0xb3, 0x8a, 0xbf, 0xd6, 0xd0, 0xae, 0x34, 0x12, 0x57, 0x1a, 0x0f, 0x92, 0x08, 0x70,
+	0xc9, 0xa0, 0x9b, 0x42, 0x52, 0xfb, 0x91, 0x97, 0x50, 0x73, 0x17, 0x53, 0x0d, 0xba, 0x29, 0x36,
+	0xfe, 0xc8, 0x83, 0xf5, 0x09, 0xf3, 0x28, 0xba, 0x04, 0x59, 0xdf, 0x53, 0x6e, 0x97, 0x5a, 0xf9,
+	0xd1, 0x69, 0x2d, 0xdb, 0xde, 0xc3, 0x59, 0xdf, 0x43, 0xdb, 0x60, 0xf5, 0xa8, 0x20, 0xc6, 0x21,
+	0x7b, 0x56, 0x40, 0x32, 0x76, 0x13, 0x8d, 0xc2, 0xa2, 0x77, 0xc1, 0x92, 0xd7, 0x60, 0x3c, 0x59,
+	0x9f, 0xc5, 0x91, 0x7b, 0x1e, 0x46, 0xd4, 0x4d, 0x78, 0x12, 0x8f, 0xf6, 0xa1, 0xec, 0x51, 0xee,
+	0xc6, 0x7e, 0x24, 0xe4, 0x19, 0x5a, 0x8a, 0x7e, 0x75, 0x1e, 0x7d, 0xef, 0x0c, 0x8a, 0xd3, 0x3c,
+	0xf4, 0x1e, 0xe4, 0xb9, 0x20, 0xa2, 0xcf, 0xed, 0x45, 0x65, 0xa1, 0x3a, 0xd7, 0x01, 0x85, 0x32,
+	0x2e, 0x18, 0x0e, 0xba, 0x03, 0xcb, 0x3d, 0x12, 0x92, 0x0e, 0x8d, 0x1d, 0x63, 0x25, 0xaf, 0xac,
+	0xfc, 0x77, 0x66, 0xe8, 0x1a, 0xa9, 0x0d, 0xe1, 0x4a, 0x2f, 0x3d, 0x45, 0xfb, 0x00, 0x44, 0x08,
+	0xe2, 0x3e, 0xee, 0xd1, 0x50, 0xd8, 0x05, 0x65, 0xe5, 0x7f, 0x33, 0x7d, 0xa1, 0xe2, 0x09, 0x8b,
+	0xbb, 0xcd, 0x31, 0x18, 0xa7, 0x88, 0xe8, 0x36, 0x94, 0x5d, 0x1a, 0x0b, 0xff, 0xd8, 0x77, 0x89,
+	0xa0, 0x76, 0x51, 0xd9, 0xa9, 0xcd, 0xb2, 0xb3, 0x7b, 0x06, 0x33, 0x41, 0xa5, 0x99, 0xe8, 0x4d,
+	0xb0, 0x62, 0x16, 0x50, 0xbb, 0x54, 0xcf, 0x6c, 0x2e, 0xcf, 0xbf, 0x16, 0xcc, 0x02, 0x8a, 0x15,
+	0x12, 0x7d, 0x06, 0x2b, 0x29, 0x03, 0xce, 0x63, 0x9f, 0x0b, 0x16, 0x0f, 0x6d, 0xa8, 0xe7, 0x36,
+	0xcb, 0xdb, 0xaf, 0x5d, 0xe0, 0x42, 0x9b, 0xf3, 0x3e, 0x09, 0x5d, 0x8a, 0x51, 0xca, 0xc6, 0x1d,
+	0x6d, 0x02, 0xdd, 0x86, 0x7a, 0x40, 0xb8, 0x70, 0x8e, 0x59, 0xec, 0x52, 0xcf, 0x49, 0xef, 0x12,
+	0x33, 0x41, 0xd4, 0xfd, 0x97, 0xeb, 0x99, 0x4d, 0x0b, 0x5f, 0x91, 0xb8, 0x8f, 0x14, 0x2c, 0x65,
+	0x1c, 0x1b, 0x10, 0x7a, 0x03, 0x80, 0x53, 0x2e, 0x2b, 0xc8, 0xf1, 0x3d, 0x7b, 0x49, 0xe5, 0x6f,
+	0x65, 0x74, 0x5a, 0x2b, 0x1d, 0xea, 0xd5, 0xf6, 0x1e, 0x2e, 0x19, 0x40, 0xdb, 0x43, 0x2d, 0x28,
+	0xca, 0x0c, 0xf5, 0x88, 0x20, 0x76, 0x45, 0x45, 0xf1, 0xff, 0x79, 0xc7, 0xd0, 0x38, 0x30, 0xc0,
+	0xfd, 0x50, 0xc4, 0x43, 0x3c, 0xe6, 0xad, 0xdd, 0x84, 0xca, 0xc4, 0x27, 0xf4, 0x0f, 0xc8, 0x75,
+	0xe9, 0x50, 0xd7, 0x0e, 0x96, 0x43, 0xb4, 0x0a, 0x8b, 0x03, 0x12, 0xf4, 0xa9, 0xaa, 0x9a, 0x12,
+	0xd6, 0x93, 0x1b, 0xd9, 0x9d, 0xcc, 0x0d, 0xeb, 0xeb, 0xef, 0x36, 0x16, 0x36, 0x7e, 0xcf, 0x41,
+	0xe1, 0x90, 0xc6, 0x03, 0xdf, 0x7d, 0xb9, 0x85, 0x77, 0x7d, 0xa2, 0xf0, 0x66, 0xe6, 0x88, 0xd9,
+	0x76, 0xaa, 0xf6, 0x76, 0xa0, 0x48, 0x43, 0x2f, 0x62, 0x7e, 0x28, 0x4c, 0xe1, 0xcd, 0x4c, 0x90,
+	0x7d, 0x83, 0xc1, 0x63, 0x34, 0xda, 0x87, 0x8a, 0xd6, 0x13, 0x67, 0xa2, 0xea, 0xea, 0xb3, 0xe8,
+	0x9f, 0x2a, 0xa0, 0x29, 0x97, 0xa5, 0x7e, 0x6a, 0x86, 0xf6, 0xa0, 0x12, 0xc5, 0x74, 0xe0, 0xb3,
+	0x3e, 0x77, 0x54, 0x10, 0xf9, 0xe7, 0x0a, 0x02, 0x2f, 0x25, 0x2c, 0x39, 0x43, 0xef, 0xc3, 0x92,
+	0x24, 0x3b, 0x89, 0x0e, 0xc3, 0x85, 0x3a, 0x8c, 0xd5, 0x93, 0x61, 0x26, 0xe8, 0x1e, 0xfc, 0x6b,
+	0xc2, 0x8b, 0xb1, 0xa1, 0xf2, 0xc5, 0x86, 0x56, 0xd2, 0x9e, 0x98, 0x45, 0x73, 0xe1, 0xdf, 0x67,
+	0xa1, 0x98, 0x1c, 0x1d, 0xba, 0x66, 0x6e, 0x29, 0x33, 0xff, 0x9c, 0x12, 0xac, 0x8a, 0x50, 0x5f,
+	0xd0, 0x35, 0x58, 0x8c, 0x58, 0x2c, 0xb8, 0x9d, 0xad, 0xe7, 0xe6, 0x89, 0xda, 0x7d, 0x16, 0x8b,
+	0x5d, 0x16, 0x1e, 0xfb, 0x1d, 0xac, 0xc1, 0xe8, 0x11, 0x94, 0x07, 0x7e, 0x2c, 0xfa, 0x24, 0x70,
+	0xfc, 0x88, 0xdb, 0xb9, 0xf9, 0x39, 0x9f, 0x6c, 0xd9, 0x78, 0xa8, 0xf1, 0xed, 0xfb, 0xad, 0xe5,
+	0xd1, 0x69, 0x0d, 0xc6, 0x53, 0x8e, 0xc1, 0x98, 0x6a, 0x47, 0x7c, 0xed, 0x00, 0x4a, 0xe3, 0x2f,
+	0xb2, 0x08, 0x43, 0xad, 0x61, 0xce, 0x38, 0x97, 0x55, 0x11, 0x1a, 0x65, 0x93, 0x45, 0x68, 0x00,
+	0x6d, 0x0f, 0x21, 0xb0, 0x88, 0xe7, 0xc5, 0xa6, 0x38, 0xd4, 0x78, 0xe3, 0x9b, 0x3c, 0x58, 0x0f,
+	0x08, 0xef, 0xbe, 0xea, 0x77, 0x48, 0xee, 0x39, 0x55, 0x0b, 0x4a, 0x53, 0x54, 0x86, 0xc9, 0x70,
+	0xac, 0xb4, 0xa6, 0xa8, 0x55, 0xad, 0x29, 0x7a, 0xa8, 0xc2, 0xe1, 0x01, 0x13, 0x2a, 0xed, 0x2d,
+	0xac, 0xc6, 0xe8, 0x2a, 0x14, 0x42, 0xe6, 0x29, 0x7a, 0x5e, 0xd1, 0x61, 0x74, 0x5a, 0xcb, 0x4b,
+	0x59, 0x69, 0xef, 0xe1, 0xbc, 0xfc, 0xd4, 0xf6, 0xa4, 0xb0, 0x93, 0x30, 0x34, 0x42, 0xc6, 0xed,
+	0xc2, 0xfc, 0x7c, 0x6f, 0x9e, 0xc1, 0x12, 0x61, 0x4f, 0x31, 0xd1, 0x43, 0x58, 0x49, 0xfc, 0x4d,
+	0x1b, 0x2c, 0xbe, 0x88, 0x41, 0x64, 0x2c, 0xa4, 0xbe, 0xa4, 0x1e, 0xd2, 0xd2, 0xfc, 0x87, 0x54,
+	0x9d, 0xe0, 0xac, 0x87, 0xb4, 0x05, 0x15, 0x8f, 0x72, 0x3f, 0xa6, 0x9e, 0x12, 0x06, 0xaa, 0x6a,
+	0x71, 0x79, 0xfb, 0xca, 0x79, 0x46, 0x28, 0x5e, 0x32, 0x1c, 0x35, 0x43, 0x4d, 0x28, 0x9a, 0xbc,
+	0xe1, 0x76, 0xb9, 0x9e, 0x7b, 0xfe, 0x07, 0x74, 0x4c, 0x9b, 0x10, 0xb6, 0xa5, 0x17, 0x12, 0xb6,
+	0xeb, 0x00, 0x01, 0xeb, 0x38, 0x5e, 0xec, 0x0f, 0x68, 0x6c, 0x57, 0x4c, 0x5b, 0x35, 0x83, 0xbb,
+	0xa7, 0x10, 0xb8, 0x14, 0xb0, 0x8e, 0x1e, 0x4e, 0xc9, 0xd0, 0xf2, 0x8b, 0xc9, 0x90, 0x51, 0x8d,
+	0xaf, 0x32, 0xf0, 0xcf, 0xa9, 0xd0, 0xd0, 0x3b, 0x50, 0x30, 0xc1, 0x9d, 0xd7, 0x65, 0x1a, 0x1e,
+	0x4e, 0xb0, 0x68, 0x1d, 0x4a, 0xb2, 0xd2, 0x28, 0xe7, 0x54, 0x6b, 0x48, 0x09, 0x9f, 0x2d, 0x20,
+	0x1b, 0x0a, 0x24, 0xf0, 0x09, 0xa7, 0x5a, 0x23, 0x4a, 0x38, 0x99, 0x6e, 0x7c, 0x9b, 0x85, 0x82,
+	0x31, 0xf6, 0xaa, 0xdf, 0x2a, 0xb3, 0xed, 0x54, 0x7d, 0xde, 0x82, 0x25, 0x7d, 0x29, 0x26, 0xb1,
+	0xac, 0x0b, 0xaf, 0xa6, 0xac, 0xf1, 0x3a, 0xa9, 0x6e, 0x81, 0xe5, 0x47, 0xa4, 0x67, 0x2f, 0xce,
+	0xdf, 0xb9, 0x7d, 0xbf, 0x79, 0x70, 0x2f, 0xd2, 0xf5, 0x51, 0x1c, 0x9d, 0xd6, 0x2c, 0xb9, 0x80,
+	0x15, 0xcd, 0xdc, 0xcd, 0x0f, 0x8b, 0x50, 0xd8, 0x0d, 0xfa, 0x5c, 0xd0, 0xf8, 0x55, 0x1f, 0x8b,
+	0xd9, 0x76, 0xea, 0x58, 0x76, 0xa1, 0x10, 0x33, 0x26, 0x1c, 0x97, 0x9c, 0x77, 0x22, 0x98, 0x31,
+	0xb1, 0xdb, 0x6c, 0x2d, 0x4b, 0xa2, 0x14, 0x25, 0x3d, 0xc7, 0x79, 0x49, 0xdd, 0x25, 0xe8, 0x11,
+	0x5c, 0x4a, 0xa4, 0xfc, 0x88, 0x31, 0xc1, 0x45, 0x4c, 0x22, 0xa7, 0x4b, 0x87, 0xf2, 0x59, 0xcf,
+	0xcd, 0x6b, 0x83, 0xf7, 0x43, 0x37, 0x1e, 0xaa, 0xe3, 0xba, 0x4b, 0x87, 0x78, 0xd5, 0x18, 0x68,
+	0x25, 0xfc, 0xbb, 0x74, 0xc8, 0xd1, 0x07, 0xb0, 0x4e, 0xc7, 0x30, 0x69, 0xd1, 0x09, 0x48, 0x4f,
+	0x3e, 0x52, 0x8e, 0x1b, 0x30, 0xb7, 0xab, 0x74, 0xd2, 0xc2, 0x97, 0x69, 0xda, 0xd4, 0xc7, 0x1a,
+	0xb1, 0x2b, 0x01, 0x88, 0x83, 0x7d, 0x14, 0x10, 0xb7, 0x1b, 0xf8, 0x5c, 0x4c, 0xb6, 0x8c, 0x52,
+	0xea, 0xa4, 0x6f, 0x3b, 0xe7, 0x9c, 0x56, 0xa3, 0x75, 0xc6, 0x4d, 0xf5, 0x91, 0x5c, 0x77, 0x77,
+	0xff, 0x3e, 0x9a, 0xfd, 0x15, 0xb5, 0xa0, 0xdc, 0x0f, 0xe5, 0xf6, 0xfa, 0x0c, 0x4a, 0xcf, 0x7b,
+	0x06, 0xa0, 0x59, 0x32, 0xf2, 0xb5, 0x01, 0xac, 0x9f, 0xb7, 0xf9, 0x8c, 0xfe, 0xf1, 0xc3, 0x74,
+	0xff, 0x58, 0xde, 0x7e, 0x7d, 0xd6, 0x7e, 0xb3, 0x4d, 0x4e, 0xf7, 0x9a, 0x3f, 0x66, 0x20, 0x7f,
+	0x48, 0xdd, 0x98, 0x8a, 0x97, 0x9a, 0xa7, 0x3b, 0x13, 0x79, 0x5a, 0x9d, 0xdd, 0xa5, 0xc9, 0x5d,
+	0xa7, 0xd2, 0x74, 0x0d, 0x8a, 0x7e, 0x28, 0x68, 0x1c, 0x92, 0x40, 0xe5, 0x69, 0x11, 0x8f, 0xe7,
+	0xc6, 0xe5, 0xdf, 0x32, 0x50, 0xc4, 0x94, 0xb3, 0x7e, 0xfc, 0x92, 0xfb, 0xe3, 0x67, 0x5e, 0xdc,
+	0xdc, 0x5f, 0x7e, 0x71, 0x11, 0x58, 0x5d, 0x3f, 0x34, 0xbd, 0x01, 0x56, 0x63, 0xd4, 0x80, 0x42,
+	0x44, 0x86, 0x01, 0x23, 0x9e, 0x51, 0x96, 0xd5, 0xa9, 0xbf, 0xe0, 0xcd, 0x70, 0x88, 0x13, 0x90,
+	0x89, 0xf5, 0x24, 0x03, 0xa5, 0xfd, 0x2f, 0x04, 0x0d, 0x55, 0xfb, 0xf9, 0xb7, 0x0c, 0xb6, 0x3e,
+	0xfd, 0xb7, 0xbc, 0x34, 0xf1, 0x8f, 0x5b, 0x87, 0xd2, 0xb2, 0x4f, 0x9e, 0x56, 0x17, 0x7e, 0x79,
+	0x5a, 0x5d, 0xf8, 0x72, 0x54, 0xcd, 0x9c, 0x8c, 0xaa, 0x99, 0x9f, 0x47, 0xd5, 0xcc, 0xaf, 0xa3,
+	0x6a, 0xe6, 0x28, 0xaf, 0x4e, 0xe0, 0xed, 0x3f, 0x07, 0x00, 0xe9, 0x9a, 0xfe, 0xf2, 0xce, 0x11,
+	0x00, 0x00,
 }
@@ -119,6 +119,11 @@ type NodeSpec struct {
 	// Availability allows a user to control the current scheduling status of a
 	// node.
 	Availability NodeSpec_Availability `protobuf:"varint,4,opt,name=availability,proto3,enum=docker.swarmkit.v1.NodeSpec_Availability" json:"availability,omitempty"`
+	// ForceCertificateRotation forces the node to rotate its certificate
+	// right away, instead of waiting for it to get close to expiring, each
+	// time it is incremented. This can be used if the node's key may have
+	// been compromised.
+	ForceCertificateRotation uint64 `protobuf:"varint,5,opt,name=force_certificate_rotation,json=forceCertificateRotation,proto3" json:"force_certificate_rotation,omitempty"`
 }
 
 func (m *NodeSpec) Reset()                    { *m = NodeSpec{} }
@@ -1137,6 +1142,11 @@ func (m *NodeSpec) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.Availability))
 	}
+	if m.ForceCertificateRotation != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSpecs(dAtA, i, uint64(m.ForceCertificateRotation))
+	}
 	return i, nil
 }
 
@@ -2084,6 +2094,9 @@ func (m *NodeSpec) Size() (n int) {
 	if m.Availability != 0 {
 		n += 1 + sovSpecs(uint64(m.Availability))
 	}
+	if m.ForceCertificateRotation != 0 {
+		n += 1 + sovSpecs(uint64(m.ForceCertificateRotation))
+	}
 	return n
 }
 
@@ -2470,6 +2483,7 @@ func (this *NodeSpec) String() string {
 		`DesiredRole:` + fmt.Sprintf("%v", this.DesiredRole) + `,`,
 		`Membership:` + fmt.Sprintf("%v", this.Membership) + `,`,
 		`Availability:` + fmt.Sprintf("%v", this.Availability) + `,`,
+		`ForceCertificateRotation:` + fmt.Sprintf("%v", this.ForceCertificateRotation) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2836,6 +2850,25 @@ func (m *NodeSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCertificateRotation", wireType)
+			}
+			m.ForceCertificateRotation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForceCertificateRotation |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecs(dAtA[iNdEx:])
@@ -5560,117 +5593,118 @@ var (
 func init() { proto.RegisterFile("specs.proto", fileDescriptorSpecs) }
 
 var fileDescriptorSpecs = []byte{
-	// 1779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x57, 0x41, 0x73, 0x1b, 0x49,
-	0x15, 0xb6, 0x6c, 0x59, 0x1a, 0xbd, 0x91, 0x13, 0xb9, 0xc9, 0x2e, 0x63, 0x85, 0x95, 0x15, 0x6d,
-	0x08, 0x5e, 0xb6, 0x90, 0x0b, 0x43, 0x2d, 0x59, 0xc2, 0x16, 0x48, 0x96, 0x70, 0x8c, 0xb1, 0xa3,
-	0x6a, 0x7b, 0x03, 0x39, 0xa9, 0xda, 0x33, 0x6d, 0x69, 0xca, 0xa3, 0xee, 0xa1, 0xa7, 0xc7, 0x5b,
-	0xba, 0x71, 0xdc, 0xca, 0x99, 0xab, 0x8b, 0x03, 0x7f, 0x26, 0x37, 0x28, 0x4e, 0x9c, 0x5c, 0xac,
-	0xfe, 0x02, 0x3f, 0x00, 0xaa, 0x7b, 0x7a, 0xa4, 0x51, 0x32, 0x4e, 0x52, 0x45, 0x6e, 0xfd, 0x5e,
-	0x7f, 0xdf, 0x9b, 0xd7, 0xaf, 0xbf, 0xee, 0xd7, 0x03, 0x76, 0x14, 0x52, 0x37, 0x6a, 0x87, 0x82,
-	0x4b, 0x8e, 0x90, 0xc7, 0xdd, 0x4b, 0x2a, 0xda, 0xd1, 0x37, 0x44, 0x4c, 0x2e, 0x7d, 0xd9, 0xbe,
-	0xfa, 0x69, 0xdd, 0x96, 0xd3, 0x90, 0x1a, 0x40, 0xfd, 0xde, 0x88, 0x8f, 0xb8, 0x1e, 0xee, 0xaa,
-	0x91, 0xf1, 0x36, 0x46, 0x9c, 0x8f, 0x02, 0xba, 0xab, 0xad, 0xf3, 0xf8, 0x62, 0xd7, 0x8b, 0x05,
-	0x91, 0x3e, 0x67, 0x66, 0x7e, 0xeb, 0xf5, 0x79, 0xc2, 0xa6, 0xc9, 0x54, 0xeb, 0xba, 0x08, 0xd6,
-	0x09, 0xf7, 0xe8, 0x69, 0x48, 0x5d, 0x74, 0x00, 0x36, 0x61, 0x8c, 0x4b, 0xcd, 0x8d, 0x9c, 0x42,
-	0xb3, 0xb0, 0x63, 0xef, 0x6d, 0xb7, 0xdf, 0x4c, 0xaa, 0xdd, 0x59, 0xc0, 0xba, 0xc5, 0x57, 0x37,
-	0xdb, 0x2b, 0x38, 0xcb, 0x44, 0xbf, 0x86, 0xaa, 0x47, 0x23, 0x5f, 0x50, 0x6f, 0x28, 0x78, 0x40,
-	0x9d, 0xd5, 0x66, 0x61, 0xe7, 0xce, 0xde, 0x0f, 0xf2, 0x22, 0xa9, 0x8f, 0x63, 0x1e, 0x50, 0x6c,
-	0x1b, 0x86, 0x32, 0xd0, 0x01, 0xc0, 0x84, 0x4e, 0xce, 0xa9, 0x88, 0xc6, 0x7e, 0xe8, 0xac, 0x69,
-	0xfa, 0x8f, 0x6e, 0xa3, 0xab, 0xdc, 0xdb, 0xc7, 0x73, 0x38, 0xce, 0x50, 0xd1, 0x31, 0x54, 0xc9,
-	0x15, 0xf1, 0x03, 0x72, 0xee, 0x07, 0xbe, 0x9c, 0x3a, 0x45, 0x1d, 0xea, 0xb3, 0xb7, 0x86, 0xea,
-	0x64, 0x08, 0x78, 0x89, 0xde, 0xf2, 0x00, 0x16, 0x1f, 0x42, 0x8f, 0xa0, 0x3c, 0xe8, 0x9f, 0xf4,
-	0x0e, 0x4f, 0x0e, 0x6a, 0x2b, 0xf5, 0xad, 0x97, 0xd7, 0xcd, 0x8f, 0x54, 0x8c, 0x05, 0x60, 0x40,
-	0x99, 0xe7, 0xb3, 0x11, 0xda, 0x01, 0xab, 0xb3, 0xbf, 0xdf, 0x1f, 0x9c, 0xf5, 0x7b, 0xb5, 0x42,
-	0xbd, 0xfe, 0xf2, 0xba, 0xf9, 0xf1, 0x32, 0xb0, 0xe3, 0xba, 0x34, 0x94, 0xd4, 0xab, 0x17, 0xbf,
-	0xfd, 0x5b, 0x63, 0xa5, 0xf5, 0x6d, 0x01, 0xaa, 0xd9, 0x24, 0xd0, 0x23, 0x28, 0x75, 0xf6, 0xcf,
-	0x0e, 0x9f, 0xf7, 0x6b, 0x2b, 0x0b, 0x7a, 0x16, 0xd1, 0x71, 0xa5, 0x7f, 0x45, 0xd1, 0x43, 0x58,
-	0x1f, 0x74, 0xbe, 0x3e, 0xed, 0xd7, 0x0a, 0x8b, 0x74, 0xb2, 0xb0, 0x01, 0x89, 0x23, 0x8d, 0xea,
-	0xe1, 0xce, 0xe1, 0x49, 0x6d, 0x35, 0x1f, 0xd5, 0x13, 0xc4, 0x67, 0x26, 0x95, 0xbf, 0x16, 0xc1,
-	0x3e, 0xa5, 0xe2, 0xca, 0x77, 0x3f, 0xb0, 0x44, 0xbe, 0x80, 0xa2, 0x24, 0xd1, 0xa5, 0x96, 0x86,
-	0x9d, 0x2f, 0x8d, 0x33, 0x12, 0x5d, 0xaa, 0x8f, 0x1a, 0xba, 0xc6, 0x2b, 0x65, 0x08, 0x1a, 0x06,
-	0xbe, 0x4b, 0x24, 0xf5, 0xb4, 0x32, 0xec, 0xbd, 0x1f, 0xe6, 0xb1, 0xf1, 0x1c, 0x65, 0xf2, 0x7f,
-	0xba, 0x82, 0x33, 0x54, 0xf4, 0x04, 0x4a, 0xa3, 0x80, 0x9f, 0x93, 0x40, 0x6b, 0xc2, 0xde, 0x7b,
-	0x90, 0x17, 0xe4, 0x40, 0x23, 0x16, 0x01, 0x0c, 0x05, 0x3d, 0x86, 0x52, 0x1c, 0x7a, 0x44, 0x52,
-	0xa7, 0xa4, 0xc9, 0xcd, 0x3c, 0xf2, 0xd7, 0x1a, 0xb1, 0xcf, 0xd9, 0x85, 0x3f, 0xc2, 0x06, 0x8f,
-	0x8e, 0xc0, 0x62, 0x54, 0x7e, 0xc3, 0xc5, 0x65, 0xe4, 0x94, 0x9b, 0x6b, 0x3b, 0xf6, 0xde, 0xe7,
-	0xb9, 0x62, 0x4c, 0x30, 0x1d, 0x29, 0x89, 0x3b, 0x9e, 0x50, 0x26, 0x93, 0x30, 0xdd, 0x55, 0xa7,
-	0x80, 0xe7, 0x01, 0xd0, 0xaf, 0xc0, 0xa2, 0xcc, 0x0b, 0xb9, 0xcf, 0xa4, 0x63, 0xdd, 0x9e, 0x48,
-	0xdf, 0x60, 0x54, 0x31, 0xf1, 0x9c, 0xa1, 0xd8, 0x82, 0x07, 0xc1, 0x39, 0x71, 0x2f, 0x9d, 0xca,
-	0x7b, 0x2e, 0x63, 0xce, 0xe8, 0x96, 0xa0, 0x38, 0xe1, 0x1e, 0x6d, 0xed, 0xc2, 0xe6, 0x1b, 0xa5,
-	0x46, 0x75, 0xb0, 0x4c, 0xa9, 0x13, 0x8d, 0x14, 0xf1, 0xdc, 0x6e, 0xdd, 0x85, 0x8d, 0xa5, 0xb2,
-	0xb6, 0xfe, 0x59, 0x04, 0x2b, 0xdd, 0x6b, 0xd4, 0x81, 0x8a, 0xcb, 0x99, 0x24, 0x3e, 0xa3, 0xc2,
-	0xc8, 0x2b, 0x77, 0x67, 0xf6, 0x53, 0x90, 0x62, 0x3d, 0x5d, 0xc1, 0x0b, 0x16, 0xfa, 0x2d, 0x54,
-	0x04, 0x8d, 0x78, 0x2c, 0x5c, 0x1a, 0x19, 0x7d, 0xed, 0xe4, 0x2b, 0x24, 0x01, 0x61, 0xfa, 0xa7,
-	0xd8, 0x17, 0x54, 0x55, 0x39, 0xc2, 0x0b, 0x2a, 0x7a, 0x02, 0x65, 0x41, 0x23, 0x49, 0x84, 0x7c,
-	0x9b, 0x44, 0x70, 0x02, 0x19, 0xf0, 0xc0, 0x77, 0xa7, 0x38, 0x65, 0xa0, 0x27, 0x50, 0x09, 0x03,
-	0xe2, 0xea, 0xa8, 0xce, 0xba, 0xa6, 0x7f, 0x92, 0x47, 0x1f, 0xa4, 0x20, 0xbc, 0xc0, 0xa3, 0x2f,
-	0x01, 0x02, 0x3e, 0x1a, 0x7a, 0xc2, 0xbf, 0xa2, 0xc2, 0x48, 0xac, 0x9e, 0xc7, 0xee, 0x69, 0x04,
-	0xae, 0x04, 0x7c, 0x94, 0x0c, 0xd1, 0xc1, 0xff, 0xa5, 0xaf, 0x8c, 0xb6, 0x8e, 0x00, 0xc8, 0x7c,
-	0xd6, 0xa8, 0xeb, 0xb3, 0xf7, 0x0a, 0x65, 0x76, 0x24, 0x43, 0x47, 0x0f, 0xa0, 0x7a, 0xc1, 0x85,
-	0x4b, 0x87, 0xe6, 0xd4, 0x54, 0xb4, 0x26, 0x6c, 0xed, 0x4b, 0xf4, 0x85, 0xba, 0x50, 0x1e, 0x51,
-	0x46, 0x85, 0xef, 0x3a, 0xa0, 0x3f, 0xf6, 0x28, 0xf7, 0x40, 0x26, 0x10, 0x1c, 0x33, 0xe9, 0x4f,
-	0xa8, 0xf9, 0x52, 0x4a, 0xec, 0x56, 0xa0, 0x2c, 0x92, 0x99, 0xd6, 0x1f, 0x01, 0xbd, 0x89, 0x45,
-	0x08, 0x8a, 0x97, 0x3e, 0xf3, 0xb4, 0xb0, 0x2a, 0x58, 0x8f, 0x51, 0x1b, 0xca, 0x21, 0x99, 0x06,
-	0x9c, 0x78, 0x46, 0x2c, 0xf7, 0xda, 0x49, 0xbf, 0x6c, 0xa7, 0xfd, 0xb2, 0xdd, 0x61, 0x53, 0x9c,
-	0x82, 0x5a, 0x47, 0xf0, 0x51, 0xee, 0x92, 0xd1, 0x1e, 0x54, 0xe7, 0x22, 0x1c, 0xfa, 0xe6, 0x23,
-	0xdd, 0xbb, 0xb3, 0x9b, 0x6d, 0x7b, 0xae, 0xd6, 0xc3, 0x1e, 0xb6, 0xe7, 0xa0, 0x43, 0xaf, 0xf5,
-	0x17, 0x0b, 0x36, 0x96, 0xa4, 0x8c, 0xee, 0xc1, 0xba, 0x3f, 0x21, 0x23, 0x6a, 0x72, 0x4c, 0x0c,
-	0xd4, 0x87, 0x52, 0x40, 0xce, 0x69, 0xa0, 0x04, 0xad, 0x36, 0xf5, 0x27, 0xef, 0x3c, 0x13, 0xed,
-	0xdf, 0x6b, 0x7c, 0x9f, 0x49, 0x31, 0xc5, 0x86, 0x8c, 0x1c, 0x28, 0xbb, 0x7c, 0x32, 0x21, 0x4c,
-	0x5d, 0x9d, 0x6b, 0x3b, 0x15, 0x9c, 0x9a, 0xaa, 0x32, 0x44, 0x8c, 0x22, 0xa7, 0xa8, 0xdd, 0x7a,
-	0x8c, 0x6a, 0xb0, 0x46, 0xd9, 0x95, 0xb3, 0xae, 0x5d, 0x6a, 0xa8, 0x3c, 0x9e, 0x9f, 0x28, 0xb2,
-	0x82, 0xd5, 0x50, 0xf1, 0xe2, 0x88, 0x0a, 0xa7, 0x9c, 0x54, 0x54, 0x8d, 0xd1, 0x2f, 0xa0, 0x34,
-	0xe1, 0x31, 0x93, 0x91, 0x63, 0xe9, 0x64, 0xb7, 0xf2, 0x92, 0x3d, 0x56, 0x08, 0x73, 0xb5, 0x1b,
-	0x38, 0xea, 0xc3, 0x66, 0x24, 0x79, 0x38, 0x1c, 0x09, 0xe2, 0xd2, 0x61, 0x48, 0x85, 0xcf, 0x3d,
-	0x73, 0x35, 0x6d, 0xbd, 0xb1, 0x29, 0x3d, 0xf3, 0xc8, 0xc1, 0x77, 0x15, 0xe7, 0x40, 0x51, 0x06,
-	0x9a, 0x81, 0x06, 0x50, 0x0d, 0xe3, 0x20, 0x18, 0xf2, 0x30, 0xe9, 0x52, 0x89, 0x9e, 0xde, 0xa3,
-	0x64, 0x83, 0x38, 0x08, 0x9e, 0x25, 0x24, 0x6c, 0x87, 0x0b, 0x03, 0x7d, 0x0c, 0xa5, 0x91, 0xe0,
-	0x71, 0x18, 0x39, 0xb6, 0x2e, 0x86, 0xb1, 0xd0, 0x57, 0x50, 0x8e, 0xa8, 0x2b, 0xa8, 0x8c, 0x9c,
-	0xaa, 0x5e, 0xea, 0xa7, 0x79, 0x1f, 0x39, 0xd5, 0x10, 0x4c, 0x2f, 0xa8, 0xa0, 0xcc, 0xa5, 0x38,
-	0xe5, 0xa0, 0x2d, 0x58, 0x93, 0x72, 0xea, 0x6c, 0x34, 0x0b, 0x3b, 0x56, 0xb7, 0x3c, 0xbb, 0xd9,
-	0x5e, 0x3b, 0x3b, 0x7b, 0x81, 0x95, 0x4f, 0xdd, 0xa0, 0x63, 0x1e, 0x49, 0x46, 0x26, 0xd4, 0xb9,
-	0xa3, 0x6b, 0x3b, 0xb7, 0xd1, 0x0b, 0x00, 0x8f, 0x45, 0x43, 0x57, 0x1f, 0x59, 0xe7, 0xae, 0x5e,
-	0xdd, 0xe7, 0xef, 0x5e, 0x5d, 0xef, 0xe4, 0xd4, 0x74, 0x91, 0x8d, 0xd9, 0xcd, 0x76, 0x65, 0x6e,
-	0xe2, 0x8a, 0xc7, 0xa2, 0x64, 0x88, 0xba, 0x60, 0x8f, 0x29, 0x09, 0xe4, 0xd8, 0x1d, 0x53, 0xf7,
-	0xd2, 0xa9, 0xdd, 0xde, 0x16, 0x9e, 0x6a, 0x98, 0x89, 0x90, 0x25, 0x29, 0x05, 0xab, 0x54, 0x23,
-	0x67, 0x53, 0xd7, 0x2a, 0x31, 0xd0, 0x27, 0x00, 0x3c, 0xa4, 0x6c, 0x18, 0x49, 0xcf, 0x67, 0x0e,
-	0x52, 0x4b, 0xc6, 0x15, 0xe5, 0x39, 0x55, 0x0e, 0x74, 0x5f, 0x5d, 0xda, 0xc4, 0x1b, 0x72, 0x16,
-	0x4c, 0x9d, 0xef, 0xe9, 0x59, 0x4b, 0x39, 0x9e, 0xb1, 0x60, 0x8a, 0xb6, 0xc1, 0xd6, 0xba, 0x88,
-	0xfc, 0x11, 0x23, 0x81, 0x73, 0x4f, 0xd7, 0x03, 0x94, 0xeb, 0x54, 0x7b, 0xea, 0x5f, 0x82, 0x9d,
-	0x91, 0xbb, 0x92, 0xe9, 0x25, 0x9d, 0x9a, 0x13, 0xa4, 0x86, 0x2a, 0xa7, 0x2b, 0x12, 0xc4, 0xc9,
-	0x53, 0xb4, 0x82, 0x13, 0xe3, 0x97, 0xab, 0x8f, 0x0b, 0xf5, 0x3d, 0xb0, 0x33, 0xdb, 0x8e, 0x3e,
-	0x85, 0x0d, 0x41, 0x47, 0x7e, 0x24, 0xc5, 0x74, 0x48, 0x62, 0x39, 0x76, 0x7e, 0xa3, 0x09, 0xd5,
-	0xd4, 0xd9, 0x89, 0xe5, 0xb8, 0x3e, 0x84, 0x45, 0xf5, 0x50, 0x13, 0x6c, 0xb5, 0x2b, 0x11, 0x15,
-	0x57, 0x54, 0xa8, 0x76, 0xa7, 0x16, 0x9d, 0x75, 0x29, 0xf5, 0x44, 0x94, 0x08, 0x77, 0xac, 0x0f,
-	0x6f, 0x05, 0x1b, 0x4b, 0x9d, 0xc6, 0x54, 0xa2, 0xe6, 0x34, 0x1a, 0xb3, 0xf5, 0x9f, 0x02, 0x54,
-	0xb3, 0x5d, 0x1b, 0xed, 0x27, 0xdd, 0x56, 0x2f, 0xe9, 0xce, 0xde, 0xee, 0xbb, 0xba, 0xbc, 0xee,
-	0x6d, 0x41, 0xac, 0x82, 0x1d, 0xab, 0x07, 0xb6, 0x26, 0xa3, 0x9f, 0xc3, 0x7a, 0xc8, 0x85, 0x4c,
-	0xef, 0x90, 0x46, 0x6e, 0x3f, 0xe2, 0x22, 0xed, 0x05, 0x09, 0xb8, 0x35, 0x86, 0x3b, 0xcb, 0xd1,
-	0xd0, 0x43, 0x58, 0x7b, 0x7e, 0x38, 0xa8, 0xad, 0xd4, 0xef, 0xbf, 0xbc, 0x6e, 0x7e, 0x7f, 0x79,
-	0xf2, 0xb9, 0x2f, 0x64, 0x4c, 0x82, 0xc3, 0x01, 0xfa, 0x31, 0xac, 0xf7, 0x4e, 0x4e, 0x31, 0xae,
-	0x15, 0xea, 0xdb, 0x2f, 0xaf, 0x9b, 0xf7, 0x97, 0x71, 0x6a, 0x8a, 0xc7, 0xcc, 0xc3, 0xfc, 0x7c,
-	0xfe, 0xd8, 0xfc, 0xfb, 0x2a, 0xd8, 0xe6, 0x6a, 0xfd, 0xd0, 0xff, 0x23, 0x1b, 0x49, 0x2f, 0x4d,
-	0xcf, 0xcc, 0xea, 0x3b, 0x5b, 0x6a, 0x35, 0x21, 0x98, 0x3d, 0x7e, 0x00, 0x55, 0x3f, 0xbc, 0xfa,
-	0x62, 0x48, 0x19, 0x39, 0x0f, 0xcc, 0xbb, 0xd3, 0xc2, 0xb6, 0xf2, 0xf5, 0x13, 0x97, 0x3a, 0xb0,
-	0x3e, 0x93, 0x54, 0x30, 0xf3, 0xa2, 0xb4, 0xf0, 0xdc, 0x46, 0x5f, 0x41, 0xd1, 0x0f, 0xc9, 0xc4,
-	0xbc, 0x03, 0x72, 0x57, 0x70, 0x38, 0xe8, 0x1c, 0x1b, 0x0d, 0x76, 0xad, 0xd9, 0xcd, 0x76, 0x51,
-	0x39, 0xb0, 0xa6, 0xa1, 0x46, 0xda, 0x8a, 0xd5, 0x97, 0xf4, 0xe5, 0x6b, 0xe1, 0x8c, 0x47, 0xe9,
-	0xc8, 0x67, 0x23, 0x41, 0xa3, 0x48, 0x5f, 0xc3, 0x16, 0x4e, 0xcd, 0xd6, 0x7f, 0x8b, 0x60, 0xef,
-	0x07, 0x71, 0x24, 0x4d, 0x73, 0xf9, 0x60, 0x15, 0x7d, 0x01, 0x9b, 0x44, 0xff, 0xb4, 0x10, 0xa6,
-	0x6e, 0x6a, 0xfd, 0xf8, 0x31, 0x55, 0x7d, 0x98, 0x1b, 0x6e, 0x0e, 0x4e, 0x1e, 0x4a, 0xdd, 0x92,
-	0x8a, 0xe9, 0x14, 0x70, 0x8d, 0xbc, 0x36, 0x83, 0x4e, 0x61, 0x83, 0x0b, 0x77, 0x4c, 0x23, 0x99,
-	0xdc, 0xef, 0xe6, 0x91, 0x9f, 0xfb, 0xfb, 0xf7, 0x2c, 0x0b, 0x34, 0x97, 0x5b, 0x92, 0xed, 0x72,
-	0x0c, 0xf4, 0x18, 0x8a, 0x82, 0x5c, 0xa4, 0x0f, 0xb9, 0x5c, 0xe5, 0x63, 0x72, 0x21, 0x97, 0x42,
-	0x68, 0x06, 0xfa, 0x1d, 0x80, 0xe7, 0x47, 0x21, 0x91, 0xee, 0x98, 0x0a, 0xb3, 0x83, 0xb9, 0x4b,
-	0xec, 0xcd, 0x51, 0x4b, 0x51, 0x32, 0x6c, 0x74, 0x04, 0x15, 0x97, 0xa4, 0x1a, 0x2c, 0xdd, 0xfe,
-	0xe7, 0xb3, 0xdf, 0x31, 0x21, 0x6a, 0x2a, 0xc4, 0xec, 0x66, 0xdb, 0x4a, 0x3d, 0xd8, 0x72, 0x89,
-	0xd1, 0xe4, 0x11, 0x6c, 0xa8, 0x3f, 0xa2, 0xa1, 0x47, 0x2f, 0x48, 0x1c, 0xc8, 0x64, 0xef, 0x6f,
-	0xb9, 0xac, 0xd5, 0xf3, 0xba, 0x67, 0x70, 0x26, 0xaf, 0xaa, 0xcc, 0xf8, 0xd0, 0x1f, 0x60, 0x93,
-	0x32, 0x57, 0x4c, 0xb5, 0x02, 0xd3, 0x0c, 0xad, 0xdb, 0x17, 0xdb, 0x9f, 0x83, 0x97, 0x16, 0x5b,
-	0xa3, 0xaf, 0xf9, 0x5b, 0x3e, 0x40, 0xd2, 0xfe, 0x3e, 0xac, 0xfe, 0x10, 0x14, 0x3d, 0x22, 0x89,
-	0x96, 0x5c, 0x15, 0xeb, 0x71, 0xd7, 0x79, 0xf5, 0x5d, 0x63, 0xe5, 0x5f, 0xdf, 0x35, 0x56, 0xfe,
-	0x3c, 0x6b, 0x14, 0x5e, 0xcd, 0x1a, 0x85, 0x7f, 0xcc, 0x1a, 0x85, 0x7f, 0xcf, 0x1a, 0x85, 0xf3,
-	0x92, 0x7e, 0x34, 0xfc, 0xec, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x3e, 0xe8, 0xcb, 0x6d,
-	0x11, 0x00, 0x00,
+	// 1807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0xb9,
+	0x15, 0xf7, 0xd8, 0xb2, 0x34, 0x7a, 0x23, 0x27, 0x0a, 0x9b, 0xdd, 0x8e, 0x95, 0xae, 0xac, 0x68,
+	0xd3, 0xd4, 0xdb, 0xa2, 0x32, 0xea, 0x16, 0xdb, 0x6c, 0xb3, 0x8b, 0x56, 0xb2, 0x54, 0xc7, 0x75,
+	0xed, 0x08, 0xb4, 0x37, 0x6d, 0x4e, 0x02, 0x3d, 0x43, 0x4b, 0x03, 0x8f, 0xc8, 0x29, 0x87, 0xe3,
+	0x85, 0x6e, 0x3d, 0x2e, 0x72, 0xee, 0x35, 0xe8, 0xa1, 0x5f, 0x26, 0xb7, 0x16, 0x3d, 0x15, 0x28,
+	0x60, 0x74, 0xf5, 0x15, 0xfa, 0x01, 0x5a, 0x90, 0xc3, 0xd1, 0x9f, 0x64, 0xb4, 0x09, 0xb0, 0xb9,
+	0x91, 0x8f, 0xbf, 0xdf, 0x9b, 0xc7, 0xc7, 0x1f, 0xdf, 0xe3, 0x80, 0x13, 0x47, 0xd4, 0x8b, 0x5b,
+	0x91, 0xe0, 0x92, 0x23, 0xe4, 0x73, 0xef, 0x8a, 0x8a, 0x56, 0xfc, 0x15, 0x11, 0xe3, 0xab, 0x40,
+	0xb6, 0xae, 0x7f, 0x56, 0x73, 0xe4, 0x24, 0xa2, 0x06, 0x50, 0xbb, 0x3b, 0xe4, 0x43, 0xae, 0x87,
+	0x7b, 0x6a, 0x64, 0xac, 0xf5, 0x21, 0xe7, 0xc3, 0x90, 0xee, 0xe9, 0xd9, 0x45, 0x72, 0xb9, 0xe7,
+	0x27, 0x82, 0xc8, 0x80, 0x33, 0xb3, 0xbe, 0xfd, 0xfa, 0x3a, 0x61, 0x93, 0x74, 0xa9, 0xf9, 0xef,
+	0x02, 0xd8, 0xa7, 0xdc, 0xa7, 0x67, 0x11, 0xf5, 0xd0, 0x21, 0x38, 0x84, 0x31, 0x2e, 0x35, 0x37,
+	0x76, 0xad, 0x86, 0xb5, 0xeb, 0xec, 0xef, 0xb4, 0xde, 0x0c, 0xaa, 0xd5, 0x9e, 0xc3, 0x3a, 0x85,
+	0x57, 0x37, 0x3b, 0x6b, 0x78, 0x91, 0x89, 0x7e, 0x0d, 0x15, 0x9f, 0xc6, 0x81, 0xa0, 0xfe, 0x40,
+	0xf0, 0x90, 0xba, 0xeb, 0x0d, 0x6b, 0xf7, 0xd6, 0xfe, 0x0f, 0xf2, 0x3c, 0xa9, 0x8f, 0x63, 0x1e,
+	0x52, 0xec, 0x18, 0x86, 0x9a, 0xa0, 0x43, 0x80, 0x31, 0x1d, 0x5f, 0x50, 0x11, 0x8f, 0x82, 0xc8,
+	0xdd, 0xd0, 0xf4, 0x1f, 0xad, 0xa2, 0xab, 0xd8, 0x5b, 0x27, 0x33, 0x38, 0x5e, 0xa0, 0xa2, 0x13,
+	0xa8, 0x90, 0x6b, 0x12, 0x84, 0xe4, 0x22, 0x08, 0x03, 0x39, 0x71, 0x0b, 0xda, 0xd5, 0x27, 0xdf,
+	0xea, 0xaa, 0xbd, 0x40, 0xc0, 0x4b, 0x74, 0xf4, 0x39, 0xd4, 0x2e, 0xb9, 0xf0, 0xe8, 0xc0, 0xa3,
+	0x42, 0x06, 0x97, 0x81, 0x47, 0x24, 0x1d, 0x08, 0xb3, 0x6f, 0x77, 0xb3, 0x61, 0xed, 0x16, 0xb0,
+	0xab, 0x11, 0x07, 0x73, 0x00, 0x36, 0xeb, 0x4d, 0x1f, 0x60, 0x1e, 0x26, 0x7a, 0x08, 0xa5, 0x7e,
+	0xef, 0xb4, 0x7b, 0x74, 0x7a, 0x58, 0x5d, 0xab, 0x6d, 0xbf, 0x78, 0xd9, 0xf8, 0x40, 0x45, 0x30,
+	0x07, 0xf4, 0x29, 0xf3, 0x03, 0x36, 0x44, 0xbb, 0x60, 0xb7, 0x0f, 0x0e, 0x7a, 0xfd, 0xf3, 0x5e,
+	0xb7, 0x6a, 0xd5, 0x6a, 0x2f, 0x5e, 0x36, 0x3e, 0x5c, 0x06, 0xb6, 0x3d, 0x8f, 0x46, 0x92, 0xfa,
+	0xb5, 0xc2, 0xd7, 0x7f, 0xab, 0xaf, 0x35, 0xbf, 0xb6, 0xa0, 0xb2, 0xb8, 0x05, 0xf4, 0x10, 0x8a,
+	0xed, 0x83, 0xf3, 0xa3, 0x67, 0xbd, 0xea, 0xda, 0x9c, 0xbe, 0x88, 0x68, 0x7b, 0x32, 0xb8, 0xa6,
+	0xe8, 0x01, 0x6c, 0xf6, 0xdb, 0x5f, 0x9e, 0xf5, 0xaa, 0xd6, 0x3c, 0x9c, 0x45, 0x58, 0x9f, 0x24,
+	0xb1, 0x46, 0x75, 0x71, 0xfb, 0xe8, 0xb4, 0xba, 0x9e, 0x8f, 0xea, 0x0a, 0x12, 0x30, 0x13, 0xca,
+	0x5f, 0x0b, 0xe0, 0x9c, 0x51, 0x71, 0x1d, 0x78, 0xef, 0x59, 0x60, 0x9f, 0x42, 0x41, 0x92, 0xf8,
+	0x4a, 0x0b, 0xcb, 0xc9, 0x17, 0xd6, 0x39, 0x89, 0xaf, 0xd4, 0x47, 0x0d, 0x5d, 0xe3, 0x95, 0xae,
+	0x04, 0x8d, 0x42, 0x7d, 0x2c, 0xbe, 0xd6, 0x95, 0xb3, 0xff, 0xc3, 0x3c, 0x36, 0x9e, 0xa1, 0x4c,
+	0xfc, 0x4f, 0xd6, 0xf0, 0x02, 0x15, 0x3d, 0x86, 0xe2, 0x30, 0xe4, 0x17, 0x24, 0xd4, 0x8a, 0x72,
+	0xf6, 0xef, 0xe7, 0x39, 0x39, 0xd4, 0x88, 0xb9, 0x03, 0x43, 0x41, 0x8f, 0xa0, 0x98, 0x44, 0x3e,
+	0x91, 0xd4, 0x2d, 0x6a, 0x72, 0x23, 0x8f, 0xfc, 0xa5, 0x46, 0x1c, 0x70, 0x76, 0x19, 0x0c, 0xb1,
+	0xc1, 0xa3, 0x63, 0xb0, 0x19, 0x95, 0x5f, 0x71, 0x71, 0x15, 0xbb, 0xa5, 0xc6, 0xc6, 0xae, 0xb3,
+	0xff, 0x93, 0x5c, 0x29, 0xa7, 0x98, 0xb6, 0x94, 0xc4, 0x1b, 0x8d, 0x29, 0x93, 0xa9, 0x9b, 0xce,
+	0xba, 0x6b, 0xe1, 0x99, 0x03, 0xf4, 0x39, 0xd8, 0x94, 0xf9, 0x11, 0x0f, 0x98, 0x74, 0xed, 0xd5,
+	0x81, 0xf4, 0x0c, 0x46, 0x25, 0x13, 0xcf, 0x18, 0x8a, 0x2d, 0x78, 0x18, 0x5e, 0x10, 0xef, 0xca,
+	0x2d, 0xbf, 0xe3, 0x36, 0x66, 0x8c, 0x4e, 0x11, 0x0a, 0x63, 0xee, 0xd3, 0xe6, 0x1e, 0xdc, 0x79,
+	0x23, 0xd5, 0xa8, 0x06, 0xb6, 0x49, 0x75, 0xaa, 0x91, 0x02, 0x9e, 0xcd, 0x9b, 0xb7, 0x61, 0x6b,
+	0x29, 0xad, 0xcd, 0x7f, 0x16, 0xc0, 0xce, 0xce, 0x1a, 0xb5, 0xa1, 0xec, 0x71, 0x26, 0x49, 0xc0,
+	0xa8, 0x70, 0xad, 0xd5, 0x27, 0x73, 0x90, 0x81, 0x14, 0xeb, 0xc9, 0x1a, 0x9e, 0xb3, 0xd0, 0x6f,
+	0xa1, 0x2c, 0x68, 0xcc, 0x13, 0xe1, 0xd1, 0xd8, 0xe8, 0x6b, 0x37, 0x5f, 0x21, 0x29, 0x08, 0xd3,
+	0x3f, 0x25, 0x81, 0xa0, 0x2a, 0xcb, 0x31, 0x9e, 0x53, 0xd1, 0x63, 0x28, 0x09, 0x1a, 0x4b, 0x22,
+	0xe4, 0xb7, 0x49, 0x04, 0xa7, 0x90, 0x3e, 0x0f, 0x03, 0x6f, 0x82, 0x33, 0x06, 0x7a, 0x0c, 0xe5,
+	0x28, 0x24, 0x9e, 0xf6, 0xaa, 0xcb, 0x8a, 0xb3, 0xff, 0x51, 0x1e, 0xbd, 0x9f, 0x81, 0xf0, 0x1c,
+	0x8f, 0x3e, 0x03, 0x08, 0xf9, 0x70, 0xe0, 0x8b, 0xe0, 0x9a, 0x0a, 0x23, 0xb1, 0x5a, 0x1e, 0xbb,
+	0xab, 0x11, 0xb8, 0x1c, 0xf2, 0x61, 0x3a, 0x44, 0x87, 0xdf, 0x49, 0x5f, 0x0b, 0xda, 0x3a, 0x06,
+	0x20, 0xb3, 0x55, 0xa3, 0xae, 0x4f, 0xde, 0xc9, 0x95, 0x39, 0x91, 0x05, 0x3a, 0xba, 0x0f, 0x95,
+	0xb4, 0xea, 0x9a, 0x5b, 0x53, 0xd6, 0x9a, 0x70, 0xb4, 0x2d, 0xd5, 0x17, 0xea, 0x40, 0x69, 0x48,
+	0x19, 0x15, 0x81, 0xe7, 0x82, 0xfe, 0xd8, 0xc3, 0xdc, 0x0b, 0x99, 0x42, 0x70, 0xc2, 0x64, 0x30,
+	0xa6, 0xe6, 0x4b, 0x19, 0xb1, 0x53, 0x86, 0x92, 0x48, 0x57, 0x9a, 0x7f, 0x04, 0xf4, 0x26, 0x16,
+	0x21, 0x28, 0x5c, 0x05, 0xcc, 0xd7, 0xc2, 0x2a, 0x63, 0x3d, 0x46, 0x2d, 0x28, 0x45, 0x64, 0x12,
+	0x72, 0xe2, 0x1b, 0xb1, 0xdc, 0x6d, 0xa5, 0xdd, 0xb6, 0x95, 0x75, 0xdb, 0x56, 0x9b, 0x4d, 0x70,
+	0x06, 0x6a, 0x1e, 0xc3, 0x07, 0xb9, 0x5b, 0x46, 0xfb, 0x50, 0x99, 0x89, 0x70, 0x10, 0x98, 0x8f,
+	0x74, 0x6e, 0x4f, 0x6f, 0x76, 0x9c, 0x99, 0x5a, 0x8f, 0xba, 0xd8, 0x99, 0x81, 0x8e, 0xfc, 0xe6,
+	0x5f, 0x6c, 0xd8, 0x5a, 0x92, 0x32, 0xba, 0x0b, 0x9b, 0xc1, 0x98, 0x0c, 0xa9, 0x89, 0x31, 0x9d,
+	0xa0, 0x1e, 0x14, 0x43, 0x72, 0x41, 0x43, 0x25, 0x68, 0x75, 0xa8, 0x3f, 0x7d, 0xeb, 0x9d, 0x68,
+	0xfd, 0x5e, 0xe3, 0x7b, 0x4c, 0x8a, 0x09, 0x36, 0x64, 0xe4, 0x42, 0xc9, 0xe3, 0xe3, 0x31, 0x61,
+	0xaa, 0x74, 0x6e, 0xec, 0x96, 0x71, 0x36, 0x55, 0x99, 0x21, 0x62, 0x18, 0xbb, 0x05, 0x6d, 0xd6,
+	0x63, 0x54, 0x85, 0x0d, 0xca, 0xae, 0xdd, 0x4d, 0x6d, 0x52, 0x43, 0x65, 0xf1, 0x83, 0x54, 0x91,
+	0x65, 0xac, 0x86, 0x8a, 0x97, 0xc4, 0x54, 0xb8, 0xa5, 0x34, 0xa3, 0x6a, 0x8c, 0x7e, 0x09, 0xc5,
+	0x31, 0x4f, 0x98, 0x8c, 0x5d, 0x5b, 0x07, 0xbb, 0x9d, 0x17, 0xec, 0x89, 0x42, 0x98, 0xd2, 0x6e,
+	0xe0, 0xa8, 0x07, 0x77, 0x62, 0xc9, 0xa3, 0xc1, 0x50, 0x10, 0x8f, 0x0e, 0x22, 0x2a, 0x02, 0xee,
+	0x9b, 0xd2, 0xb4, 0xfd, 0xc6, 0xa1, 0x74, 0xcd, 0x13, 0x09, 0xdf, 0x56, 0x9c, 0x43, 0x45, 0xe9,
+	0x6b, 0x06, 0xea, 0x43, 0x25, 0x4a, 0xc2, 0x70, 0xc0, 0xa3, 0xb4, 0x4b, 0xa5, 0x7a, 0x7a, 0x87,
+	0x94, 0xf5, 0x93, 0x30, 0x7c, 0x9a, 0x92, 0xb0, 0x13, 0xcd, 0x27, 0xe8, 0x43, 0x28, 0x0e, 0x05,
+	0x4f, 0xa2, 0xd8, 0x75, 0x74, 0x32, 0xcc, 0x0c, 0x7d, 0x01, 0xa5, 0x98, 0x7a, 0x82, 0xca, 0xd8,
+	0xad, 0xe8, 0xad, 0x7e, 0x9c, 0xf7, 0x91, 0x33, 0x0d, 0xc1, 0xf4, 0x92, 0x0a, 0xca, 0x3c, 0x8a,
+	0x33, 0x0e, 0xda, 0x86, 0x0d, 0x29, 0x27, 0xee, 0x56, 0xc3, 0xda, 0xb5, 0x3b, 0xa5, 0xe9, 0xcd,
+	0xce, 0xc6, 0xf9, 0xf9, 0x73, 0xac, 0x6c, 0xaa, 0x82, 0x8e, 0x78, 0x2c, 0x19, 0x19, 0x53, 0xf7,
+	0x96, 0xce, 0xed, 0x6c, 0x8e, 0x9e, 0x03, 0xf8, 0x2c, 0x1e, 0x78, 0xfa, 0xca, 0xba, 0xb7, 0x1b,
+	0xd6, 0xaa, 0x5b, 0xbe, 0xbc, 0xbb, 0xee, 0xe9, 0x99, 0xe9, 0x22, 0x5b, 0xd3, 0x9b, 0x9d, 0xf2,
+	0x6c, 0x8a, 0xcb, 0x3e, 0x8b, 0xd3, 0x21, 0xea, 0x80, 0x33, 0xa2, 0x24, 0x94, 0x23, 0x6f, 0x44,
+	0xbd, 0x2b, 0xb7, 0xba, 0xba, 0x2d, 0x3c, 0xd1, 0x30, 0xe3, 0x61, 0x91, 0xa4, 0x14, 0xac, 0x42,
+	0x8d, 0xdd, 0x3b, 0x3a, 0x57, 0xe9, 0x04, 0x7d, 0x04, 0xc0, 0x23, 0xca, 0x06, 0xb1, 0xf4, 0x03,
+	0xe6, 0x22, 0xb5, 0x65, 0x5c, 0x56, 0x96, 0x33, 0x65, 0x40, 0xf7, 0x54, 0xd1, 0x26, 0xfe, 0x80,
+	0xb3, 0x70, 0xe2, 0x7e, 0x4f, 0xaf, 0xda, 0xca, 0xf0, 0x94, 0x85, 0x13, 0xb4, 0x03, 0x8e, 0xd6,
+	0x45, 0x1c, 0x0c, 0x19, 0x09, 0xdd, 0xbb, 0x3a, 0x1f, 0xa0, 0x4c, 0x67, 0xda, 0x52, 0xfb, 0x0c,
+	0x9c, 0x05, 0xb9, 0x2b, 0x99, 0x5e, 0xd1, 0x89, 0xb9, 0x41, 0x6a, 0xa8, 0x62, 0xba, 0x26, 0x61,
+	0x92, 0x3e, 0x64, 0xcb, 0x38, 0x9d, 0xfc, 0x6a, 0xfd, 0x91, 0x55, 0xdb, 0x07, 0x67, 0xe1, 0xd8,
+	0xd1, 0xc7, 0xb0, 0x25, 0xe8, 0x30, 0x88, 0xa5, 0x98, 0x0c, 0x48, 0x22, 0x47, 0xee, 0x6f, 0x34,
+	0xa1, 0x92, 0x19, 0xdb, 0x89, 0x1c, 0xd5, 0x06, 0x30, 0xcf, 0x1e, 0x6a, 0x80, 0xa3, 0x4e, 0x25,
+	0xa6, 0xe2, 0x9a, 0x0a, 0xd5, 0xee, 0xd4, 0xa6, 0x17, 0x4d, 0x4a, 0x3d, 0x31, 0x25, 0xc2, 0x1b,
+	0xe9, 0xcb, 0x5b, 0xc6, 0x66, 0xa6, 0x6e, 0x63, 0x26, 0x51, 0x73, 0x1b, 0xcd, 0xb4, 0xf9, 0x5f,
+	0x0b, 0x2a, 0x8b, 0x5d, 0x1b, 0x1d, 0xa4, 0xdd, 0x56, 0x6f, 0xe9, 0xd6, 0xfe, 0xde, 0xdb, 0xba,
+	0xbc, 0xee, 0x6d, 0x61, 0xa2, 0x9c, 0x9d, 0xa8, 0xe7, 0xb9, 0x26, 0xa3, 0x5f, 0xc0, 0x66, 0xc4,
+	0x85, 0xcc, 0x6a, 0x48, 0x3d, 0xb7, 0x1f, 0x71, 0x91, 0xf5, 0x82, 0x14, 0xdc, 0x1c, 0xc1, 0xad,
+	0x65, 0x6f, 0xe8, 0x01, 0x6c, 0x3c, 0x3b, 0xea, 0x57, 0xd7, 0x6a, 0xf7, 0x5e, 0xbc, 0x6c, 0x7c,
+	0x7f, 0x79, 0xf1, 0x59, 0x20, 0x64, 0x42, 0xc2, 0xa3, 0x3e, 0xfa, 0x31, 0x6c, 0x76, 0x4f, 0xcf,
+	0x30, 0xae, 0x5a, 0xb5, 0x9d, 0x17, 0x2f, 0x1b, 0xf7, 0x96, 0x71, 0x6a, 0x89, 0x27, 0xcc, 0xc7,
+	0xfc, 0x62, 0xf6, 0xd8, 0xfc, 0xfb, 0x3a, 0x38, 0xa6, 0xb4, 0xbe, 0xef, 0xbf, 0x99, 0xad, 0xb4,
+	0x97, 0x66, 0x77, 0x66, 0xfd, 0xad, 0x2d, 0xb5, 0x92, 0x12, 0xcc, 0x19, 0xdf, 0x87, 0x4a, 0x10,
+	0x5d, 0x7f, 0x3a, 0xa0, 0x8c, 0x5c, 0x84, 0xe6, 0xdd, 0x69, 0x63, 0x47, 0xd9, 0x7a, 0xa9, 0x49,
+	0x5d, 0xd8, 0x80, 0x49, 0x2a, 0x98, 0x79, 0x51, 0xda, 0x78, 0x36, 0x47, 0x5f, 0x40, 0x21, 0x88,
+	0xc8, 0xd8, 0xdd, 0x5c, 0xbd, 0x83, 0xa3, 0x7e, 0xfb, 0xc4, 0x68, 0xb0, 0x63, 0x4f, 0x6f, 0x76,
+	0x0a, 0xca, 0x80, 0x35, 0x0d, 0xd5, 0xb3, 0x56, 0xac, 0xbe, 0xa4, 0x8b, 0xaf, 0x8d, 0x17, 0x2c,
+	0x4a, 0x47, 0x01, 0x1b, 0x0a, 0x1a, 0xc7, 0xba, 0x0c, 0xdb, 0x38, 0x9b, 0x36, 0xff, 0x57, 0x00,
+	0xe7, 0x20, 0x4c, 0x62, 0x49, 0xc5, 0xfb, 0xcd, 0xe8, 0x73, 0xb8, 0x43, 0xf4, 0x4f, 0x0b, 0x61,
+	0xaa, 0x52, 0xeb, 0xc7, 0x8f, 0xc9, 0xea, 0x83, 0x5c, 0x77, 0x33, 0x70, 0xfa, 0x50, 0xea, 0x14,
+	0x95, 0x4f, 0xd7, 0xc2, 0x55, 0xf2, 0xda, 0x0a, 0x3a, 0x83, 0x2d, 0x2e, 0xbc, 0x11, 0x8d, 0x65,
+	0x5a, 0xdf, 0xcd, 0x23, 0x3f, 0xf7, 0xe7, 0xf1, 0xe9, 0x22, 0xd0, 0x14, 0xb7, 0x34, 0xda, 0x65,
+	0x1f, 0xe8, 0x11, 0x14, 0x04, 0xb9, 0xcc, 0x1e, 0x72, 0xb9, 0xca, 0xc7, 0xe4, 0x52, 0x2e, 0xb9,
+	0xd0, 0x0c, 0xf4, 0x3b, 0x00, 0x3f, 0x88, 0x23, 0x22, 0xbd, 0x11, 0x15, 0xee, 0xe6, 0xea, 0x2d,
+	0x76, 0x67, 0xa8, 0x25, 0x2f, 0x0b, 0x6c, 0x74, 0x0c, 0x65, 0x8f, 0x64, 0x1a, 0x2c, 0xae, 0xfe,
+	0xf3, 0x39, 0x68, 0x1b, 0x17, 0x55, 0xe5, 0x62, 0x7a, 0xb3, 0x63, 0x67, 0x16, 0x6c, 0x7b, 0x24,
+	0x1d, 0xa1, 0x63, 0xd8, 0x52, 0x7f, 0x44, 0x03, 0x9f, 0x5e, 0x92, 0x24, 0x94, 0xe9, 0xd9, 0xaf,
+	0x28, 0xd6, 0xea, 0x79, 0xdd, 0x35, 0x38, 0x13, 0x57, 0x45, 0x2e, 0xd8, 0xd0, 0x1f, 0xe0, 0x0e,
+	0x65, 0x9e, 0x98, 0x68, 0x05, 0x66, 0x11, 0xda, 0xab, 0x37, 0xdb, 0x9b, 0x81, 0x97, 0x36, 0x5b,
+	0xa5, 0xaf, 0xd9, 0x9b, 0x01, 0x40, 0xda, 0xfe, 0xde, 0xaf, 0xfe, 0x10, 0x14, 0x7c, 0x22, 0x89,
+	0x96, 0x5c, 0x05, 0xeb, 0x71, 0xc7, 0x7d, 0xf5, 0x4d, 0x7d, 0xed, 0x5f, 0xdf, 0xd4, 0xd7, 0xfe,
+	0x3c, 0xad, 0x5b, 0xaf, 0xa6, 0x75, 0xeb, 0x1f, 0xd3, 0xba, 0xf5, 0x9f, 0x69, 0xdd, 0xba, 0x28,
+	0xea, 0x47, 0xc3, 0xcf, 0xff, 0x3f, 0x00, 0xde, 0xcc, 0x25, 0x18, 0xab, 0x11, 0x00, 0x00,
 }
@@ -60,6 +60,7 @@
 		JoinTokens
 		RootCA
 		Certificate
+		CertificateIssuance
 		EncryptionKey
 		ManagerStatus
 		SecretReference
@@ -157,6 +158,8 @@
 		IssueNodeCertificateResponse
 		GetRootCACertificateRequest
 		GetRootCACertificateResponse
+		WatchCertificateAuditRequest
+		CertificateAuditEvent
 		GetUnlockKeyRequest
 		GetUnlockKeyResponse
 		StoreSnapshot
@@ -695,7 +698,7 @@ func (x EncryptionKey_Algorithm) String() string {
 	return proto.EnumName(EncryptionKey_Algorithm_name, int32(x))
 }
 func (EncryptionKey_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{41, 0}
+	return fileDescriptorTypes, []int{42, 0}
 }
 
 type MaybeEncryptedRecord_Algorithm int32
@@ -718,7 +721,7 @@ func (x MaybeEncryptedRecord_Algorithm) String() string {
 	return proto.EnumName(MaybeEncryptedRecord_Algorithm_name, int32(x))
 }
 func (MaybeEncryptedRecord_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{46, 0}
+	return fileDescriptorTypes, []int{47, 0}
 }
 
 // Version tracks the last time an object in the store was updated.
@@ -840,7 +843,8 @@ func (*RaftMemberStatus) Descriptor() ([]byte, []int) { return fileDescriptorTyp
 type NodeStatus struct {
 	State   NodeStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=docker.swarmkit.v1.NodeStatus_State" json:"state,omitempty"`
 	Message string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Addr is the node's IP address as observed by the manager
+	// Addr is the node's IP address as advertised by the node, or otherwise
+	// as observed by the manager
 	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
@@ -1345,6 +1349,11 @@ type CAConfig struct {
 	// ExternalCAs is a list of CAs to which a manager node will make
 	// certificate signing requests for node certificates.
 	ExternalCAs []*ExternalCA `protobuf:"bytes,2,rep,name=external_cas,json=externalCas" json:"external_cas,omitempty"`
+	// ManagerSANs is a list of subject alternative names, such as a DNS
+	// name that all managers are reachable under, which the CA adds to
+	// every manager certificate it issues, regardless of what the
+	// manager's CSR requested.
+	ManagerSANs []string `protobuf:"bytes,3,rep,name=manager_sans,json=managerSans" json:"manager_sans,omitempty"`
 }
 
 func (m *CAConfig) Reset()                    { *m = CAConfig{} }
@@ -1571,12 +1580,35 @@ type Certificate struct {
 	Certificate []byte         `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
 	// CN represents the node ID.
 	CN string `protobuf:"bytes,5,opt,name=cn,proto3" json:"cn,omitempty"`
+	// Organization is the organization the certificate is issued for. If
+	// empty, the certificate is issued for the cluster's own organization.
+	Organization string `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization,omitempty"`
+	// RequestedExpiry is the validity the node asked for, if any. The
+	// certificate is only issued for it if it is shorter than the CA's
+	// default.
+	RequestedExpiry *google_protobuf1.Duration `protobuf:"bytes,7,opt,name=requested_expiry,json=requestedExpiry" json:"requested_expiry,omitempty"`
 }
 
 func (m *Certificate) Reset()                    { *m = Certificate{} }
 func (*Certificate) ProtoMessage()               {}
 func (*Certificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
 
+// CertificateIssuance records a certificate that was issued for a node.
+type CertificateIssuance struct {
+	// SerialNumber is the hex encoded serial number of the certificate.
+	SerialNumber string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Issuer is the subject of the CA certificate that signed the certificate.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// IssuedAt is when the CA issued the certificate.
+	IssuedAt *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt" json:"issued_at,omitempty"`
+	// NotAfter is when the certificate expires.
+	NotAfter *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=not_after,json=notAfter" json:"not_after,omitempty"`
+}
+
+func (m *CertificateIssuance) Reset()                    { *m = CertificateIssuance{} }
+func (*CertificateIssuance) ProtoMessage()               {}
+func (*CertificateIssuance) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
+
 // Symmetric keys to encrypt inter-agent communication.
 type EncryptionKey struct {
 	// Agent subsystem the key is intended for. Example:
@@ -1591,7 +1623,7 @@ type EncryptionKey struct {
 
 func (m *EncryptionKey) Reset()                    { *m = EncryptionKey{} }
 func (*EncryptionKey) ProtoMessage()               {}
-func (*EncryptionKey) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
+func (*EncryptionKey) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
 
 // ManagerStatus provides informations about the state of a manager in the cluster.
 type ManagerStatus struct {
@@ -1608,7 +1640,7 @@ type ManagerStatus struct {
 
 func (m *ManagerStatus) Reset()                    { *m = ManagerStatus{} }
 func (*ManagerStatus) ProtoMessage()               {}
-func (*ManagerStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+func (*ManagerStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
 
 // SecretReference is the linkage between a service and a secret that it uses.
 type SecretReference struct {
@@ -1628,7 +1660,7 @@ type SecretReference struct {
 
 func (m *SecretReference) Reset()                    { *m = SecretReference{} }
 func (*SecretReference) ProtoMessage()               {}
-func (*SecretReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
+func (*SecretReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
 
 type isSecretReference_Target interface {
 	isSecretReference_Target()
@@ -1726,7 +1758,7 @@ type SecretReference_FileTarget struct {
 func (m *SecretReference_FileTarget) Reset()      { *m = SecretReference_FileTarget{} }
 func (*SecretReference_FileTarget) ProtoMessage() {}
 func (*SecretReference_FileTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{43, 0}
+	return fileDescriptorTypes, []int{44, 0}
 }
 
 // BlacklistedCertificate is a record for a blacklisted certificate. It does not
@@ -1740,7 +1772,7 @@ type BlacklistedCertificate struct {
 
 func (m *BlacklistedCertificate) Reset()                    { *m = BlacklistedCertificate{} }
 func (*BlacklistedCertificate) ProtoMessage()               {}
-func (*BlacklistedCertificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
+func (*BlacklistedCertificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
 
 // HealthConfig holds configuration settings for the HEALTHCHECK feature.
 type HealthConfig struct {
@@ -1770,7 +1802,7 @@ type HealthConfig struct {
 
 func (m *HealthConfig) Reset()                    { *m = HealthConfig{} }
 func (*HealthConfig) ProtoMessage()               {}
-func (*HealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+func (*HealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
 
 type MaybeEncryptedRecord struct {
 	Algorithm MaybeEncryptedRecord_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=docker.swarmkit.v1.MaybeEncryptedRecord_Algorithm" json:"algorithm,omitempty"`
@@ -1780,7 +1812,7 @@ type MaybeEncryptedRecord struct {
 
 func (m *MaybeEncryptedRecord) Reset()                    { *m = MaybeEncryptedRecord{} }
 func (*MaybeEncryptedRecord) ProtoMessage()               {}
-func (*MaybeEncryptedRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
+func (*MaybeEncryptedRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
 
 type RootRotation struct {
 	CACert []byte `protobuf:"bytes,1,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
@@ -1791,7 +1823,7 @@ type RootRotation struct {
 
 func (m *RootRotation) Reset()                    { *m = RootRotation{} }
 func (*RootRotation) ProtoMessage()               {}
-func (*RootRotation) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
+func (*RootRotation) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
 
 func init() {
 	proto.RegisterType((*Version)(nil), "docker.swarmkit.v1.Version")
@@ -1840,6 +1872,7 @@ func init() {
 	proto.RegisterType((*JoinTokens)(nil), "docker.swarmkit.v1.JoinTokens")
 	proto.RegisterType((*RootCA)(nil), "docker.swarmkit.v1.RootCA")
 	proto.RegisterType((*Certificate)(nil), "docker.swarmkit.v1.Certificate")
+	proto.RegisterType((*CertificateIssuance)(nil), "docker.swarmkit.v1.CertificateIssuance")
 	proto.RegisterType((*EncryptionKey)(nil), "docker.swarmkit.v1.EncryptionKey")
 	proto.RegisterType((*ManagerStatus)(nil), "docker.swarmkit.v1.ManagerStatus")
 	proto.RegisterType((*SecretReference)(nil), "docker.swarmkit.v1.SecretReference")
@@ -2580,6 +2613,11 @@ func (m *CAConfig) CopyFrom(src interface{}) {
 		}
 	}
 
+	if o.ManagerSANs != nil {
+		m.ManagerSANs = make([]string, len(o.ManagerSANs))
+		copy(m.ManagerSANs, o.ManagerSANs)
+	}
+
 }
 
 func (m *OrchestrationConfig) Copy() *OrchestrationConfig {
@@ -2783,6 +2821,33 @@ func (m *Certificate) CopyFrom(src interface{}) {
 	o := src.(*Certificate)
 	*m = *o
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Status, &o.Status)
+	if o.RequestedExpiry != nil {
+		m.RequestedExpiry = &google_protobuf1.Duration{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.RequestedExpiry, o.RequestedExpiry)
+	}
+}
+
+func (m *CertificateIssuance) Copy() *CertificateIssuance {
+	if m == nil {
+		return nil
+	}
+	o := &CertificateIssuance{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *CertificateIssuance) CopyFrom(src interface{}) {
+
+	o := src.(*CertificateIssuance)
+	*m = *o
+	if o.IssuedAt != nil {
+		m.IssuedAt = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.IssuedAt, o.IssuedAt)
+	}
+	if o.NotAfter != nil {
+		m.NotAfter = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.NotAfter, o.NotAfter)
+	}
 }
 
 func (m *EncryptionKey) Copy() *EncryptionKey {
@@ -4369,6 +4434,21 @@ func (m *CAConfig) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ManagerSANs) > 0 {
+		for _, s := range m.ManagerSANs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -4760,6 +4840,72 @@ func (m *Certificate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.CN)))
 		i += copy(dAtA[i:], m.CN)
 	}
+	if len(m.Organization) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Organization)))
+		i += copy(dAtA[i:], m.Organization)
+	}
+	if m.RequestedExpiry != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestedExpiry.Size()))
+		n31, err := m.RequestedExpiry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+
+func (m *CertificateIssuance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateIssuance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SerialNumber) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SerialNumber)))
+		i += copy(dAtA[i:], m.SerialNumber)
+	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
+	if m.IssuedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuedAt.Size()))
+		n32, err := m.IssuedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.NotAfter != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.NotAfter.Size()))
+		n33, err := m.NotAfter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
 	return i, nil
 }
 
@@ -4875,11 +5021,11 @@ func (m *SecretReference) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.SecretName)
 	}
 	if m.Target != nil {
-		nn31, err := m.Target.MarshalTo(dAtA[i:])
+		nn34, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn31
+		i += nn34
 	}
 	return i, nil
 }
@@ -4890,11 +5036,11 @@ func (m *SecretReference_File) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.File.Size()))
-		n32, err := m.File.MarshalTo(dAtA[i:])
+		n35, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n35
 	}
 	return i, nil
 }
@@ -4958,11 +5104,11 @@ func (m *BlacklistedCertificate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Expiry.Size()))
-		n33, err := m.Expiry.MarshalTo(dAtA[i:])
+		n36, err := m.Expiry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n36
 	}
 	return i, nil
 }
@@ -5001,21 +5147,21 @@ func (m *HealthConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Interval.Size()))
-		n34, err := m.Interval.MarshalTo(dAtA[i:])
+		n37, err := m.Interval.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n37
 	}
 	if m.Timeout != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout.Size()))
-		n35, err := m.Timeout.MarshalTo(dAtA[i:])
+		n38, err := m.Timeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n38
 	}
 	if m.Retries != 0 {
 		dAtA[i] = 0x20
@@ -5026,11 +5172,11 @@ func (m *HealthConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.StartPeriod.Size()))
-		n36, err := m.StartPeriod.MarshalTo(dAtA[i:])
+		n39, err := m.StartPeriod.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n39
 	}
 	return i, nil
 }
@@ -5755,6 +5901,12 @@ func (m *CAConfig) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.ManagerSANs) > 0 {
+		for _, s := range m.ManagerSANs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5921,6 +6073,36 @@ func (m *Certificate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Organization)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RequestedExpiry != nil {
+		l = m.RequestedExpiry.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CertificateIssuance) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SerialNumber)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IssuedAt != nil {
+		l = m.IssuedAt.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = m.NotAfter.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -6576,6 +6758,7 @@ func (this *CAConfig) String() string {
 	s := strings.Join([]string{`&CAConfig{`,
 		`NodeCertExpiry:` + strings.Replace(fmt.Sprintf("%v", this.NodeCertExpiry), "Duration", "google_protobuf1.Duration", 1) + `,`,
 		`ExternalCAs:` + strings.Replace(fmt.Sprintf("%v", this.ExternalCAs), "ExternalCA", "ExternalCA", 1) + `,`,
+		`ManagerSANs:` + fmt.Sprintf("%v", this.ManagerSANs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6710,6 +6893,21 @@ func (this *Certificate) String() string {
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "IssuanceStatus", "IssuanceStatus", 1), `&`, ``, 1) + `,`,
 		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
 		`CN:` + fmt.Sprintf("%v", this.CN) + `,`,
+		`Organization:` + fmt.Sprintf("%v", this.Organization) + `,`,
+		`RequestedExpiry:` + strings.Replace(fmt.Sprintf("%v", this.RequestedExpiry), "Duration", "google_protobuf1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CertificateIssuance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateIssuance{`,
+		`SerialNumber:` + fmt.Sprintf("%v", this.SerialNumber) + `,`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`IssuedAt:` + strings.Replace(fmt.Sprintf("%v", this.IssuedAt), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
+		`NotAfter:` + strings.Replace(fmt.Sprintf("%v", this.NotAfter), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11761,6 +11959,35 @@ func (m *CAConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerSANs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerSANs = append(m.ManagerSANs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12984,6 +13211,242 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 			}
 			m.CN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestedExpiry == nil {
+				m.RequestedExpiry = &google_protobuf1.Duration{}
+			}
+			if err := m.RequestedExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CertificateIssuance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateIssuance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerialNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SerialNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IssuedAt == nil {
+				m.IssuedAt = &google_protobuf.Timestamp{}
+			}
+			if err := m.IssuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotAfter == nil {
+				m.NotAfter = &google_protobuf.Timestamp{}
+			}
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14248,271 +14711,278 @@ var (
 func init() { proto.RegisterFile("types.proto", fileDescriptorTypes) }
 
 var fileDescriptorTypes = []byte{
-	// 4248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x5a, 0x4d, 0x6c, 0x24, 0x49,
-	0x56, 0x76, 0xfd, 0xba, 0xea, 0x55, 0xd9, 0x9d, 0x1d, 0xdd, 0xdb, 0xeb, 0xae, 0xed, 0xb1, 0x6b,
-	0x72, 0xa6, 0x77, 0x7e, 0x76, 0x54, 0xd3, 0xed, 0xde, 0x59, 0xf5, 0xcc, 0xb0, 0x33, 0x93, 0xf5,
-	0xe3, 0x76, 0x6d, 0xdb, 0x55, 0xa5, 0xa8, 0x72, 0xf7, 0xce, 0x01, 0x52, 0xe1, 0xcc, 0x70, 0x39,
-	0xc7, 0x59, 0x19, 0x45, 0x66, 0x96, 0xdd, 0x05, 0x42, 0xb4, 0x38, 0x00, 0xf2, 0x05, 0x8e, 0x48,
-	0xc8, 0xa7, 0xe5, 0xc4, 0x81, 0x0b, 0x07, 0x24, 0x2e, 0xcc, 0x81, 0xc3, 0xdc, 0x58, 0x40, 0x42,
-	0x2b, 0x90, 0x1a, 0xc6, 0x48, 0xdc, 0x10, 0x5c, 0x56, 0x5c, 0x40, 0x42, 0xf1, 0x93, 0x59, 0x69,
-	0x77, 0xd9, 0xee, 0x61, 0xb8, 0xd8, 0x19, 0x2f, 0xbe, 0xf7, 0xe2, 0x45, 0xc4, 0x8b, 0x88, 0xef,
-	0x45, 0x14, 0x94, 0xc2, 0xe9, 0x98, 0x06, 0xb5, 0xb1, 0xcf, 0x42, 0x86, 0x90, 0xcd, 0xac, 0x03,
-	0xea, 0xd7, 0x82, 0x23, 0xe2, 0x8f, 0x0e, 0x9c, 0xb0, 0x76, 0x78, 0xbf, 0xb2, 0x36, 0x64, 0x6c,
-	0xe8, 0xd2, 0xf7, 0x05, 0x62, 0x77, 0xb2, 0xf7, 0x7e, 0xe8, 0x8c, 0x68, 0x10, 0x92, 0xd1, 0x58,
-	0x2a, 0x55, 0x56, 0xcf, 0x03, 0xec, 0x89, 0x4f, 0x42, 0x87, 0x79, 0xaa, 0xfe, 0xe6, 0x90, 0x0d,
-	0x99, 0xf8, 0x7c, 0x9f, 0x7f, 0x49, 0xa9, 0xbe, 0x06, 0x8b, 0x4f, 0xa8, 0x1f, 0x38, 0xcc, 0x43,
-	0x37, 0x21, 0xe7, 0x78, 0x36, 0x7d, 0xb6, 0x92, 0xaa, 0xa6, 0xde, 0xce, 0x62, 0x59, 0xd0, 0xef,
-	0x01, 0xb4, 0xf9, 0x47, 0xcb, 0x0b, 0xfd, 0x29, 0xd2, 0x20, 0x73, 0x40, 0xa7, 0x02, 0x51, 0xc4,
-	0xfc, 0x93, 0x4b, 0x0e, 0x89, 0xbb, 0x92, 0x96, 0x92, 0x43, 0xe2, 0xea, 0x5f, 0xa7, 0xa0, 0x64,
-	0x78, 0x1e, 0x0b, 0x45, 0xeb, 0x01, 0x42, 0x90, 0xf5, 0xc8, 0x88, 0x2a, 0x25, 0xf1, 0x8d, 0x1a,
-	0x90, 0x77, 0xc9, 0x2e, 0x75, 0x83, 0x95, 0x74, 0x35, 0xf3, 0x76, 0x69, 0xfd, 0x07, 0xb5, 0x97,
-	0xbb, 0x5c, 0x4b, 0x18, 0xa9, 0x6d, 0x09, 0xb4, 0x70, 0x02, 0x2b, 0x55, 0xf4, 0x09, 0x2c, 0x3a,
-	0x9e, 0xed, 0x58, 0x34, 0x58, 0xc9, 0x0a, 0x2b, 0xab, 0xf3, 0xac, 0xcc, 0xbc, 0xaf, 0x67, 0xbf,
-	0x7a, 0xb1, 0xb6, 0x80, 0x23, 0xa5, 0xca, 0x87, 0x50, 0x4a, 0x98, 0x9d, 0xd3, 0xb7, 0x9b, 0x90,
-	0x3b, 0x24, 0xee, 0x84, 0xaa, 0xde, 0xc9, 0xc2, 0x47, 0xe9, 0x87, 0x29, 0xfd, 0x73, 0x28, 0x62,
-	0x1a, 0xb0, 0x89, 0x6f, 0xd1, 0x00, 0xbd, 0x03, 0x45, 0x8f, 0x78, 0xcc, 0xb4, 0xc6, 0x93, 0x40,
-	0xa8, 0x67, 0xea, 0xe5, 0xd3, 0x17, 0x6b, 0x85, 0x0e, 0xf1, 0x58, 0xa3, 0xb7, 0x13, 0xe0, 0x02,
-	0xaf, 0x6e, 0x8c, 0x27, 0x01, 0x7a, 0x1d, 0xca, 0x23, 0x3a, 0x62, 0xfe, 0xd4, 0xdc, 0x9d, 0x86,
-	0x34, 0x10, 0x86, 0x33, 0xb8, 0x24, 0x65, 0x75, 0x2e, 0xd2, 0xff, 0x30, 0x05, 0x37, 0x23, 0xdb,
-	0x98, 0xfe, 0xfa, 0xc4, 0xf1, 0xe9, 0x88, 0x7a, 0x61, 0x80, 0x3e, 0x80, 0xbc, 0xeb, 0x8c, 0x9c,
-	0x50, 0xb6, 0x51, 0x5a, 0x7f, 0x6d, 0x5e, 0x6f, 0x63, 0xaf, 0xb0, 0x02, 0x23, 0x03, 0xca, 0x3e,
-	0x0d, 0xa8, 0x7f, 0x28, 0x47, 0x52, 0x34, 0x79, 0xa5, 0xf2, 0x19, 0x15, 0x7d, 0x03, 0x0a, 0x3d,
-	0x97, 0x84, 0x7b, 0xcc, 0x1f, 0x21, 0x1d, 0xca, 0xc4, 0xb7, 0xf6, 0x9d, 0x90, 0x5a, 0xe1, 0xc4,
-	0x8f, 0x66, 0xf5, 0x8c, 0x0c, 0xdd, 0x82, 0x34, 0x93, 0x0d, 0x15, 0xeb, 0xf9, 0xd3, 0x17, 0x6b,
-	0xe9, 0x6e, 0x1f, 0xa7, 0x59, 0xa0, 0x7f, 0x0c, 0xd7, 0x7b, 0xee, 0x64, 0xe8, 0x78, 0x4d, 0x1a,
-	0x58, 0xbe, 0x33, 0xe6, 0xd6, 0x79, 0x78, 0xf0, 0xd8, 0x8f, 0xc2, 0x83, 0x7f, 0xc7, 0x21, 0x93,
-	0x9e, 0x85, 0x8c, 0xfe, 0x7b, 0x69, 0xb8, 0xde, 0xf2, 0x86, 0x8e, 0x47, 0x93, 0xda, 0x77, 0x61,
-	0x99, 0x0a, 0xa1, 0x79, 0x28, 0xc3, 0x58, 0xd9, 0x59, 0x92, 0xd2, 0x28, 0xb6, 0xdb, 0xe7, 0xe2,
-	0xed, 0xfe, 0xbc, 0xee, 0xbf, 0x64, 0x7d, 0x6e, 0xd4, 0xb5, 0x60, 0x71, 0x2c, 0x3a, 0x11, 0xac,
-	0x64, 0x84, 0xad, 0xbb, 0xf3, 0x6c, 0xbd, 0xd4, 0xcf, 0x28, 0xf8, 0x94, 0xee, 0xb7, 0x09, 0xbe,
-	0x7f, 0x4d, 0xc1, 0xb5, 0x0e, 0xb3, 0xcf, 0x8c, 0x43, 0x05, 0x0a, 0xfb, 0x2c, 0x08, 0x13, 0x0b,
-	0x2d, 0x2e, 0xa3, 0x87, 0x50, 0x18, 0xab, 0xe9, 0x53, 0xb3, 0x7f, 0x67, 0xbe, 0xcb, 0x12, 0x83,
-	0x63, 0x34, 0xfa, 0x18, 0x8a, 0x7e, 0x14, 0x13, 0x2b, 0x99, 0x57, 0x09, 0x9c, 0x19, 0x1e, 0xfd,
-	0x18, 0xf2, 0x72, 0x12, 0x56, 0xb2, 0x42, 0xf3, 0xee, 0x2b, 0x8d, 0x39, 0x56, 0x4a, 0xfa, 0x2f,
-	0x52, 0xa0, 0x61, 0xb2, 0x17, 0x6e, 0xd3, 0xd1, 0x2e, 0xf5, 0xfb, 0x21, 0x09, 0x27, 0x01, 0xba,
-	0x05, 0x79, 0x97, 0x12, 0x9b, 0xfa, 0xa2, 0x93, 0x05, 0xac, 0x4a, 0x68, 0x87, 0x07, 0x39, 0xb1,
-	0xf6, 0xc9, 0xae, 0xe3, 0x3a, 0xe1, 0x54, 0x74, 0x73, 0x79, 0xfe, 0x2c, 0x9f, 0xb7, 0x59, 0xc3,
-	0x09, 0x45, 0x7c, 0xc6, 0x0c, 0x5a, 0x81, 0xc5, 0x11, 0x0d, 0x02, 0x32, 0xa4, 0xa2, 0xf7, 0x45,
-	0x1c, 0x15, 0xf5, 0x8f, 0xa1, 0x9c, 0xd4, 0x43, 0x25, 0x58, 0xdc, 0xe9, 0x3c, 0xee, 0x74, 0x9f,
-	0x76, 0xb4, 0x05, 0x74, 0x0d, 0x4a, 0x3b, 0x1d, 0xdc, 0x32, 0x1a, 0x9b, 0x46, 0x7d, 0xab, 0xa5,
-	0xa5, 0xd0, 0x12, 0x14, 0x67, 0xc5, 0xb4, 0xfe, 0xe7, 0x29, 0x00, 0x3e, 0x81, 0xaa, 0x53, 0x1f,
-	0x41, 0x2e, 0x08, 0x49, 0x28, 0x27, 0x6e, 0x79, 0xfd, 0xcd, 0x79, 0x5e, 0xcf, 0xe0, 0x35, 0xfe,
-	0x8f, 0x62, 0xa9, 0x92, 0xf4, 0x30, 0x7d, 0xc6, 0x43, 0xbe, 0x86, 0x88, 0x6d, 0xfb, 0xca, 0x71,
-	0xf1, 0xad, 0x7f, 0x0c, 0x39, 0xa1, 0x7d, 0xd6, 0xdd, 0x02, 0x64, 0x9b, 0xfc, 0x2b, 0x85, 0x8a,
-	0x90, 0xc3, 0x2d, 0xa3, 0xf9, 0xb9, 0x96, 0x46, 0x1a, 0x94, 0x9b, 0xed, 0x7e, 0xa3, 0xdb, 0xe9,
-	0xb4, 0x1a, 0x83, 0x56, 0x53, 0xcb, 0xe8, 0x77, 0x21, 0xd7, 0x1e, 0x71, 0xcb, 0x77, 0x78, 0x54,
-	0xec, 0x51, 0x9f, 0x7a, 0x56, 0x14, 0x6c, 0x33, 0x81, 0xfe, 0xf3, 0x22, 0xe4, 0xb6, 0xd9, 0xc4,
-	0x0b, 0xd1, 0x7a, 0x62, 0x65, 0x2f, 0xcf, 0xdf, 0x9c, 0x05, 0xb0, 0x36, 0x98, 0x8e, 0xa9, 0x5a,
-	0xf9, 0xb7, 0x20, 0x2f, 0xe3, 0x47, 0x75, 0x47, 0x95, 0xb8, 0x3c, 0x24, 0xfe, 0x90, 0x86, 0xaa,
-	0x3f, 0xaa, 0x84, 0xde, 0x86, 0x82, 0x4f, 0x89, 0xcd, 0x3c, 0x77, 0x2a, 0xc2, 0xac, 0x20, 0xb7,
-	0x5e, 0x4c, 0x89, 0xdd, 0xf5, 0xdc, 0x29, 0x8e, 0x6b, 0xd1, 0x26, 0x94, 0x77, 0x1d, 0xcf, 0x36,
-	0xd9, 0x58, 0xee, 0x83, 0xb9, 0x8b, 0x83, 0x52, 0x7a, 0x55, 0x77, 0x3c, 0xbb, 0x2b, 0xc1, 0xb8,
-	0xb4, 0x3b, 0x2b, 0xa0, 0x0e, 0x2c, 0x1f, 0x32, 0x77, 0x32, 0xa2, 0xb1, 0xad, 0xbc, 0xb0, 0xf5,
-	0xd6, 0xc5, 0xb6, 0x9e, 0x08, 0x7c, 0x64, 0x6d, 0xe9, 0x30, 0x59, 0x44, 0x8f, 0x61, 0x29, 0x1c,
-	0x8d, 0xf7, 0x82, 0xd8, 0xdc, 0xa2, 0x30, 0xf7, 0xfd, 0x4b, 0x06, 0x8c, 0xc3, 0x23, 0x6b, 0xe5,
-	0x30, 0x51, 0xaa, 0xfc, 0x4e, 0x06, 0x4a, 0x09, 0xcf, 0x51, 0x1f, 0x4a, 0x63, 0x9f, 0x8d, 0xc9,
-	0x50, 0xec, 0xe5, 0x6a, 0x2e, 0xee, 0xbf, 0x52, 0xaf, 0x6b, 0xbd, 0x99, 0x22, 0x4e, 0x5a, 0xd1,
-	0x4f, 0xd2, 0x50, 0x4a, 0x54, 0xa2, 0x77, 0xa1, 0x80, 0x7b, 0xb8, 0xfd, 0xc4, 0x18, 0xb4, 0xb4,
-	0x85, 0xca, 0x9d, 0xe3, 0x93, 0xea, 0x8a, 0xb0, 0x96, 0x34, 0xd0, 0xf3, 0x9d, 0x43, 0x1e, 0x7a,
-	0x6f, 0xc3, 0x62, 0x04, 0x4d, 0x55, 0xbe, 0x77, 0x7c, 0x52, 0xfd, 0xee, 0x79, 0x68, 0x02, 0x89,
-	0xfb, 0x9b, 0x06, 0x6e, 0x35, 0xb5, 0xf4, 0x7c, 0x24, 0xee, 0xef, 0x13, 0x9f, 0xda, 0xe8, 0xfb,
-	0x90, 0x57, 0xc0, 0x4c, 0xa5, 0x72, 0x7c, 0x52, 0xbd, 0x75, 0x1e, 0x38, 0xc3, 0xe1, 0xfe, 0x96,
-	0xf1, 0xa4, 0xa5, 0x65, 0xe7, 0xe3, 0x70, 0xdf, 0x25, 0x87, 0x14, 0xbd, 0x09, 0x39, 0x09, 0xcb,
-	0x55, 0x6e, 0x1f, 0x9f, 0x54, 0xbf, 0xf3, 0x92, 0x39, 0x8e, 0xaa, 0xac, 0xfc, 0xfe, 0xcf, 0x56,
-	0x17, 0xfe, 0xf2, 0x4f, 0x56, 0xb5, 0xf3, 0xd5, 0x95, 0xff, 0x4e, 0xc1, 0xd2, 0x99, 0x29, 0x47,
-	0x3a, 0xe4, 0x3d, 0x66, 0xb1, 0xb1, 0xdc, 0xe2, 0x0b, 0x75, 0x38, 0x7d, 0xb1, 0x96, 0xef, 0xb0,
-	0x06, 0x1b, 0x4f, 0xb1, 0xaa, 0x41, 0x8f, 0xcf, 0x1d, 0x52, 0x0f, 0x5e, 0x31, 0x9e, 0xe6, 0x1e,
-	0x53, 0x9f, 0xc2, 0x92, 0xed, 0x3b, 0x87, 0xd4, 0x37, 0x2d, 0xe6, 0xed, 0x39, 0x43, 0xb5, 0x7d,
-	0x57, 0xe6, 0xd9, 0x6c, 0x0a, 0x20, 0x2e, 0x4b, 0x85, 0x86, 0xc0, 0x7f, 0x8b, 0x03, 0xaa, 0xf2,
-	0x04, 0xca, 0xc9, 0x08, 0x45, 0xaf, 0x01, 0x04, 0xce, 0x6f, 0x50, 0xc5, 0x79, 0x04, 0x43, 0xc2,
-	0x45, 0x2e, 0x11, 0x8c, 0x07, 0xbd, 0x05, 0xd9, 0x11, 0xb3, 0xa5, 0x9d, 0xa5, 0xfa, 0x0d, 0x7e,
-	0x4e, 0xfe, 0xe3, 0x8b, 0xb5, 0x12, 0x0b, 0x6a, 0x1b, 0x8e, 0x4b, 0xb7, 0x99, 0x4d, 0xb1, 0x00,
-	0xe8, 0x87, 0x90, 0xe5, 0x5b, 0x05, 0xfa, 0x1e, 0x64, 0xeb, 0xed, 0x4e, 0x53, 0x5b, 0xa8, 0x5c,
-	0x3f, 0x3e, 0xa9, 0x2e, 0x89, 0x21, 0xe1, 0x15, 0x3c, 0x76, 0xd1, 0x1a, 0xe4, 0x9f, 0x74, 0xb7,
-	0x76, 0xb6, 0x79, 0x78, 0xdd, 0x38, 0x3e, 0xa9, 0x5e, 0x8b, 0xab, 0xe5, 0xa0, 0xa1, 0xd7, 0x20,
-	0x37, 0xd8, 0xee, 0x6d, 0xf4, 0xb5, 0x74, 0x05, 0x1d, 0x9f, 0x54, 0x97, 0xe3, 0x7a, 0xe1, 0x73,
-	0xe5, 0xba, 0x9a, 0xd5, 0x62, 0x2c, 0xd7, 0x7f, 0x99, 0x86, 0x25, 0xcc, 0xc9, 0xb6, 0x1f, 0xf6,
-	0x98, 0xeb, 0x58, 0x53, 0xd4, 0x83, 0xa2, 0xc5, 0x3c, 0xdb, 0x49, 0xac, 0xa9, 0xf5, 0x0b, 0x0e,
-	0xc6, 0x99, 0x56, 0x54, 0x6a, 0x44, 0x9a, 0x78, 0x66, 0x04, 0xbd, 0x0f, 0x39, 0x9b, 0xba, 0x64,
-	0xaa, 0x4e, 0xe8, 0xdb, 0x35, 0x49, 0xe7, 0x6b, 0x11, 0x9d, 0xaf, 0x35, 0x15, 0x9d, 0xc7, 0x12,
-	0x27, 0xa8, 0x24, 0x79, 0x66, 0x92, 0x30, 0xa4, 0xa3, 0x71, 0x28, 0x8f, 0xe7, 0x2c, 0x2e, 0x8d,
-	0xc8, 0x33, 0x43, 0x89, 0xd0, 0x7d, 0xc8, 0x1f, 0x39, 0x9e, 0xcd, 0x8e, 0xd4, 0x09, 0x7c, 0x89,
-	0x51, 0x05, 0xd4, 0x8f, 0xf9, 0xa9, 0x7b, 0xce, 0x4d, 0x3e, 0xde, 0x9d, 0x6e, 0xa7, 0x15, 0x8d,
-	0xb7, 0xaa, 0xef, 0x7a, 0x1d, 0xe6, 0xf1, 0xb5, 0x02, 0xdd, 0x8e, 0xb9, 0x61, 0xb4, 0xb7, 0x76,
-	0x30, 0x1f, 0xf3, 0x9b, 0xc7, 0x27, 0x55, 0x2d, 0x86, 0x6c, 0x10, 0xc7, 0xe5, 0x94, 0xf0, 0x36,
-	0x64, 0x8c, 0xce, 0xe7, 0x5a, 0xba, 0xa2, 0x1d, 0x9f, 0x54, 0xcb, 0x71, 0xb5, 0xe1, 0x4d, 0x67,
-	0xcb, 0xe8, 0x7c, 0xbb, 0xfa, 0xdf, 0x64, 0xa0, 0xbc, 0x33, 0xb6, 0x49, 0x48, 0x65, 0x4c, 0xa2,
-	0x2a, 0x94, 0xc6, 0xc4, 0x27, 0xae, 0x4b, 0x5d, 0x27, 0x18, 0xa9, 0x44, 0x25, 0x29, 0x42, 0x1f,
-	0xbe, 0xea, 0x30, 0xd6, 0x0b, 0x3c, 0xce, 0xfe, 0xe8, 0x9f, 0xd7, 0x52, 0xd1, 0x80, 0xee, 0xc0,
-	0xf2, 0x9e, 0xf4, 0xd6, 0x24, 0x96, 0x98, 0xd8, 0x8c, 0x98, 0xd8, 0xda, 0xbc, 0x89, 0x4d, 0xba,
-	0x55, 0x53, 0x9d, 0x34, 0x84, 0x16, 0x5e, 0xda, 0x4b, 0x16, 0xd1, 0x03, 0x58, 0x1c, 0x31, 0xcf,
-	0x09, 0x99, 0x7f, 0xf5, 0x2c, 0x44, 0x48, 0xf4, 0x2e, 0x5c, 0xe7, 0x93, 0x1b, 0xf9, 0x23, 0xaa,
-	0xc5, 0x89, 0x95, 0xc6, 0xd7, 0x46, 0xe4, 0x99, 0x6a, 0x10, 0x73, 0x31, 0xaa, 0x43, 0x8e, 0xf9,
-	0x9c, 0x12, 0xe5, 0x85, 0xbb, 0xef, 0x5d, 0xe9, 0xae, 0x2c, 0x74, 0xb9, 0x0e, 0x96, 0xaa, 0xfa,
-	0x8f, 0x60, 0xe9, 0x4c, 0x27, 0x38, 0x13, 0xe8, 0x19, 0x3b, 0xfd, 0x96, 0xb6, 0x80, 0xca, 0x50,
-	0x68, 0x74, 0x3b, 0x83, 0x76, 0x67, 0x87, 0x53, 0x99, 0x32, 0x14, 0x70, 0x77, 0x6b, 0xab, 0x6e,
-	0x34, 0x1e, 0x6b, 0x69, 0xbd, 0x06, 0xa5, 0x84, 0x35, 0xb4, 0x0c, 0xd0, 0x1f, 0x74, 0x7b, 0xe6,
-	0x46, 0x1b, 0xf7, 0x07, 0x92, 0x08, 0xf5, 0x07, 0x06, 0x1e, 0x28, 0x41, 0x4a, 0xff, 0x8f, 0x74,
-	0x34, 0xa3, 0x8a, 0xfb, 0xd4, 0xcf, 0x72, 0x9f, 0x4b, 0x9c, 0x57, 0xec, 0x67, 0x56, 0x88, 0x39,
-	0xd0, 0x87, 0x00, 0x22, 0x70, 0xa8, 0x6d, 0x92, 0x50, 0x4d, 0x7c, 0xe5, 0xa5, 0x41, 0x1e, 0x44,
-	0xf9, 0x32, 0x2e, 0x2a, 0xb4, 0x11, 0xa2, 0x1f, 0x43, 0xd9, 0x62, 0xa3, 0xb1, 0x4b, 0x95, 0x72,
-	0xe6, 0x4a, 0xe5, 0x52, 0x8c, 0x37, 0xc2, 0x24, 0xfb, 0xca, 0x9e, 0xe5, 0x87, 0xbf, 0x9b, 0x8a,
-	0x46, 0x66, 0x0e, 0xe1, 0x2a, 0x43, 0x61, 0xa7, 0xd7, 0x34, 0x06, 0xed, 0xce, 0x23, 0x2d, 0x85,
-	0x00, 0xf2, 0x62, 0xa8, 0x9b, 0x5a, 0x9a, 0x13, 0xc5, 0x46, 0x77, 0xbb, 0xb7, 0xd5, 0x12, 0x94,
-	0x0b, 0xdd, 0x04, 0x2d, 0x1a, 0x6c, 0x53, 0x0c, 0x64, 0xab, 0xa9, 0x65, 0xd1, 0x0d, 0xb8, 0x16,
-	0x4b, 0x95, 0x66, 0x0e, 0xdd, 0x02, 0x14, 0x0b, 0x67, 0x26, 0xf2, 0xfa, 0x6f, 0xc1, 0xb5, 0x06,
-	0xf3, 0x42, 0xe2, 0x78, 0x31, 0x89, 0x5e, 0xe7, 0x9d, 0x56, 0x22, 0xd3, 0xb1, 0xe5, 0x9e, 0x5e,
-	0xbf, 0x76, 0xfa, 0x62, 0xad, 0x14, 0x43, 0xdb, 0x4d, 0xde, 0xd3, 0xa8, 0x60, 0xf3, 0xf5, 0x3b,
-	0x76, 0x6c, 0x31, 0xb8, 0xb9, 0xfa, 0xe2, 0xe9, 0x8b, 0xb5, 0x4c, 0xaf, 0xdd, 0xc4, 0x5c, 0x86,
-	0xbe, 0x07, 0x45, 0xfa, 0xcc, 0x09, 0x4d, 0x8b, 0xef, 0xe1, 0x7c, 0x00, 0x73, 0xb8, 0xc0, 0x05,
-	0x0d, 0xbe, 0x65, 0xd7, 0x01, 0x7a, 0xcc, 0x0f, 0x55, 0xcb, 0x3f, 0x84, 0xdc, 0x98, 0xf9, 0x22,
-	0x83, 0xbd, 0x30, 0x5f, 0xe7, 0x70, 0x19, 0xa8, 0x58, 0x82, 0xf5, 0xbf, 0x4a, 0x03, 0x0c, 0x48,
-	0x70, 0xa0, 0x8c, 0x3c, 0x84, 0x62, 0x7c, 0xf7, 0xa1, 0x52, 0xe1, 0x4b, 0x67, 0x3b, 0x06, 0xa3,
-	0x07, 0x51, 0xb0, 0xc9, 0xf4, 0x60, 0x6e, 0x2a, 0x13, 0x35, 0x34, 0x8f, 0x61, 0x9f, 0xcd, 0x01,
-	0xf8, 0x91, 0x48, 0x7d, 0x5f, 0xcd, 0x3c, 0xff, 0x44, 0x0d, 0x71, 0x2c, 0xc8, 0x41, 0x53, 0x04,
-	0xf3, 0x8d, 0x79, 0x8d, 0x9c, 0x9b, 0x91, 0xcd, 0x05, 0x3c, 0xd3, 0x43, 0x9f, 0x42, 0x89, 0xf7,
-	0xdb, 0x0c, 0x44, 0x9d, 0xe2, 0x96, 0x17, 0x0e, 0x95, 0xb4, 0x80, 0x61, 0x1c, 0x7f, 0xd7, 0x35,
-	0x58, 0xf6, 0x27, 0x1e, 0xef, 0xb6, 0xb2, 0xa1, 0x3b, 0xf0, 0xdd, 0x0e, 0x0d, 0x8f, 0x98, 0x7f,
-	0x60, 0x84, 0x21, 0xb1, 0xf6, 0x47, 0xd4, 0x53, 0x63, 0x9c, 0x20, 0xd6, 0xa9, 0x33, 0xc4, 0x7a,
-	0x05, 0x16, 0x89, 0xeb, 0x90, 0x80, 0x4a, 0x36, 0x52, 0xc4, 0x51, 0x91, 0xd3, 0x7f, 0x9e, 0x4c,
-	0xd0, 0x20, 0xa0, 0x32, 0x05, 0x2e, 0xe2, 0x99, 0x40, 0xff, 0xfb, 0x34, 0x40, 0xbb, 0x67, 0x6c,
-	0x2b, 0xf3, 0x4d, 0xc8, 0xef, 0x91, 0x91, 0xe3, 0x4e, 0x2f, 0x5b, 0xe0, 0x33, 0x7c, 0xcd, 0x90,
-	0x86, 0x36, 0x84, 0x0e, 0x56, 0xba, 0x22, 0x2b, 0x98, 0xec, 0x7a, 0x34, 0x8c, 0xb3, 0x02, 0x51,
-	0xe2, 0x14, 0xc4, 0x27, 0x5e, 0x3c, 0x33, 0xb2, 0xc0, 0x5d, 0x1f, 0x92, 0x90, 0x1e, 0x91, 0x69,
-	0xb4, 0x2a, 0x55, 0x11, 0x6d, 0xf2, 0x6c, 0x21, 0xa0, 0xfe, 0x21, 0xb5, 0x57, 0x72, 0x22, 0x04,
-	0xaf, 0xf2, 0x07, 0x2b, 0xb8, 0x24, 0x57, 0xb1, 0x76, 0xe5, 0x63, 0xc1, 0x08, 0x66, 0x55, 0xdf,
-	0x28, 0x81, 0xbf, 0x07, 0x4b, 0x67, 0xfa, 0xf9, 0x52, 0x3a, 0xd6, 0xee, 0x3d, 0xf9, 0xa1, 0x96,
-	0x55, 0x5f, 0x3f, 0xd2, 0xf2, 0xfa, 0x9f, 0x66, 0xe4, 0x3a, 0x52, 0xa3, 0x3a, 0xff, 0x4a, 0xad,
-	0x20, 0xa2, 0xdf, 0x62, 0xae, 0x8a, 0xef, 0xb7, 0x2e, 0x5f, 0x5e, 0x9c, 0xde, 0x0b, 0x38, 0x8e,
-	0x15, 0xd1, 0x1a, 0x94, 0xe4, 0xfc, 0x9b, 0x3c, 0x9e, 0xc4, 0xb0, 0x2e, 0x61, 0x90, 0x22, 0xae,
-	0x89, 0xee, 0xc2, 0xf2, 0x78, 0xb2, 0xeb, 0x3a, 0xc1, 0x3e, 0xb5, 0x25, 0x26, 0x2b, 0x30, 0x4b,
-	0xb1, 0x54, 0xc0, 0xb6, 0xa1, 0xac, 0x04, 0xa6, 0xa0, 0x76, 0x39, 0xe1, 0xd0, 0xbb, 0x57, 0x39,
-	0x24, 0x55, 0x04, 0xe3, 0x2b, 0x8d, 0x67, 0x05, 0xbd, 0x09, 0x85, 0xc8, 0x59, 0xb4, 0x02, 0x99,
-	0x41, 0xa3, 0xa7, 0x2d, 0x54, 0xae, 0x1d, 0x9f, 0x54, 0x4b, 0x91, 0x78, 0xd0, 0xe8, 0xf1, 0x9a,
-	0x9d, 0x66, 0x4f, 0x4b, 0x9d, 0xad, 0xd9, 0x69, 0xf6, 0x2a, 0x59, 0x4e, 0x31, 0xf4, 0x3d, 0x28,
-	0x25, 0x5a, 0x40, 0x6f, 0xc0, 0x62, 0xbb, 0xf3, 0x08, 0xb7, 0xfa, 0x7d, 0x6d, 0xa1, 0x72, 0xeb,
-	0xf8, 0xa4, 0x8a, 0x12, 0xb5, 0x6d, 0x6f, 0xc8, 0xe7, 0x07, 0xbd, 0x06, 0xd9, 0xcd, 0x2e, 0x3f,
-	0xba, 0x24, 0x97, 0x4c, 0x20, 0x36, 0x59, 0x10, 0x56, 0x6e, 0x28, 0xee, 0x92, 0x34, 0xac, 0xff,
-	0x71, 0x0a, 0xf2, 0x92, 0x52, 0xcf, 0x9d, 0x28, 0x03, 0x16, 0xa3, 0x44, 0x4f, 0xf2, 0xfc, 0xb7,
-	0x2e, 0xe6, 0xe4, 0x35, 0x45, 0xa1, 0x65, 0xf8, 0x45, 0x7a, 0x95, 0x8f, 0xa0, 0x9c, 0xac, 0xf8,
-	0x46, 0xc1, 0xf7, 0x9b, 0x50, 0xe2, 0xf1, 0x1d, 0x71, 0xf3, 0x75, 0xc8, 0x4b, 0xda, 0x1f, 0x6f,
-	0xa5, 0x17, 0x27, 0x08, 0x0a, 0x89, 0x1e, 0xc2, 0xa2, 0x4c, 0x2a, 0xa2, 0x2b, 0xb0, 0xd5, 0xcb,
-	0x57, 0x11, 0x8e, 0xe0, 0xfa, 0xa7, 0x90, 0xed, 0x51, 0xea, 0xf3, 0xb1, 0xf7, 0x98, 0x4d, 0x67,
-	0xa7, 0x8f, 0xca, 0x87, 0x6c, 0xda, 0x6e, 0xf2, 0x7c, 0xc8, 0xa6, 0x6d, 0x3b, 0xbe, 0xc1, 0x48,
-	0x27, 0x6e, 0x30, 0x06, 0x50, 0x7e, 0x4a, 0x9d, 0xe1, 0x7e, 0x48, 0x6d, 0x61, 0xe8, 0x3d, 0xc8,
-	0x8e, 0x69, 0xec, 0xfc, 0xca, 0xdc, 0x00, 0xa3, 0xd4, 0xc7, 0x02, 0xc5, 0xf7, 0x91, 0x23, 0xa1,
-	0xad, 0x2e, 0x5e, 0x55, 0x49, 0xff, 0xbb, 0x34, 0x2c, 0xb7, 0x83, 0x60, 0x42, 0x3c, 0x2b, 0x22,
-	0x26, 0x9f, 0x9c, 0x25, 0x26, 0x6f, 0xcf, 0xed, 0xe1, 0x19, 0x95, 0xb3, 0x17, 0x33, 0xea, 0x70,
-	0x48, 0xc7, 0x87, 0x83, 0xfe, 0xef, 0xa9, 0xe8, 0xf6, 0xe5, 0x6e, 0x62, 0xb9, 0x57, 0x56, 0x8e,
-	0x4f, 0xaa, 0x37, 0x93, 0x96, 0xe8, 0x8e, 0x77, 0xe0, 0xb1, 0x23, 0x0f, 0xbd, 0x0e, 0x39, 0xdc,
-	0xea, 0xb4, 0x9e, 0x6a, 0x29, 0x19, 0x9e, 0x67, 0x40, 0x98, 0x7a, 0xf4, 0x88, 0x5b, 0xea, 0xb5,
-	0x3a, 0x4d, 0x4e, 0x24, 0xd2, 0x73, 0x2c, 0xf5, 0xa8, 0x67, 0x3b, 0xde, 0x10, 0xbd, 0x01, 0xf9,
-	0x76, 0xbf, 0xbf, 0x23, 0xf2, 0xe3, 0xef, 0x1e, 0x9f, 0x54, 0x6f, 0x9c, 0x41, 0xf1, 0x02, 0xb5,
-	0x39, 0x88, 0xb3, 0x78, 0x4e, 0x31, 0xe6, 0x80, 0x38, 0x3d, 0x94, 0x20, 0xdc, 0x1d, 0xf0, 0xe4,
-	0x3d, 0x37, 0x07, 0x84, 0x19, 0xff, 0xab, 0x96, 0xdb, 0x3f, 0xa5, 0x41, 0x33, 0x2c, 0x8b, 0x8e,
-	0x43, 0x5e, 0xaf, 0x12, 0xa7, 0x01, 0x14, 0xc6, 0xfc, 0xcb, 0xa1, 0x11, 0x09, 0x78, 0x38, 0xf7,
-	0xea, 0xff, 0x9c, 0x5e, 0x0d, 0x33, 0x97, 0x1a, 0xf6, 0xc8, 0x09, 0x02, 0x87, 0x79, 0x52, 0x86,
-	0x63, 0x4b, 0x95, 0xff, 0x4c, 0xc1, 0x8d, 0x39, 0x08, 0x74, 0x0f, 0xb2, 0x3e, 0x73, 0xa3, 0x39,
-	0xbc, 0x73, 0xd1, 0xc5, 0x1a, 0x57, 0xc5, 0x02, 0x89, 0x56, 0x01, 0xc8, 0x24, 0x64, 0x44, 0xb4,
-	0x2f, 0x66, 0xaf, 0x80, 0x13, 0x12, 0xf4, 0x14, 0xf2, 0x01, 0xb5, 0x7c, 0x1a, 0x51, 0xc5, 0x4f,
-	0xff, 0xaf, 0xde, 0xd7, 0xfa, 0xc2, 0x0c, 0x56, 0xe6, 0x2a, 0x35, 0xc8, 0x4b, 0x09, 0x0f, 0x7b,
-	0x9b, 0x84, 0x44, 0x38, 0x5d, 0xc6, 0xe2, 0x9b, 0x47, 0x13, 0x71, 0x87, 0x51, 0x34, 0x11, 0x77,
-	0xa8, 0xff, 0x75, 0x1a, 0xa0, 0xf5, 0x2c, 0xa4, 0xbe, 0x47, 0xdc, 0x86, 0x81, 0x5a, 0x89, 0xdd,
-	0x5f, 0xf6, 0xf6, 0x9d, 0xb9, 0xd7, 0xad, 0xb1, 0x46, 0xad, 0x61, 0xcc, 0xd9, 0xff, 0x6f, 0x43,
-	0x66, 0xe2, 0xab, 0xd7, 0x1c, 0x49, 0xf3, 0x76, 0xf0, 0x16, 0xe6, 0x32, 0xd4, 0x9a, 0x6d, 0x5b,
-	0x99, 0x8b, 0xdf, 0x6c, 0x12, 0x0d, 0xcc, 0xdd, 0xba, 0xf8, 0xca, 0xb7, 0x88, 0x69, 0x51, 0x75,
-	0x72, 0x94, 0xe5, 0xca, 0x6f, 0x18, 0x0d, 0xea, 0x87, 0x38, 0x6f, 0x11, 0xfe, 0xff, 0x5b, 0xed,
-	0x6f, 0xef, 0x01, 0xcc, 0xba, 0x86, 0x56, 0x21, 0xd7, 0xd8, 0xe8, 0xf7, 0xb7, 0xb4, 0x05, 0xb9,
-	0x81, 0xcf, 0xaa, 0x84, 0x58, 0xff, 0x59, 0x0a, 0x0a, 0x0d, 0x43, 0x1d, 0xab, 0x0d, 0xd0, 0xc4,
-	0xae, 0xc4, 0xbd, 0x33, 0xe9, 0xb3, 0xb1, 0xe3, 0x4f, 0xd5, 0xc6, 0x72, 0x49, 0xce, 0xb6, 0xcc,
-	0x55, 0xb8, 0xd7, 0x2d, 0xa1, 0x80, 0x30, 0x94, 0xa9, 0x1a, 0x04, 0xd3, 0x22, 0xd1, 0x1e, 0xbf,
-	0x7a, 0xf9, 0x60, 0x49, 0xf6, 0x3d, 0x2b, 0x07, 0xb8, 0x14, 0x19, 0x69, 0x90, 0x40, 0x7f, 0x02,
-	0x37, 0xba, 0xbe, 0xb5, 0x4f, 0x83, 0x50, 0x36, 0xaa, 0xfc, 0xfd, 0x14, 0xee, 0x84, 0x24, 0x38,
-	0x30, 0xf7, 0x9d, 0x20, 0x64, 0xfe, 0xd4, 0xf4, 0x69, 0x48, 0x3d, 0x5e, 0x6f, 0x8a, 0xb7, 0x1f,
-	0x75, 0xd3, 0x72, 0x9b, 0x63, 0x36, 0x25, 0x04, 0x47, 0x88, 0x2d, 0x0e, 0xd0, 0xdb, 0x50, 0xe6,
-	0x7c, 0xb7, 0x49, 0xf7, 0xc8, 0xc4, 0x0d, 0x03, 0x9e, 0x49, 0xb9, 0x6c, 0x68, 0xbe, 0xf2, 0x81,
-	0x50, 0x74, 0xd9, 0x50, 0x7e, 0xea, 0x3f, 0x05, 0xad, 0xe9, 0x04, 0x63, 0x12, 0x5a, 0xfb, 0xd1,
-	0x15, 0x12, 0x6a, 0x82, 0xb6, 0x4f, 0x89, 0x1f, 0xee, 0x52, 0x12, 0x9a, 0x63, 0xea, 0x3b, 0xcc,
-	0xbe, 0x7a, 0x3c, 0xaf, 0xc5, 0x2a, 0x3d, 0xa1, 0xa1, 0xff, 0x57, 0x0a, 0x00, 0x93, 0xbd, 0x88,
-	0xfb, 0xfc, 0x00, 0xae, 0x07, 0x1e, 0x19, 0x07, 0xfb, 0x2c, 0x34, 0x1d, 0x2f, 0xa4, 0xfe, 0x21,
-	0x71, 0xd5, 0x4d, 0x80, 0x16, 0x55, 0xb4, 0x95, 0x1c, 0xbd, 0x07, 0xe8, 0x80, 0xd2, 0xb1, 0xc9,
-	0x5c, 0xdb, 0x8c, 0x2a, 0xe5, 0xcb, 0x54, 0x16, 0x6b, 0xbc, 0xa6, 0xeb, 0xda, 0xfd, 0x48, 0x8e,
-	0xea, 0xb0, 0xca, 0xbb, 0x4f, 0xbd, 0xd0, 0x77, 0x68, 0x60, 0xee, 0x31, 0xdf, 0x0c, 0x5c, 0x76,
-	0x64, 0xee, 0x31, 0xd7, 0x65, 0x47, 0xd4, 0x8f, 0x2e, 0x59, 0x2a, 0x2e, 0x1b, 0xb6, 0x24, 0x68,
-	0x83, 0xf9, 0x7d, 0x97, 0x1d, 0x6d, 0x44, 0x08, 0x4e, 0x90, 0x66, 0x7d, 0x0e, 0x1d, 0xeb, 0x20,
-	0x22, 0x48, 0xb1, 0x74, 0xe0, 0x58, 0x07, 0xe8, 0x0d, 0x58, 0xa2, 0x2e, 0x15, 0xb9, 0xb6, 0x44,
-	0xe5, 0x04, 0xaa, 0x1c, 0x09, 0x39, 0x48, 0xff, 0x0c, 0xb4, 0x96, 0x67, 0xf9, 0xd3, 0x71, 0x62,
-	0xce, 0xdf, 0x03, 0xc4, 0xb7, 0x23, 0xd3, 0x65, 0xd6, 0x81, 0x39, 0x22, 0x1e, 0x19, 0x72, 0xbf,
-	0xe4, 0x6b, 0x88, 0xc6, 0x6b, 0xb6, 0x98, 0x75, 0xb0, 0xad, 0xe4, 0xfa, 0x87, 0x00, 0xfd, 0xb1,
-	0x4f, 0x89, 0xdd, 0xe5, 0xe7, 0x36, 0x1f, 0x3a, 0x51, 0x32, 0x6d, 0xf5, 0xe0, 0xc2, 0x7c, 0xb5,
-	0xa8, 0x34, 0x59, 0xd1, 0x8c, 0xe5, 0xfa, 0xaf, 0xc2, 0x8d, 0x9e, 0x4b, 0x2c, 0xf1, 0xf8, 0xd8,
-	0x8b, 0xaf, 0xf7, 0xd1, 0x43, 0xc8, 0x4b, 0xa8, 0x9a, 0xc9, 0xb9, 0x81, 0x3d, 0x6b, 0x73, 0x73,
-	0x01, 0x2b, 0x7c, 0xbd, 0x0c, 0x30, 0xb3, 0xa3, 0x3f, 0x83, 0x62, 0x6c, 0x1e, 0x55, 0x81, 0x27,
-	0x9b, 0x3c, 0xba, 0x1d, 0x4f, 0x65, 0x87, 0x45, 0x9c, 0x14, 0xa1, 0x36, 0x94, 0xc6, 0xb1, 0xf2,
-	0xa5, 0xc4, 0x69, 0x8e, 0xd3, 0x38, 0xa9, 0xab, 0x7f, 0x02, 0xf0, 0x13, 0xe6, 0x78, 0x03, 0x76,
-	0x40, 0x3d, 0xf1, 0xa2, 0xc4, 0xf3, 0x22, 0x1a, 0x0d, 0x84, 0x2a, 0x89, 0xb4, 0x4f, 0x8e, 0x62,
-	0xfc, 0xb0, 0x22, 0x8b, 0xfa, 0x1f, 0xa4, 0x21, 0x8f, 0x19, 0x0b, 0x1b, 0x06, 0xaa, 0x42, 0xde,
-	0x22, 0x66, 0xb4, 0x35, 0x95, 0xeb, 0xc5, 0xd3, 0x17, 0x6b, 0xb9, 0x86, 0xf1, 0x98, 0x4e, 0x71,
-	0xce, 0x22, 0x8f, 0xe9, 0x34, 0xb9, 0xdd, 0xa5, 0x2f, 0xda, 0xee, 0xd0, 0x3d, 0x28, 0x2b, 0x90,
-	0xb9, 0x4f, 0x82, 0x7d, 0x99, 0xcd, 0xd4, 0x97, 0x4f, 0x5f, 0xac, 0x81, 0x44, 0x6e, 0x92, 0x60,
-	0x1f, 0x83, 0x44, 0xf3, 0x6f, 0xd4, 0x82, 0xd2, 0x17, 0xcc, 0xf1, 0xcc, 0x50, 0x74, 0x42, 0x5d,
-	0x2c, 0xcd, 0x9d, 0x8a, 0x59, 0x57, 0xd5, 0x0b, 0x24, 0x7c, 0x31, 0xeb, 0x7c, 0x0b, 0x96, 0x7c,
-	0xc6, 0x42, 0xd3, 0x57, 0xef, 0xec, 0x2a, 0x67, 0xad, 0xce, 0xbd, 0xca, 0x64, 0x2c, 0xc4, 0x0a,
-	0x87, 0xcb, 0x7e, 0xa2, 0xa4, 0xff, 0x43, 0x0a, 0x4a, 0xdc, 0x35, 0x67, 0xcf, 0xb1, 0x38, 0xbf,
-	0xf9, 0xe6, 0xc7, 0xee, 0x6d, 0xc8, 0x58, 0x81, 0xaf, 0x86, 0x48, 0x9c, 0x3b, 0x8d, 0x3e, 0xc6,
-	0x5c, 0x86, 0x3e, 0x83, 0xbc, 0xca, 0x84, 0xe5, 0x89, 0xab, 0x5f, 0xcd, 0xc4, 0x54, 0x4f, 0x95,
-	0x9e, 0x88, 0xae, 0x99, 0x77, 0xf2, 0xd8, 0xc1, 0x49, 0x11, 0xba, 0x05, 0x69, 0x4b, 0x76, 0x5e,
-	0x3d, 0x58, 0x37, 0x3a, 0x38, 0x6d, 0x79, 0xfa, 0xdf, 0xa6, 0x60, 0x69, 0xb6, 0x02, 0xf9, 0x7c,
-	0xde, 0x81, 0x62, 0x30, 0xd9, 0x0d, 0xa6, 0x41, 0x48, 0x47, 0xd1, 0xdb, 0x57, 0x2c, 0x40, 0x6d,
-	0x28, 0x12, 0x77, 0xc8, 0x7c, 0x27, 0xdc, 0x1f, 0xa9, 0x24, 0x6c, 0xfe, 0x29, 0x99, 0xb4, 0x59,
-	0x33, 0x22, 0x15, 0x3c, 0xd3, 0x8e, 0x8e, 0xbc, 0x8c, 0x70, 0x56, 0x1c, 0x79, 0xaf, 0x43, 0xd9,
-	0x25, 0x23, 0x71, 0x35, 0xc0, 0x73, 0x7b, 0xd1, 0x8f, 0x2c, 0x2e, 0x29, 0xd9, 0xc0, 0x19, 0x51,
-	0x5d, 0x87, 0x62, 0x6c, 0x0c, 0x5d, 0x83, 0x92, 0xd1, 0xea, 0x9b, 0xf7, 0xd7, 0x1f, 0x9a, 0x8f,
-	0x1a, 0xdb, 0xda, 0x82, 0xa2, 0x65, 0x7f, 0x91, 0x82, 0x25, 0xb5, 0x3f, 0x28, 0xaa, 0xfb, 0x06,
-	0x2c, 0xfa, 0x64, 0x2f, 0x8c, 0xc8, 0x78, 0x56, 0xc6, 0x28, 0xdf, 0x72, 0x39, 0x19, 0xe7, 0x55,
-	0xf3, 0xc9, 0x78, 0xe2, 0x35, 0x36, 0x73, 0xe9, 0x6b, 0x6c, 0xf6, 0xff, 0xe5, 0x35, 0x56, 0xff,
-	0xb3, 0x34, 0x5c, 0x53, 0xac, 0x29, 0xde, 0x8e, 0xde, 0x81, 0xa2, 0x24, 0x50, 0xb3, 0x54, 0x42,
-	0x3c, 0x00, 0x4a, 0x5c, 0xbb, 0x89, 0x0b, 0xb2, 0xba, 0x6d, 0xf3, 0xdc, 0x56, 0x41, 0x13, 0xbf,
-	0x2d, 0x00, 0x29, 0xea, 0xf0, 0xc4, 0xac, 0x09, 0xd9, 0x3d, 0xc7, 0xa5, 0x2a, 0xce, 0xe6, 0x5e,
-	0xfb, 0x9e, 0x6b, 0x5e, 0x3c, 0x50, 0x0c, 0x44, 0x76, 0xbc, 0xb9, 0x80, 0x85, 0x76, 0xe5, 0xb7,
-	0x01, 0x66, 0xd2, 0xb9, 0x09, 0x20, 0x27, 0x59, 0xea, 0x2e, 0x2d, 0x22, 0x59, 0xed, 0x26, 0xe6,
-	0x32, 0x5e, 0x35, 0x74, 0x6c, 0xb5, 0x01, 0x88, 0xaa, 0x47, 0xbc, 0x6a, 0xe8, 0xd8, 0xf1, 0x2b,
-	0x49, 0xf6, 0x8a, 0x57, 0x92, 0x7a, 0x21, 0xba, 0xd1, 0xd1, 0xb7, 0xe0, 0x56, 0xdd, 0x25, 0xd6,
-	0x81, 0xeb, 0x04, 0x21, 0xb5, 0x93, 0x2b, 0x74, 0x1d, 0xf2, 0x67, 0xf8, 0xcd, 0x65, 0x17, 0x68,
-	0x0a, 0xa9, 0xff, 0x5b, 0x0a, 0xca, 0x9b, 0x94, 0xb8, 0xe1, 0xfe, 0xec, 0x16, 0x22, 0xa4, 0x41,
-	0xa8, 0xb6, 0x6b, 0xf1, 0x8d, 0x3e, 0x80, 0x42, 0x7c, 0x28, 0x5f, 0xf9, 0x92, 0x11, 0x43, 0xd1,
-	0x03, 0x58, 0xe4, 0x31, 0xcd, 0x26, 0x11, 0xaf, 0xbe, 0xec, 0x92, 0x5c, 0x21, 0xf9, 0x16, 0xed,
-	0x53, 0x71, 0x0a, 0x8b, 0x41, 0xc9, 0xe1, 0xa8, 0x88, 0x7e, 0x05, 0xca, 0xe2, 0x8e, 0x37, 0x22,
-	0x1d, 0xb9, 0xab, 0x6c, 0x96, 0xe4, 0x33, 0x8d, 0x24, 0x1c, 0xff, 0x93, 0x82, 0x9b, 0xdb, 0x64,
-	0xba, 0x4b, 0xd5, 0x32, 0xa5, 0x36, 0xa6, 0x16, 0xf3, 0x6d, 0xd4, 0x4b, 0x2e, 0xef, 0x4b, 0x5e,
-	0x7d, 0xe6, 0x29, 0xcf, 0x5f, 0xe5, 0x11, 0xd7, 0x4f, 0x27, 0xb8, 0xfe, 0x4d, 0xc8, 0x79, 0xcc,
-	0xb3, 0xa8, 0x5a, 0xfb, 0xb2, 0xa0, 0x3b, 0xc9, 0xa5, 0x5d, 0x89, 0x1f, 0x64, 0xc4, 0x73, 0x4a,
-	0x87, 0x85, 0x71, 0x6b, 0xe8, 0x33, 0xa8, 0xf4, 0x5b, 0x0d, 0xdc, 0x1a, 0xd4, 0xbb, 0x3f, 0x35,
-	0xfb, 0xc6, 0x56, 0xdf, 0x58, 0xbf, 0x67, 0xf6, 0xba, 0x5b, 0x9f, 0xdf, 0x7f, 0x70, 0xef, 0x03,
-	0x2d, 0x55, 0xa9, 0x1e, 0x9f, 0x54, 0xef, 0x74, 0x8c, 0xc6, 0x96, 0x8c, 0xe5, 0x5d, 0xf6, 0xac,
-	0x4f, 0xdc, 0x80, 0xac, 0xdf, 0xeb, 0x31, 0x77, 0xca, 0x31, 0xfa, 0x49, 0x0a, 0xca, 0xc9, 0xdd,
-	0x3e, 0x79, 0x88, 0xa5, 0x2e, 0x3c, 0xc4, 0x66, 0x67, 0x61, 0xfa, 0x82, 0xb3, 0x70, 0x03, 0x6e,
-	0x5a, 0x3e, 0x0b, 0x02, 0x33, 0x70, 0x86, 0x1e, 0xb5, 0xcd, 0xc8, 0xa6, 0xe8, 0x67, 0xfd, 0x3b,
-	0xa7, 0x2f, 0xd6, 0xae, 0x37, 0x78, 0x7d, 0x5f, 0x54, 0x2b, 0xf3, 0xd7, 0xad, 0x84, 0x48, 0xb4,
-	0xf4, 0xee, 0x2f, 0x33, 0x50, 0x8c, 0xaf, 0x69, 0xf9, 0x92, 0xe1, 0x39, 0xb2, 0x1a, 0x8a, 0x58,
-	0xde, 0xa1, 0x47, 0xe8, 0xf5, 0x59, 0x76, 0xfc, 0x99, 0x7c, 0x97, 0x8a, 0xab, 0xa3, 0xcc, 0xf8,
-	0x4d, 0x28, 0x18, 0xfd, 0x7e, 0xfb, 0x51, 0xa7, 0xd5, 0xd4, 0xbe, 0x4c, 0x55, 0xbe, 0x73, 0x7c,
-	0x52, 0xbd, 0x1e, 0x83, 0x8c, 0x40, 0x7a, 0x2a, 0x50, 0x8d, 0x46, 0xab, 0x37, 0x68, 0x35, 0xb5,
-	0xe7, 0xe9, 0xf3, 0x28, 0x91, 0xed, 0x89, 0xd7, 0xe5, 0x62, 0x0f, 0xb7, 0x7a, 0x06, 0xe6, 0x0d,
-	0x7e, 0x99, 0x96, 0x49, 0xfb, 0xac, 0x45, 0x9f, 0x8e, 0x89, 0xcf, 0xdb, 0x5c, 0x8d, 0x7e, 0x65,
-	0xf1, 0x3c, 0x23, 0x5f, 0x20, 0x67, 0x77, 0xce, 0x94, 0xd8, 0x53, 0xde, 0x9a, 0xb8, 0xec, 0x17,
-	0x66, 0x32, 0xe7, 0x5a, 0xeb, 0xf3, 0x40, 0xe5, 0x56, 0x74, 0x58, 0xc4, 0x3b, 0x9d, 0x0e, 0x07,
-	0x3d, 0xcf, 0x9e, 0xeb, 0x1d, 0x9e, 0x78, 0x1e, 0xc7, 0xdc, 0x85, 0x42, 0xf4, 0x16, 0xa0, 0x7d,
-	0x99, 0x3d, 0xe7, 0x50, 0x23, 0x7a, 0xc8, 0x10, 0x0d, 0x6e, 0xee, 0x0c, 0xc4, 0x8f, 0x40, 0x9e,
-	0xe7, 0xce, 0x37, 0xb8, 0x3f, 0x09, 0x6d, 0x76, 0xe4, 0xf1, 0x09, 0x56, 0xf7, 0x03, 0x5f, 0xe6,
-	0x64, 0x32, 0x15, 0x63, 0xd4, 0xe5, 0xc0, 0x9b, 0x50, 0xc0, 0xad, 0x9f, 0xc8, 0xdf, 0x8b, 0x3c,
-	0xcf, 0x9f, 0xb3, 0x83, 0xe9, 0x17, 0xd4, 0x52, 0xad, 0x75, 0x71, 0x6f, 0xd3, 0x10, 0x43, 0x7e,
-	0x1e, 0xd5, 0xf5, 0xc7, 0xfb, 0xc4, 0xa3, 0xf6, 0xec, 0x19, 0x36, 0xae, 0x7a, 0xf7, 0xd7, 0xa0,
-	0x10, 0xd1, 0x06, 0xb4, 0x0a, 0xf9, 0xa7, 0x5d, 0xfc, 0xb8, 0x85, 0xb5, 0x05, 0x39, 0x86, 0x51,
-	0xcd, 0x53, 0x49, 0xdf, 0xaa, 0xb0, 0xb8, 0x6d, 0x74, 0x8c, 0x47, 0x2d, 0x1c, 0x5d, 0xdd, 0x45,
-	0x00, 0x75, 0xf6, 0x55, 0x34, 0xd5, 0x40, 0x6c, 0xb3, 0xbe, 0xf2, 0xd5, 0xd7, 0xab, 0x0b, 0xbf,
-	0xf8, 0x7a, 0x75, 0xe1, 0xf9, 0xe9, 0x6a, 0xea, 0xab, 0xd3, 0xd5, 0xd4, 0xcf, 0x4f, 0x57, 0x53,
-	0xff, 0x72, 0xba, 0x9a, 0xda, 0xcd, 0x8b, 0x1d, 0xe3, 0xc1, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x55, 0x06, 0x85, 0xd6, 0xa6, 0x29, 0x00, 0x00,
+	// 4363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7a, 0x4d, 0x6c, 0x23, 0x47,
+	0x76, 0xbf, 0xf8, 0x29, 0xf2, 0x91, 0xd2, 0xf4, 0xd4, 0xcc, 0xce, 0x72, 0xe8, 0xb1, 0x44, 0xb7,
+	0xed, 0xf5, 0xc7, 0x1a, 0xf4, 0x78, 0xbc, 0xde, 0xff, 0xd8, 0xfe, 0xaf, 0xed, 0xe6, 0x87, 0x46,
+	0xdc, 0x91, 0x48, 0xa2, 0x48, 0xcd, 0xac, 0x0f, 0x49, 0xa3, 0xd4, 0x5d, 0xa2, 0xda, 0x6a, 0x76,
+	0x71, 0xbb, 0x9b, 0xd2, 0x70, 0x83, 0x20, 0x83, 0x1c, 0x92, 0x40, 0x97, 0xe4, 0x18, 0x20, 0xd0,
+	0x29, 0x39, 0xe5, 0x90, 0x4b, 0x0e, 0x01, 0x72, 0x89, 0x0f, 0x7b, 0xf0, 0x2d, 0x9b, 0xe4, 0x90,
+	0x45, 0x02, 0x4c, 0x62, 0x05, 0xc8, 0x2d, 0x48, 0x2e, 0x8b, 0x5c, 0x12, 0x20, 0xa8, 0x8f, 0x6e,
+	0xb6, 0x34, 0x94, 0x34, 0x8e, 0x73, 0x91, 0xba, 0x5e, 0xfd, 0xde, 0xab, 0x7a, 0x55, 0xaf, 0xaa,
+	0xde, 0x07, 0xa1, 0x14, 0xce, 0x26, 0x34, 0xa8, 0x4f, 0x7c, 0x16, 0x32, 0x84, 0x6c, 0x66, 0x1d,
+	0x50, 0xbf, 0x1e, 0x1c, 0x11, 0x7f, 0x7c, 0xe0, 0x84, 0xf5, 0xc3, 0xf7, 0xaa, 0xeb, 0x23, 0xc6,
+	0x46, 0x2e, 0x7d, 0x57, 0x20, 0x76, 0xa7, 0x7b, 0xef, 0x86, 0xce, 0x98, 0x06, 0x21, 0x19, 0x4f,
+	0x24, 0x53, 0x75, 0xed, 0x3c, 0xc0, 0x9e, 0xfa, 0x24, 0x74, 0x98, 0xa7, 0xfa, 0x6f, 0x8e, 0xd8,
+	0x88, 0x89, 0xcf, 0x77, 0xf9, 0x97, 0xa4, 0xea, 0xeb, 0xb0, 0xfc, 0x88, 0xfa, 0x81, 0xc3, 0x3c,
+	0x74, 0x13, 0x72, 0x8e, 0x67, 0xd3, 0x27, 0x95, 0x54, 0x2d, 0xf5, 0x66, 0x16, 0xcb, 0x86, 0x7e,
+	0x17, 0xa0, 0xc3, 0x3f, 0xda, 0x5e, 0xe8, 0xcf, 0x90, 0x06, 0x99, 0x03, 0x3a, 0x13, 0x88, 0x22,
+	0xe6, 0x9f, 0x9c, 0x72, 0x48, 0xdc, 0x4a, 0x5a, 0x52, 0x0e, 0x89, 0xab, 0x7f, 0x9d, 0x82, 0x92,
+	0xe1, 0x79, 0x2c, 0x14, 0xa3, 0x07, 0x08, 0x41, 0xd6, 0x23, 0x63, 0xaa, 0x98, 0xc4, 0x37, 0x6a,
+	0x42, 0xde, 0x25, 0xbb, 0xd4, 0x0d, 0x2a, 0xe9, 0x5a, 0xe6, 0xcd, 0xd2, 0xbd, 0xef, 0xd7, 0x9f,
+	0x57, 0xb9, 0x9e, 0x10, 0x52, 0xdf, 0x12, 0x68, 0x31, 0x09, 0xac, 0x58, 0xd1, 0x27, 0xb0, 0xec,
+	0x78, 0xb6, 0x63, 0xd1, 0xa0, 0x92, 0x15, 0x52, 0xd6, 0x16, 0x49, 0x99, 0xcf, 0xbe, 0x91, 0xfd,
+	0xea, 0xd9, 0xfa, 0x12, 0x8e, 0x98, 0xaa, 0x1f, 0x42, 0x29, 0x21, 0x76, 0x81, 0x6e, 0x37, 0x21,
+	0x77, 0x48, 0xdc, 0x29, 0x55, 0xda, 0xc9, 0xc6, 0x47, 0xe9, 0xfb, 0x29, 0xfd, 0x73, 0x28, 0x62,
+	0x1a, 0xb0, 0xa9, 0x6f, 0xd1, 0x00, 0xbd, 0x05, 0x45, 0x8f, 0x78, 0xcc, 0xb4, 0x26, 0xd3, 0x40,
+	0xb0, 0x67, 0x1a, 0xe5, 0xd3, 0x67, 0xeb, 0x85, 0x2e, 0xf1, 0x58, 0xb3, 0xbf, 0x13, 0xe0, 0x02,
+	0xef, 0x6e, 0x4e, 0xa6, 0x01, 0x7a, 0x05, 0xca, 0x63, 0x3a, 0x66, 0xfe, 0xcc, 0xdc, 0x9d, 0x85,
+	0x34, 0x10, 0x82, 0x33, 0xb8, 0x24, 0x69, 0x0d, 0x4e, 0xd2, 0xff, 0x20, 0x05, 0x37, 0x23, 0xd9,
+	0x98, 0xfe, 0x74, 0xea, 0xf8, 0x74, 0x4c, 0xbd, 0x30, 0x40, 0x1f, 0x40, 0xde, 0x75, 0xc6, 0x4e,
+	0x28, 0xc7, 0x28, 0xdd, 0x7b, 0x79, 0x91, 0xb6, 0xf1, 0xac, 0xb0, 0x02, 0x23, 0x03, 0xca, 0x3e,
+	0x0d, 0xa8, 0x7f, 0x28, 0x57, 0xb2, 0x92, 0x7e, 0x11, 0xe6, 0x33, 0x2c, 0xfa, 0x06, 0x14, 0xfa,
+	0x2e, 0x09, 0xf7, 0x98, 0x3f, 0x46, 0x3a, 0x94, 0x89, 0x6f, 0xed, 0x3b, 0x21, 0xb5, 0xc2, 0xa9,
+	0x1f, 0xed, 0xea, 0x19, 0x1a, 0xba, 0x05, 0x69, 0x26, 0x07, 0x2a, 0x36, 0xf2, 0xa7, 0xcf, 0xd6,
+	0xd3, 0xbd, 0x01, 0x4e, 0xb3, 0x40, 0xff, 0x18, 0xae, 0xf7, 0xdd, 0xe9, 0xc8, 0xf1, 0x5a, 0x34,
+	0xb0, 0x7c, 0x67, 0xc2, 0xa5, 0x73, 0xf3, 0xe0, 0xb6, 0x1f, 0x99, 0x07, 0xff, 0x8e, 0x4d, 0x26,
+	0x3d, 0x37, 0x19, 0xfd, 0x77, 0xd3, 0x70, 0xbd, 0xed, 0x8d, 0x1c, 0x8f, 0x26, 0xb9, 0x5f, 0x87,
+	0x55, 0x2a, 0x88, 0xe6, 0xa1, 0x34, 0x63, 0x25, 0x67, 0x45, 0x52, 0x23, 0xdb, 0xee, 0x9c, 0xb3,
+	0xb7, 0xf7, 0x16, 0xa9, 0xff, 0x9c, 0xf4, 0x85, 0x56, 0xd7, 0x86, 0xe5, 0x89, 0x50, 0x22, 0xa8,
+	0x64, 0x84, 0xac, 0xd7, 0x17, 0xc9, 0x7a, 0x4e, 0xcf, 0xc8, 0xf8, 0x14, 0xef, 0xb7, 0x31, 0xbe,
+	0x7f, 0x49, 0xc1, 0xb5, 0x2e, 0xb3, 0xcf, 0xac, 0x43, 0x15, 0x0a, 0xfb, 0x2c, 0x08, 0x13, 0x07,
+	0x2d, 0x6e, 0xa3, 0xfb, 0x50, 0x98, 0xa8, 0xed, 0x53, 0xbb, 0x7f, 0x67, 0xf1, 0x94, 0x25, 0x06,
+	0xc7, 0x68, 0xf4, 0x31, 0x14, 0xfd, 0xc8, 0x26, 0x2a, 0x99, 0x17, 0x31, 0x9c, 0x39, 0x1e, 0xfd,
+	0x08, 0xf2, 0x72, 0x13, 0x2a, 0xd9, 0x5a, 0xea, 0xa2, 0x75, 0x7a, 0x6e, 0xcd, 0xb1, 0x62, 0xd2,
+	0x7f, 0x99, 0x02, 0x0d, 0x93, 0xbd, 0x70, 0x9b, 0x8e, 0x77, 0xa9, 0x3f, 0x08, 0x49, 0x38, 0x0d,
+	0xd0, 0x2d, 0xc8, 0xbb, 0x94, 0xd8, 0xd4, 0x17, 0x4a, 0x16, 0xb0, 0x6a, 0xa1, 0x1d, 0x6e, 0xe4,
+	0xc4, 0xda, 0x27, 0xbb, 0x8e, 0xeb, 0x84, 0x33, 0xa1, 0xe6, 0xea, 0xe2, 0x5d, 0x3e, 0x2f, 0xb3,
+	0x8e, 0x13, 0x8c, 0xf8, 0x8c, 0x18, 0x54, 0x81, 0xe5, 0x31, 0x0d, 0x02, 0x32, 0xa2, 0x42, 0xfb,
+	0x22, 0x8e, 0x9a, 0xfa, 0xc7, 0x50, 0x4e, 0xf2, 0xa1, 0x12, 0x2c, 0xef, 0x74, 0x1f, 0x76, 0x7b,
+	0x8f, 0xbb, 0xda, 0x12, 0xba, 0x06, 0xa5, 0x9d, 0x2e, 0x6e, 0x1b, 0xcd, 0x4d, 0xa3, 0xb1, 0xd5,
+	0xd6, 0x52, 0x68, 0x05, 0x8a, 0xf3, 0x66, 0x5a, 0xff, 0xf3, 0x14, 0x00, 0xdf, 0x40, 0xa5, 0xd4,
+	0x47, 0x90, 0x0b, 0x42, 0x12, 0xca, 0x8d, 0x5b, 0xbd, 0xf7, 0xda, 0xa2, 0x59, 0xcf, 0xe1, 0x75,
+	0xfe, 0x8f, 0x62, 0xc9, 0x92, 0x9c, 0x61, 0xfa, 0xcc, 0x0c, 0xf9, 0x19, 0x22, 0xb6, 0xed, 0xab,
+	0x89, 0x8b, 0x6f, 0xfd, 0x63, 0xc8, 0x09, 0xee, 0xb3, 0xd3, 0x2d, 0x40, 0xb6, 0xc5, 0xbf, 0x52,
+	0xa8, 0x08, 0x39, 0xdc, 0x36, 0x5a, 0x9f, 0x6b, 0x69, 0xa4, 0x41, 0xb9, 0xd5, 0x19, 0x34, 0x7b,
+	0xdd, 0x6e, 0xbb, 0x39, 0x6c, 0xb7, 0xb4, 0x8c, 0xfe, 0x3a, 0xe4, 0x3a, 0x63, 0x2e, 0xf9, 0x0e,
+	0xb7, 0x8a, 0x3d, 0xea, 0x53, 0xcf, 0x8a, 0x8c, 0x6d, 0x4e, 0xd0, 0x7f, 0x51, 0x84, 0xdc, 0x36,
+	0x9b, 0x7a, 0x21, 0xba, 0x97, 0x38, 0xd9, 0xab, 0x8b, 0x2f, 0x67, 0x01, 0xac, 0x0f, 0x67, 0x13,
+	0xaa, 0x4e, 0xfe, 0x2d, 0xc8, 0x4b, 0xfb, 0x51, 0xea, 0xa8, 0x16, 0xa7, 0x87, 0xc4, 0x1f, 0xd1,
+	0x50, 0xe9, 0xa3, 0x5a, 0xe8, 0x4d, 0x28, 0xf8, 0x94, 0xd8, 0xcc, 0x73, 0x67, 0xc2, 0xcc, 0x0a,
+	0xf2, 0xea, 0xc5, 0x94, 0xd8, 0x3d, 0xcf, 0x9d, 0xe1, 0xb8, 0x17, 0x6d, 0x42, 0x79, 0xd7, 0xf1,
+	0x6c, 0x93, 0x4d, 0xe4, 0x3d, 0x98, 0xbb, 0xd8, 0x28, 0xe5, 0xac, 0x1a, 0x8e, 0x67, 0xf7, 0x24,
+	0x18, 0x97, 0x76, 0xe7, 0x0d, 0xd4, 0x85, 0xd5, 0x43, 0xe6, 0x4e, 0xc7, 0x34, 0x96, 0x95, 0x17,
+	0xb2, 0xde, 0xb8, 0x58, 0xd6, 0x23, 0x81, 0x8f, 0xa4, 0xad, 0x1c, 0x26, 0x9b, 0xe8, 0x21, 0xac,
+	0x84, 0xe3, 0xc9, 0x5e, 0x10, 0x8b, 0x5b, 0x16, 0xe2, 0xbe, 0x77, 0xc9, 0x82, 0x71, 0x78, 0x24,
+	0xad, 0x1c, 0x26, 0x5a, 0xd5, 0xdf, 0xce, 0x40, 0x29, 0x31, 0x73, 0x34, 0x80, 0xd2, 0xc4, 0x67,
+	0x13, 0x32, 0x12, 0x77, 0x79, 0x25, 0x75, 0xf1, 0xc1, 0x78, 0x4e, 0xeb, 0x7a, 0x7f, 0xce, 0x88,
+	0x93, 0x52, 0xf4, 0x93, 0x34, 0x94, 0x12, 0x9d, 0xe8, 0x6d, 0x28, 0xe0, 0x3e, 0xee, 0x3c, 0x32,
+	0x86, 0x6d, 0x6d, 0xa9, 0x7a, 0xe7, 0xf8, 0xa4, 0x56, 0x11, 0xd2, 0x92, 0x02, 0xfa, 0xbe, 0x73,
+	0xc8, 0x4d, 0xef, 0x4d, 0x58, 0x8e, 0xa0, 0xa9, 0xea, 0x4b, 0xc7, 0x27, 0xb5, 0xef, 0x9e, 0x87,
+	0x26, 0x90, 0x78, 0xb0, 0x69, 0xe0, 0x76, 0x4b, 0x4b, 0x2f, 0x46, 0xe2, 0xc1, 0x3e, 0xf1, 0xa9,
+	0x8d, 0xbe, 0x07, 0x79, 0x05, 0xcc, 0x54, 0xab, 0xc7, 0x27, 0xb5, 0x5b, 0xe7, 0x81, 0x73, 0x1c,
+	0x1e, 0x6c, 0x19, 0x8f, 0xda, 0x5a, 0x76, 0x31, 0x0e, 0x0f, 0x5c, 0x72, 0x48, 0xd1, 0x6b, 0x90,
+	0x93, 0xb0, 0x5c, 0xf5, 0xf6, 0xf1, 0x49, 0xed, 0x3b, 0xcf, 0x89, 0xe3, 0xa8, 0x6a, 0xe5, 0xf7,
+	0xfe, 0x78, 0x6d, 0xe9, 0x2f, 0xff, 0x64, 0x4d, 0x3b, 0xdf, 0x5d, 0xfd, 0xaf, 0x14, 0xac, 0x9c,
+	0xd9, 0x72, 0xa4, 0x43, 0xde, 0x63, 0x16, 0x9b, 0xc8, 0x2b, 0xbe, 0xd0, 0x80, 0xd3, 0x67, 0xeb,
+	0xf9, 0x2e, 0x6b, 0xb2, 0xc9, 0x0c, 0xab, 0x1e, 0xf4, 0xf0, 0xdc, 0x23, 0xf5, 0xfe, 0x0b, 0xda,
+	0xd3, 0xc2, 0x67, 0xea, 0x53, 0x58, 0xb1, 0x7d, 0xe7, 0x90, 0xfa, 0xa6, 0xc5, 0xbc, 0x3d, 0x67,
+	0xa4, 0xae, 0xef, 0xea, 0x22, 0x99, 0x2d, 0x01, 0xc4, 0x65, 0xc9, 0xd0, 0x14, 0xf8, 0x6f, 0xf1,
+	0x40, 0x55, 0x1f, 0x41, 0x39, 0x69, 0xa1, 0xe8, 0x65, 0x80, 0xc0, 0xf9, 0x19, 0x55, 0x3e, 0x8f,
+	0xf0, 0x90, 0x70, 0x91, 0x53, 0x84, 0xc7, 0x83, 0xde, 0x80, 0xec, 0x98, 0xd9, 0x52, 0xce, 0x4a,
+	0xe3, 0x06, 0x7f, 0x27, 0xff, 0xe1, 0xd9, 0x7a, 0x89, 0x05, 0xf5, 0x0d, 0xc7, 0xa5, 0xdb, 0xcc,
+	0xa6, 0x58, 0x00, 0xf4, 0x43, 0xc8, 0xf2, 0xab, 0x02, 0xbd, 0x04, 0xd9, 0x46, 0xa7, 0xdb, 0xd2,
+	0x96, 0xaa, 0xd7, 0x8f, 0x4f, 0x6a, 0x2b, 0x62, 0x49, 0x78, 0x07, 0xb7, 0x5d, 0xb4, 0x0e, 0xf9,
+	0x47, 0xbd, 0xad, 0x9d, 0x6d, 0x6e, 0x5e, 0x37, 0x8e, 0x4f, 0x6a, 0xd7, 0xe2, 0x6e, 0xb9, 0x68,
+	0xe8, 0x65, 0xc8, 0x0d, 0xb7, 0xfb, 0x1b, 0x03, 0x2d, 0x5d, 0x45, 0xc7, 0x27, 0xb5, 0xd5, 0xb8,
+	0x5f, 0xcc, 0xb9, 0x7a, 0x5d, 0xed, 0x6a, 0x31, 0xa6, 0xeb, 0xbf, 0x4a, 0xc3, 0x0a, 0xe6, 0xce,
+	0xb6, 0x1f, 0xf6, 0x99, 0xeb, 0x58, 0x33, 0xd4, 0x87, 0xa2, 0xc5, 0x3c, 0xdb, 0x49, 0x9c, 0xa9,
+	0x7b, 0x17, 0x3c, 0x8c, 0x73, 0xae, 0xa8, 0xd5, 0x8c, 0x38, 0xf1, 0x5c, 0x08, 0x7a, 0x17, 0x72,
+	0x36, 0x75, 0xc9, 0x4c, 0xbd, 0xd0, 0xb7, 0xeb, 0xd2, 0x9d, 0xaf, 0x47, 0xee, 0x7c, 0xbd, 0xa5,
+	0xdc, 0x79, 0x2c, 0x71, 0xc2, 0x95, 0x24, 0x4f, 0x4c, 0x12, 0x86, 0x74, 0x3c, 0x09, 0xe5, 0xf3,
+	0x9c, 0xc5, 0xa5, 0x31, 0x79, 0x62, 0x28, 0x12, 0x7a, 0x0f, 0xf2, 0x47, 0x8e, 0x67, 0xb3, 0xa3,
+	0x4a, 0xf6, 0x2a, 0xa1, 0x0a, 0xa8, 0x1f, 0xf3, 0x57, 0xf7, 0xdc, 0x34, 0xf9, 0x7a, 0x77, 0x7b,
+	0xdd, 0x76, 0xb4, 0xde, 0xaa, 0xbf, 0xe7, 0x75, 0x99, 0xc7, 0xcf, 0x0a, 0xf4, 0xba, 0xe6, 0x86,
+	0xd1, 0xd9, 0xda, 0xc1, 0x7c, 0xcd, 0x6f, 0x1e, 0x9f, 0xd4, 0xb4, 0x18, 0xb2, 0x41, 0x1c, 0x97,
+	0xbb, 0x84, 0xb7, 0x21, 0x63, 0x74, 0x3f, 0xd7, 0xd2, 0x55, 0xed, 0xf8, 0xa4, 0x56, 0x8e, 0xbb,
+	0x0d, 0x6f, 0x36, 0x3f, 0x46, 0xe7, 0xc7, 0xd5, 0xff, 0x3a, 0x03, 0xe5, 0x9d, 0x89, 0x4d, 0x42,
+	0x2a, 0x6d, 0x12, 0xd5, 0xa0, 0x34, 0x21, 0x3e, 0x71, 0x5d, 0xea, 0x3a, 0xc1, 0x58, 0x05, 0x2a,
+	0x49, 0x12, 0xfa, 0xf0, 0x45, 0x97, 0xb1, 0x51, 0xe0, 0x76, 0xf6, 0x87, 0xff, 0xb4, 0x9e, 0x8a,
+	0x16, 0x74, 0x07, 0x56, 0xf7, 0xe4, 0x6c, 0x4d, 0x62, 0x89, 0x8d, 0xcd, 0x88, 0x8d, 0xad, 0x2f,
+	0xda, 0xd8, 0xe4, 0xb4, 0xea, 0x4a, 0x49, 0x43, 0x70, 0xe1, 0x95, 0xbd, 0x64, 0x13, 0xbd, 0x0f,
+	0xcb, 0x63, 0xe6, 0x39, 0x21, 0xf3, 0xaf, 0xde, 0x85, 0x08, 0x89, 0xde, 0x86, 0xeb, 0x7c, 0x73,
+	0xa3, 0xf9, 0x88, 0x6e, 0xf1, 0x62, 0xa5, 0xf1, 0xb5, 0x31, 0x79, 0xa2, 0x06, 0xc4, 0x9c, 0x8c,
+	0x1a, 0x90, 0x63, 0x3e, 0x77, 0x89, 0xf2, 0x62, 0xba, 0xef, 0x5c, 0x39, 0x5d, 0xd9, 0xe8, 0x71,
+	0x1e, 0x2c, 0x59, 0xf5, 0x1f, 0xc2, 0xca, 0x19, 0x25, 0xb8, 0x27, 0xd0, 0x37, 0x76, 0x06, 0x6d,
+	0x6d, 0x09, 0x95, 0xa1, 0xd0, 0xec, 0x75, 0x87, 0x9d, 0xee, 0x0e, 0x77, 0x65, 0xca, 0x50, 0xc0,
+	0xbd, 0xad, 0xad, 0x86, 0xd1, 0x7c, 0xa8, 0xa5, 0xf5, 0x3a, 0x94, 0x12, 0xd2, 0xd0, 0x2a, 0xc0,
+	0x60, 0xd8, 0xeb, 0x9b, 0x1b, 0x1d, 0x3c, 0x18, 0x4a, 0x47, 0x68, 0x30, 0x34, 0xf0, 0x50, 0x11,
+	0x52, 0xfa, 0xbf, 0xa7, 0xa3, 0x1d, 0x55, 0xbe, 0x4f, 0xe3, 0xac, 0xef, 0x73, 0xc9, 0xe4, 0x25,
+	0x43, 0xa2, 0x11, 0xfb, 0x40, 0x1f, 0x02, 0x08, 0xc3, 0xa1, 0xb6, 0x49, 0x42, 0xb5, 0xf1, 0xd5,
+	0xe7, 0x16, 0x79, 0x18, 0xc5, 0xcb, 0xb8, 0xa8, 0xd0, 0x46, 0x88, 0x7e, 0x04, 0x65, 0x8b, 0x8d,
+	0x27, 0x2e, 0x55, 0xcc, 0x99, 0x2b, 0x99, 0x4b, 0x31, 0xde, 0x08, 0x93, 0xde, 0x57, 0xf6, 0xac,
+	0x7f, 0xf8, 0x3b, 0x29, 0x28, 0x25, 0xa6, 0x7a, 0xd6, 0xe1, 0x2a, 0x43, 0x61, 0xa7, 0xdf, 0x32,
+	0x86, 0x9d, 0xee, 0x03, 0x2d, 0x85, 0x00, 0xf2, 0x62, 0xa9, 0x5b, 0x5a, 0x9a, 0x3b, 0x8a, 0xcd,
+	0xde, 0x76, 0x7f, 0xab, 0x2d, 0x5c, 0x2e, 0x74, 0x13, 0xb4, 0x68, 0xb1, 0x4d, 0xb1, 0x90, 0xed,
+	0x96, 0x96, 0x45, 0x37, 0xe0, 0x5a, 0x4c, 0x55, 0x9c, 0x39, 0x74, 0x0b, 0x50, 0x4c, 0x9c, 0x8b,
+	0xc8, 0xeb, 0xbf, 0x09, 0xd7, 0x9a, 0xcc, 0x0b, 0x89, 0xe3, 0xc5, 0x4e, 0xf4, 0x3d, 0xae, 0xb4,
+	0x22, 0x99, 0x8e, 0x2d, 0xef, 0xf4, 0xc6, 0xb5, 0xd3, 0x67, 0xeb, 0xa5, 0x18, 0xda, 0x69, 0x71,
+	0x4d, 0xa3, 0x86, 0xcd, 0xcf, 0xef, 0xc4, 0xb1, 0xc5, 0xe2, 0xe6, 0x1a, 0xcb, 0xa7, 0xcf, 0xd6,
+	0x33, 0xfd, 0x4e, 0x0b, 0x73, 0x1a, 0x7a, 0x09, 0x8a, 0xf4, 0x89, 0x13, 0x9a, 0x16, 0xbf, 0xc3,
+	0xf9, 0x02, 0xe6, 0x70, 0x81, 0x13, 0x9a, 0xfc, 0xca, 0x6e, 0x00, 0xf4, 0x99, 0x1f, 0xaa, 0x91,
+	0x7f, 0x00, 0xb9, 0x09, 0xf3, 0x45, 0x04, 0x7b, 0x61, 0xbc, 0xce, 0xe1, 0xd2, 0x50, 0xb1, 0x04,
+	0xeb, 0x7f, 0x95, 0x06, 0x18, 0x92, 0xe0, 0x40, 0x09, 0xb9, 0x0f, 0xc5, 0x38, 0xf7, 0x51, 0x49,
+	0x5d, 0xb9, 0x61, 0x73, 0x30, 0x7a, 0x3f, 0x32, 0x36, 0x19, 0x1e, 0x2c, 0x0c, 0x65, 0xa2, 0x81,
+	0x16, 0x79, 0xd8, 0x67, 0x63, 0x00, 0xfe, 0x24, 0x52, 0xdf, 0x57, 0x3b, 0xcf, 0x3f, 0x51, 0x13,
+	0x8a, 0xf1, 0xa2, 0x29, 0x07, 0xf3, 0xd5, 0x45, 0x83, 0x9c, 0xdb, 0x91, 0xcd, 0x25, 0x3c, 0xe7,
+	0x43, 0x9f, 0x42, 0x89, 0xeb, 0x6d, 0x06, 0xa2, 0x4f, 0xf9, 0x96, 0x17, 0x2e, 0x95, 0x94, 0x80,
+	0x61, 0x12, 0x7f, 0x37, 0x34, 0x58, 0xf5, 0xa7, 0x1e, 0x57, 0x5b, 0xc9, 0xd0, 0x1d, 0xf8, 0x6e,
+	0x97, 0x86, 0x47, 0xcc, 0x3f, 0x30, 0xc2, 0x90, 0x58, 0xfb, 0x3c, 0xa1, 0xa0, 0xae, 0xd4, 0xb9,
+	0x63, 0x9d, 0x3a, 0xe3, 0x58, 0x57, 0x60, 0x99, 0xb8, 0x0e, 0x09, 0xa8, 0xf4, 0x46, 0x8a, 0x38,
+	0x6a, 0x72, 0xf7, 0x9f, 0x07, 0x13, 0x34, 0x08, 0xa8, 0x0c, 0x81, 0x8b, 0x78, 0x4e, 0xd0, 0xff,
+	0x2e, 0x0d, 0xd0, 0xe9, 0x1b, 0xdb, 0x4a, 0x7c, 0x0b, 0xf2, 0x7b, 0x64, 0xec, 0xb8, 0xb3, 0xcb,
+	0x0e, 0xf8, 0x1c, 0x5f, 0x37, 0xa4, 0xa0, 0x0d, 0xc1, 0x83, 0x15, 0xaf, 0x88, 0x0a, 0xa6, 0xbb,
+	0x1e, 0x0d, 0xe3, 0xa8, 0x40, 0xb4, 0xb8, 0x0b, 0xe2, 0x13, 0x2f, 0xde, 0x19, 0xd9, 0xe0, 0x53,
+	0x1f, 0x91, 0x90, 0x1e, 0x91, 0x59, 0x74, 0x2a, 0x55, 0x13, 0x6d, 0x42, 0x41, 0x26, 0x36, 0xa8,
+	0x5d, 0xc9, 0x09, 0x13, 0xbc, 0x6a, 0x3e, 0x58, 0xc1, 0xa5, 0x73, 0x15, 0x73, 0x57, 0x3f, 0x16,
+	0x1e, 0xc1, 0xbc, 0xeb, 0x1b, 0x05, 0xf0, 0x77, 0x61, 0xe5, 0x8c, 0x9e, 0xcf, 0x85, 0x63, 0x9d,
+	0xfe, 0xa3, 0x1f, 0x68, 0x59, 0xf5, 0xf5, 0x43, 0x2d, 0xaf, 0xff, 0x69, 0x46, 0x9e, 0x23, 0xb5,
+	0xaa, 0x8b, 0x53, 0x6a, 0x05, 0x61, 0xfd, 0x16, 0x73, 0x95, 0x7d, 0xbf, 0x71, 0xf9, 0xf1, 0xaa,
+	0xf7, 0x15, 0x1c, 0xc7, 0x8c, 0x68, 0x1d, 0x4a, 0x72, 0xff, 0x4d, 0x6e, 0x4f, 0x62, 0x59, 0x57,
+	0x30, 0x48, 0x12, 0xe7, 0xe4, 0xf9, 0x96, 0xc9, 0x74, 0xd7, 0x75, 0x82, 0x7d, 0x6a, 0x4b, 0x4c,
+	0x56, 0x60, 0x56, 0x62, 0xaa, 0x80, 0x6d, 0x43, 0x59, 0x11, 0x4c, 0xe1, 0xda, 0xe5, 0xc4, 0x84,
+	0xde, 0xbe, 0x6a, 0x42, 0x92, 0x45, 0x78, 0x7c, 0xa5, 0xc9, 0xbc, 0xa1, 0xb7, 0xa0, 0x10, 0x4d,
+	0x16, 0x55, 0x20, 0x33, 0x6c, 0xf6, 0xb5, 0xa5, 0xea, 0xb5, 0xe3, 0x93, 0x5a, 0x29, 0x22, 0x0f,
+	0x9b, 0x7d, 0xde, 0xb3, 0xd3, 0xea, 0x6b, 0xa9, 0xb3, 0x3d, 0x3b, 0xad, 0x7e, 0x35, 0xcb, 0x5d,
+	0x0c, 0x7d, 0x0f, 0x4a, 0x89, 0x11, 0xd0, 0xab, 0xb0, 0xdc, 0xe9, 0x3e, 0xc0, 0xed, 0xc1, 0x40,
+	0x5b, 0xaa, 0xde, 0x3a, 0x3e, 0xa9, 0xa1, 0x44, 0x6f, 0xc7, 0x1b, 0xf1, 0xfd, 0x41, 0x2f, 0x43,
+	0x76, 0xb3, 0x37, 0x18, 0x46, 0xbe, 0x64, 0x02, 0xb1, 0xc9, 0x82, 0xb0, 0x7a, 0x43, 0xf9, 0x2e,
+	0x49, 0xc1, 0xfa, 0x1f, 0xa5, 0x20, 0x2f, 0x5d, 0xea, 0x85, 0x1b, 0x65, 0xc0, 0x72, 0x14, 0xe8,
+	0x49, 0x3f, 0xff, 0x8d, 0x8b, 0x7d, 0xf2, 0xba, 0x72, 0xa1, 0xa5, 0xf9, 0x45, 0x7c, 0xd5, 0x8f,
+	0xa0, 0x9c, 0xec, 0xf8, 0x46, 0xc6, 0xf7, 0x1b, 0x50, 0xe2, 0xf6, 0xad, 0xf8, 0xd1, 0x3d, 0xc8,
+	0x4b, 0xb7, 0x3f, 0xbe, 0x4a, 0x2f, 0x0e, 0x10, 0x14, 0x12, 0xdd, 0x87, 0x65, 0x19, 0x54, 0x44,
+	0x29, 0xb0, 0xb5, 0xcb, 0x4f, 0x11, 0x8e, 0xe0, 0xfa, 0xa7, 0x90, 0xed, 0x53, 0xea, 0xf3, 0xb5,
+	0xf7, 0x98, 0x4d, 0xe7, 0xaf, 0x8f, 0x8a, 0x87, 0x6c, 0xda, 0x69, 0xf1, 0x78, 0xc8, 0xa6, 0x1d,
+	0x3b, 0xce, 0x60, 0xa4, 0x13, 0x19, 0x8c, 0x21, 0x94, 0x1f, 0x53, 0x67, 0xb4, 0x1f, 0x52, 0x5b,
+	0x08, 0x7a, 0x07, 0xb2, 0x13, 0x1a, 0x4f, 0xbe, 0xb2, 0xd0, 0xc0, 0x28, 0xf5, 0xb1, 0x40, 0xf1,
+	0x7b, 0xe4, 0x48, 0x70, 0xab, 0xc4, 0xab, 0x6a, 0xe9, 0x7f, 0x9b, 0x86, 0xd5, 0x4e, 0x10, 0x4c,
+	0x89, 0x67, 0x45, 0x8e, 0xc9, 0x27, 0x67, 0x1d, 0x93, 0x37, 0x17, 0x6a, 0x78, 0x86, 0xe5, 0x6c,
+	0x62, 0x46, 0x3d, 0x0e, 0xe9, 0xf8, 0x71, 0xd0, 0xff, 0x2d, 0x15, 0x65, 0x5f, 0x5e, 0x4f, 0x1c,
+	0xf7, 0x6a, 0xe5, 0xf8, 0xa4, 0x76, 0x33, 0x29, 0x89, 0xee, 0x78, 0x07, 0x1e, 0x3b, 0xf2, 0xd0,
+	0x2b, 0x3c, 0x1b, 0xd3, 0x6d, 0x3f, 0xd6, 0x52, 0xd2, 0x3c, 0xcf, 0x80, 0x30, 0xf5, 0xe8, 0x11,
+	0x97, 0xd4, 0x6f, 0x77, 0x5b, 0xdc, 0x91, 0x48, 0x2f, 0x90, 0xd4, 0xa7, 0x9e, 0xed, 0x78, 0x23,
+	0xf4, 0x2a, 0xe4, 0x3b, 0x83, 0xc1, 0x8e, 0x88, 0x8f, 0xbf, 0x7b, 0x7c, 0x52, 0xbb, 0x71, 0x06,
+	0xc5, 0x1b, 0xd4, 0xe6, 0x20, 0xee, 0xc5, 0x73, 0x17, 0x63, 0x01, 0x88, 0xbb, 0x87, 0x12, 0x84,
+	0x7b, 0x43, 0x1e, 0xbc, 0xe7, 0x16, 0x80, 0x30, 0xe3, 0x7f, 0xd5, 0x71, 0xfb, 0xc7, 0x34, 0x68,
+	0x86, 0x65, 0xd1, 0x49, 0xc8, 0xfb, 0x55, 0xe0, 0x34, 0x84, 0xc2, 0x84, 0x7f, 0x39, 0x34, 0x72,
+	0x02, 0xee, 0x2f, 0x4c, 0xfd, 0x9f, 0xe3, 0xab, 0x63, 0xe6, 0x52, 0xc3, 0x1e, 0x3b, 0x01, 0x4f,
+	0xe7, 0x4a, 0x1a, 0x8e, 0x25, 0x55, 0xff, 0x23, 0x05, 0x37, 0x16, 0x20, 0xd0, 0x5d, 0xc8, 0xfa,
+	0xcc, 0x8d, 0xf6, 0xf0, 0xce, 0x45, 0x89, 0x35, 0xce, 0x8a, 0x05, 0x12, 0xad, 0x01, 0x90, 0x69,
+	0xc8, 0x88, 0x18, 0x5f, 0xec, 0x5e, 0x01, 0x27, 0x28, 0xe8, 0x31, 0xe4, 0x03, 0x6a, 0xf9, 0x34,
+	0x72, 0x15, 0x3f, 0xfd, 0xdf, 0xce, 0xbe, 0x3e, 0x10, 0x62, 0xb0, 0x12, 0x57, 0xad, 0x43, 0x5e,
+	0x52, 0xb8, 0xd9, 0xdb, 0x24, 0x24, 0x62, 0xd2, 0x65, 0x2c, 0xbe, 0xb9, 0x35, 0x11, 0x77, 0x14,
+	0x59, 0x13, 0x71, 0x47, 0xfa, 0xcf, 0xd3, 0x00, 0xed, 0x27, 0x21, 0xf5, 0x3d, 0xe2, 0x36, 0x0d,
+	0xd4, 0x4e, 0xdc, 0xfe, 0x52, 0xdb, 0xb7, 0x16, 0xa6, 0x5b, 0x63, 0x8e, 0x7a, 0xd3, 0x58, 0x70,
+	0xff, 0xdf, 0x86, 0xcc, 0xd4, 0x57, 0xd5, 0x1c, 0xe9, 0xe6, 0xed, 0xe0, 0x2d, 0xcc, 0x69, 0x3c,
+	0xef, 0x1d, 0x5d, 0x5b, 0x99, 0x8b, 0x6b, 0x36, 0x89, 0x01, 0x16, 0x5e, 0x5d, 0xfc, 0xe4, 0x5b,
+	0xc4, 0xb4, 0xa8, 0x7a, 0x39, 0xca, 0xf2, 0xe4, 0x37, 0x8d, 0x26, 0xf5, 0x43, 0x9c, 0xb7, 0x08,
+	0xff, 0xff, 0xad, 0xee, 0xb7, 0x77, 0x00, 0xe6, 0xaa, 0xa1, 0x35, 0xc8, 0x35, 0x37, 0x06, 0x83,
+	0x2d, 0x6d, 0x49, 0x5e, 0xe0, 0xf3, 0x2e, 0x41, 0xd6, 0xff, 0x3e, 0x05, 0x85, 0xa6, 0xa1, 0x9e,
+	0xd5, 0x26, 0x68, 0xe2, 0x56, 0xe2, 0xb3, 0x33, 0xe9, 0x93, 0x89, 0xe3, 0xcf, 0x2a, 0xa9, 0xab,
+	0x62, 0xb6, 0x55, 0xce, 0xc2, 0x67, 0xdd, 0x16, 0x0c, 0x08, 0x43, 0x99, 0xaa, 0x45, 0x30, 0x2d,
+	0x12, 0xdd, 0xf1, 0x6b, 0x97, 0x2f, 0x96, 0xf4, 0xbe, 0xe7, 0xed, 0x00, 0x97, 0x22, 0x21, 0x4d,
+	0x22, 0x3c, 0xf6, 0x31, 0xf1, 0xc8, 0x88, 0xfa, 0x66, 0x40, 0xd4, 0x06, 0x28, 0x8f, 0x7d, 0x5b,
+	0xd2, 0x07, 0x46, 0x37, 0xe0, 0xc1, 0xbf, 0x6c, 0x10, 0x2f, 0xd0, 0x1f, 0xc1, 0x8d, 0x9e, 0x6f,
+	0xed, 0xd3, 0x20, 0x94, 0x13, 0x55, 0x3a, 0x7e, 0x0a, 0x77, 0x42, 0x12, 0x1c, 0x98, 0xfb, 0x4e,
+	0x10, 0xf2, 0x3a, 0x94, 0x4f, 0x43, 0xea, 0xf1, 0x7e, 0x53, 0xd4, 0x8b, 0x54, 0x76, 0xe6, 0x36,
+	0xc7, 0x6c, 0x4a, 0x08, 0x8e, 0x10, 0x5b, 0x1c, 0xa0, 0x77, 0xa0, 0xcc, 0x7d, 0xe4, 0x16, 0xdd,
+	0x23, 0x53, 0x37, 0x0c, 0x78, 0xf4, 0xe5, 0xb2, 0x91, 0xf9, 0xc2, 0x8f, 0x48, 0xd1, 0x65, 0x23,
+	0xf9, 0xa9, 0xff, 0x04, 0xb4, 0x96, 0x13, 0x4c, 0x48, 0x68, 0xed, 0x47, 0x69, 0x27, 0xd4, 0x02,
+	0x6d, 0x9f, 0x12, 0x3f, 0xdc, 0xa5, 0x24, 0x34, 0x27, 0xd4, 0x77, 0x98, 0x7d, 0xf5, 0x1e, 0x5c,
+	0x8b, 0x59, 0xfa, 0x82, 0x43, 0xff, 0xcf, 0x14, 0x00, 0x4f, 0xf4, 0x2b, 0xa1, 0xdf, 0x87, 0xeb,
+	0x81, 0x47, 0x26, 0xc1, 0x3e, 0x0b, 0x4d, 0xc7, 0x0b, 0x79, 0x65, 0xcb, 0x55, 0xd9, 0x03, 0x2d,
+	0xea, 0xe8, 0x28, 0x3a, 0x7a, 0x07, 0xd0, 0x01, 0xa5, 0x13, 0x93, 0xb9, 0xb6, 0x19, 0x75, 0xca,
+	0x6a, 0x56, 0x16, 0x6b, 0xbc, 0xa7, 0xe7, 0xda, 0x83, 0x88, 0x8e, 0x1a, 0xb0, 0xc6, 0xd5, 0xa7,
+	0x5e, 0xe8, 0x3b, 0x34, 0x30, 0xf7, 0x98, 0x6f, 0x06, 0x2e, 0x3b, 0x32, 0xf7, 0x98, 0xeb, 0xb2,
+	0x23, 0xea, 0x47, 0x89, 0x99, 0xaa, 0xcb, 0x46, 0x6d, 0x09, 0xda, 0x60, 0xfe, 0xc0, 0x65, 0x47,
+	0x1b, 0x11, 0x82, 0x3b, 0x55, 0x73, 0x9d, 0x43, 0xc7, 0x3a, 0x88, 0x9c, 0xaa, 0x98, 0x3a, 0x74,
+	0xac, 0x03, 0xf4, 0x2a, 0xac, 0x50, 0x97, 0x8a, 0xf8, 0x5c, 0xa2, 0x72, 0x02, 0x55, 0x8e, 0x88,
+	0x1c, 0xa4, 0x7f, 0x06, 0x5a, 0xdb, 0xb3, 0xfc, 0xd9, 0x24, 0xb1, 0xe7, 0xef, 0x00, 0xe2, 0x57,
+	0x98, 0xe9, 0x32, 0xeb, 0xc0, 0x54, 0x36, 0x12, 0xa8, 0x0a, 0x8a, 0xc6, 0x7b, 0xb6, 0x98, 0x75,
+	0xa0, 0x0c, 0x29, 0xd0, 0x3f, 0x04, 0x18, 0x4c, 0x78, 0xda, 0xbc, 0xc7, 0xdf, 0x7a, 0xbe, 0x74,
+	0xa2, 0x65, 0xda, 0xaa, 0x48, 0xc3, 0x7c, 0x75, 0x10, 0x35, 0xd9, 0xd1, 0x8a, 0xe9, 0xfa, 0xaf,
+	0xc1, 0x8d, 0xbe, 0x4b, 0x2c, 0x51, 0xb0, 0xec, 0xc7, 0x25, 0x01, 0x74, 0x1f, 0xf2, 0x12, 0xaa,
+	0x76, 0x72, 0xe1, 0x61, 0x98, 0x8f, 0xb9, 0xb9, 0x84, 0x15, 0xbe, 0x51, 0x06, 0x98, 0xcb, 0xd1,
+	0x9f, 0x40, 0x31, 0x16, 0xcf, 0x73, 0x41, 0x16, 0xf3, 0xb8, 0x75, 0x3b, 0x9e, 0x8a, 0x28, 0x8b,
+	0x38, 0x49, 0x42, 0x1d, 0x9e, 0xfa, 0x8e, 0x98, 0x2f, 0x75, 0xb6, 0x16, 0x4c, 0x1a, 0x27, 0x79,
+	0xf5, 0x4f, 0x00, 0x7e, 0xcc, 0x1c, 0x6f, 0xc8, 0x0e, 0xa8, 0x27, 0xaa, 0x50, 0x3c, 0x96, 0xa2,
+	0xd1, 0x42, 0xa8, 0x96, 0x08, 0x15, 0xe5, 0x2a, 0xc6, 0xc5, 0x18, 0xd9, 0xd4, 0x7f, 0x3f, 0x0d,
+	0x79, 0xcc, 0x58, 0xd8, 0x34, 0x50, 0x0d, 0xf2, 0x16, 0x31, 0xa3, 0xeb, 0xac, 0xdc, 0x28, 0x9e,
+	0x3e, 0x5b, 0xcf, 0x35, 0x8d, 0x87, 0x74, 0x86, 0x73, 0x16, 0x79, 0x48, 0x67, 0xc9, 0x2b, 0x32,
+	0x7d, 0xd1, 0x15, 0x89, 0xee, 0x42, 0x59, 0x81, 0xcc, 0x7d, 0x12, 0xec, 0xcb, 0x08, 0xa8, 0xb1,
+	0x7a, 0xfa, 0x6c, 0x1d, 0x24, 0x72, 0x93, 0x04, 0xfb, 0x18, 0x2c, 0x12, 0x7d, 0xa3, 0x36, 0x94,
+	0xbe, 0x60, 0x8e, 0x67, 0x86, 0x42, 0x89, 0x4a, 0xf6, 0xe2, 0xad, 0x98, 0xab, 0xaa, 0xaa, 0x96,
+	0xf0, 0xc5, 0x5c, 0xf9, 0x36, 0xac, 0xf8, 0x8c, 0x85, 0xa6, 0xaf, 0x6a, 0xf3, 0x2a, 0xce, 0xad,
+	0x2d, 0x12, 0xc4, 0x55, 0xc6, 0x0a, 0x87, 0xcb, 0x7e, 0xa2, 0xa5, 0x7f, 0x99, 0x86, 0x12, 0x9f,
+	0x9a, 0xb3, 0xe7, 0x58, 0xdc, 0x27, 0xfa, 0xe6, 0x4f, 0xf5, 0x6d, 0xc8, 0x58, 0x81, 0xaf, 0x96,
+	0x48, 0xbc, 0x55, 0xcd, 0x01, 0xc6, 0x9c, 0x86, 0x3e, 0x83, 0xbc, 0x8a, 0x9e, 0xe5, 0x2b, 0xad,
+	0x5f, 0xed, 0xbd, 0x29, 0x4d, 0x15, 0x9f, 0xb0, 0xae, 0xf9, 0xec, 0xe4, 0x53, 0x85, 0x93, 0x24,
+	0x5e, 0xe4, 0xb6, 0xa4, 0xf2, 0xaa, 0xc8, 0xdd, 0xec, 0xe2, 0xb4, 0xe5, 0xf1, 0x02, 0x39, 0xf3,
+	0x47, 0xc4, 0x73, 0x7e, 0x26, 0x97, 0x27, 0x2f, 0x0b, 0xe4, 0x49, 0x1a, 0xbf, 0xe4, 0x7c, 0xfa,
+	0xd3, 0x29, 0x0d, 0x78, 0xda, 0x49, 0x3d, 0x34, 0xcb, 0x57, 0x5e, 0x72, 0x31, 0x8b, 0x7c, 0x69,
+	0xf4, 0x9f, 0xa7, 0xe0, 0x46, 0x62, 0x09, 0x23, 0x7d, 0xf8, 0x3d, 0x11, 0x50, 0xdf, 0x21, 0xae,
+	0xe9, 0x4d, 0x79, 0x9d, 0x33, 0xaa, 0xd1, 0x4b, 0x62, 0x57, 0xd0, 0xb8, 0x0d, 0x3b, 0xdc, 0xef,
+	0x8b, 0x4c, 0x55, 0xb5, 0xd0, 0xff, 0x83, 0xa2, 0xf8, 0x7a, 0xc1, 0x74, 0x58, 0x41, 0x82, 0x8d,
+	0x90, 0x33, 0x7a, 0x2c, 0x34, 0xc9, 0x5e, 0x48, 0xa3, 0x4c, 0xe7, 0xa5, 0x8c, 0x1e, 0x0b, 0x0d,
+	0x8e, 0xd5, 0xff, 0x26, 0x05, 0x2b, 0xf3, 0x2b, 0x8b, 0x1f, 0x80, 0x3b, 0x50, 0x0c, 0xa6, 0xbb,
+	0xc1, 0x2c, 0x08, 0xe9, 0x38, 0x2a, 0x30, 0xc6, 0x04, 0xd4, 0x81, 0x22, 0x71, 0x47, 0xcc, 0x77,
+	0xc2, 0xfd, 0xb1, 0x8a, 0x74, 0x17, 0xbb, 0x22, 0x49, 0x99, 0x75, 0x23, 0x62, 0xc1, 0x73, 0xee,
+	0xc8, 0xaf, 0xc8, 0x88, 0xdd, 0xe5, 0x9f, 0x3c, 0xab, 0xee, 0x92, 0xb1, 0xc8, 0xbf, 0xf0, 0x04,
+	0x8a, 0x50, 0x24, 0x8b, 0x4b, 0x8a, 0xc6, 0xa7, 0xaf, 0xeb, 0x50, 0x8c, 0x85, 0xf1, 0x0c, 0xa7,
+	0xd1, 0x1e, 0x98, 0xef, 0xdd, 0xbb, 0x6f, 0x3e, 0x68, 0x6e, 0x6b, 0x4b, 0xca, 0xf7, 0xfd, 0x8b,
+	0x14, 0xac, 0x44, 0x2f, 0xb3, 0x34, 0xa8, 0x57, 0x61, 0xd9, 0x27, 0x7b, 0x61, 0x14, 0xf1, 0x64,
+	0xe5, 0xa1, 0xe6, 0x6f, 0x14, 0x8f, 0x78, 0x78, 0xd7, 0xe2, 0x88, 0x27, 0x51, 0xf2, 0xce, 0x5c,
+	0x5a, 0xf2, 0xce, 0xfe, 0x9f, 0x94, 0xbc, 0xf5, 0x3f, 0x4b, 0xc3, 0x35, 0xe5, 0x9a, 0xc6, 0xf7,
+	0xf7, 0x5b, 0x50, 0x94, 0x5e, 0xea, 0x3c, 0x5e, 0x13, 0x55, 0x56, 0x89, 0xeb, 0xb4, 0x70, 0x41,
+	0x76, 0x77, 0x78, 0xf5, 0xa5, 0xa4, 0xa0, 0x89, 0x1f, 0x70, 0x80, 0x24, 0x75, 0x79, 0xf4, 0xdb,
+	0x82, 0xec, 0x9e, 0xe3, 0x52, 0x65, 0x5a, 0x0b, 0x73, 0xeb, 0xe7, 0x86, 0x17, 0x55, 0xa0, 0xa1,
+	0x48, 0x41, 0x6c, 0x2e, 0x61, 0xc1, 0x5d, 0xfd, 0x2d, 0x80, 0x39, 0x75, 0x61, 0x94, 0xcd, 0x3d,
+	0x59, 0xc7, 0x3e, 0xe3, 0xc9, 0xf2, 0x84, 0xe5, 0xd4, 0x11, 0xb9, 0xcc, 0x91, 0x63, 0x57, 0x32,
+	0xf3, 0xae, 0x07, 0xbc, 0x6b, 0xe4, 0xd8, 0x71, 0x29, 0x2a, 0x7b, 0x45, 0x29, 0xaa, 0x51, 0x88,
+	0xd2, 0x66, 0xfa, 0x16, 0xdc, 0x6a, 0xb8, 0xc4, 0x3a, 0x70, 0x1d, 0x7e, 0x34, 0x93, 0x57, 0xda,
+	0x3d, 0xc8, 0x9f, 0x71, 0x22, 0x2f, 0x3b, 0x0e, 0x0a, 0xa9, 0xff, 0x6b, 0x0a, 0xca, 0x9b, 0x94,
+	0xb8, 0xe1, 0xfe, 0x3c, 0xd5, 0x13, 0xd2, 0x20, 0x54, 0xef, 0x9b, 0xf8, 0x46, 0x1f, 0x40, 0x21,
+	0xf6, 0x62, 0xae, 0x2c, 0x17, 0xc5, 0x50, 0x5e, 0x89, 0xe0, 0x36, 0xcd, 0xa6, 0xd1, 0xc1, 0xbe,
+	0xac, 0x12, 0xa1, 0x90, 0xfc, 0x4d, 0xf3, 0xa9, 0x70, 0x5b, 0xc4, 0xa2, 0xe4, 0x70, 0xd4, 0x44,
+	0xff, 0x1f, 0xca, 0x22, 0x91, 0x1e, 0x79, 0x69, 0xb9, 0xab, 0x64, 0x96, 0x04, 0x5c, 0x79, 0x68,
+	0xff, 0x9d, 0x82, 0x9b, 0xdb, 0x64, 0xb6, 0x4b, 0xd5, 0x31, 0xa5, 0x36, 0xa6, 0x16, 0xf3, 0x6d,
+	0x5e, 0x5a, 0x9b, 0x1f, 0xef, 0x4b, 0x4a, 0x6b, 0x8b, 0x98, 0x17, 0x9f, 0xf2, 0x28, 0xa0, 0x4a,
+	0x27, 0x02, 0xaa, 0x9b, 0x90, 0xf3, 0x18, 0xff, 0xfd, 0x82, 0x3c, 0xfb, 0xb2, 0xa1, 0x3b, 0xc9,
+	0xa3, 0x5d, 0x8d, 0xab, 0x5e, 0xa2, 0x66, 0xd5, 0x65, 0x61, 0x3c, 0x1a, 0xfa, 0x0c, 0xaa, 0x83,
+	0x76, 0x13, 0xb7, 0x87, 0x8d, 0xde, 0x4f, 0xcc, 0x81, 0xb1, 0x35, 0x30, 0xee, 0xdd, 0x35, 0xfb,
+	0xbd, 0xad, 0xcf, 0xdf, 0x7b, 0xff, 0xee, 0x07, 0x5a, 0xaa, 0x5a, 0x3b, 0x3e, 0xa9, 0xdd, 0xe9,
+	0x1a, 0xcd, 0x2d, 0x69, 0xcb, 0xbb, 0xec, 0xc9, 0x80, 0xb8, 0x01, 0xb9, 0x77, 0xb7, 0xcf, 0xdc,
+	0x19, 0xc7, 0xe8, 0x27, 0x29, 0x28, 0x27, 0x9f, 0xc7, 0xe4, 0xab, 0x9f, 0xba, 0xf0, 0xd5, 0x9f,
+	0x3b, 0x0f, 0xe9, 0x0b, 0x9c, 0x87, 0x0d, 0xb8, 0x69, 0xf9, 0x2c, 0x08, 0xcc, 0xc0, 0x19, 0x79,
+	0xd4, 0x36, 0x23, 0x99, 0x42, 0xcf, 0xc6, 0x77, 0x4e, 0x9f, 0xad, 0x5f, 0x6f, 0xf2, 0xfe, 0x81,
+	0xe8, 0x56, 0xe2, 0xaf, 0x5b, 0x09, 0x92, 0x18, 0xe9, 0xed, 0x5f, 0x65, 0xa0, 0x18, 0xe7, 0xc2,
+	0xf9, 0x91, 0xe1, 0x89, 0x08, 0xb5, 0x14, 0x31, 0xbd, 0x4b, 0x8f, 0xd0, 0x2b, 0xf3, 0x14, 0xc4,
+	0x67, 0xb2, 0xf8, 0x17, 0x77, 0x47, 0xe9, 0x87, 0xd7, 0xa0, 0x60, 0x0c, 0x06, 0x9d, 0x07, 0xdd,
+	0x76, 0x4b, 0xfb, 0x32, 0x55, 0xfd, 0xce, 0xf1, 0x49, 0xed, 0x7a, 0x0c, 0x32, 0x02, 0x39, 0x53,
+	0x81, 0x6a, 0x36, 0xdb, 0x7d, 0x5e, 0xb7, 0x78, 0x9a, 0x3e, 0x8f, 0x12, 0x21, 0xb5, 0x28, 0xe1,
+	0x17, 0xfb, 0xb8, 0xdd, 0x37, 0x30, 0x1f, 0xf0, 0xcb, 0xb4, 0xcc, 0x8c, 0xcc, 0x47, 0xf4, 0xe9,
+	0x84, 0xf8, 0x7c, 0xcc, 0xb5, 0xe8, 0xa7, 0x2c, 0x4f, 0x33, 0xb2, 0xcc, 0x1b, 0x63, 0xf8, 0x6f,
+	0x43, 0x66, 0x7c, 0x34, 0x51, 0x51, 0x11, 0x62, 0x32, 0xe7, 0x46, 0x1b, 0x70, 0x43, 0xe5, 0x52,
+	0x74, 0x58, 0xc6, 0x3b, 0xdd, 0x2e, 0x07, 0x3d, 0xcd, 0x9e, 0xd3, 0x0e, 0x4f, 0x3d, 0x8f, 0x63,
+	0x5e, 0x87, 0x42, 0x54, 0x70, 0xd1, 0xbe, 0xcc, 0x9e, 0x9b, 0x50, 0x33, 0xaa, 0x16, 0x89, 0x01,
+	0x37, 0x77, 0x86, 0xe2, 0x97, 0x36, 0x4f, 0x73, 0xe7, 0x07, 0xdc, 0x9f, 0x86, 0x36, 0xcf, 0xf9,
+	0xd4, 0xe2, 0x24, 0xcc, 0x97, 0x39, 0x19, 0xb1, 0xc6, 0x18, 0x95, 0x81, 0x79, 0x0d, 0x0a, 0xb8,
+	0xfd, 0x63, 0xf9, 0xa3, 0x9c, 0xa7, 0xf9, 0x73, 0x72, 0x30, 0xfd, 0x82, 0x5a, 0x6a, 0xb4, 0x1e,
+	0xee, 0x6f, 0x1a, 0x62, 0xc9, 0xcf, 0xa3, 0x7a, 0xfe, 0x64, 0x9f, 0x78, 0xd4, 0x9e, 0xd7, 0xba,
+	0xe3, 0xae, 0xb7, 0x7f, 0x1d, 0x0a, 0x91, 0x9f, 0x85, 0xd6, 0x20, 0xff, 0xb8, 0x87, 0x1f, 0xb6,
+	0xb1, 0xb6, 0x24, 0xd7, 0x30, 0xea, 0x79, 0x2c, 0xfd, 0xdd, 0x1a, 0x2c, 0x6f, 0x1b, 0x5d, 0xe3,
+	0x41, 0x1b, 0x47, 0xf9, 0xd1, 0x08, 0xa0, 0xde, 0xbe, 0xaa, 0xa6, 0x06, 0x88, 0x65, 0x36, 0x2a,
+	0x5f, 0x7d, 0xbd, 0xb6, 0xf4, 0xcb, 0xaf, 0xd7, 0x96, 0x9e, 0x9e, 0xae, 0xa5, 0xbe, 0x3a, 0x5d,
+	0x4b, 0xfd, 0xe2, 0x74, 0x2d, 0xf5, 0xcf, 0xa7, 0x6b, 0xa9, 0xdd, 0xbc, 0xb8, 0x31, 0xde, 0xff,
+	0x9f, 0x01, 0x00, 0xdc, 0x68, 0xdc, 0x5e, 0x0b, 0x2b, 0x00, 0x00,
 }
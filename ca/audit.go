@@ -0,0 +1,126 @@
+package ca
+
+import (
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/pkg/errors"
+)
+
+// WatchCertificateAudit streams an audit event for every node certificate issued, as recorded in the nodes'
+// certificate history, and for every node added to the cluster's blacklisted certificates.  Only events that happen
+// after the call are sent.
+func (s *Server) WatchCertificateAudit(request *api.WatchCertificateAuditRequest, stream api.CA_WatchCertificateAuditServer) error {
+	serverCtx, err := s.isRunningLocked()
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	// the latest issued serial of every node, and the CNs already blacklisted, so that only new ones are reported
+	issued := make(map[string]string)
+	var revoked map[string]*api.BlacklistedCertificate
+
+	updates, cancel, err := store.ViewAndWatch(
+		s.store,
+		func(readTx store.ReadTx) error {
+			clusters, err := store.FindClusters(readTx, store.ByName(store.DefaultClusterName))
+			if err != nil {
+				return err
+			}
+			if len(clusters) != 1 {
+				return errors.New("could not find cluster object")
+			}
+			revoked = clusters[0].BlacklistedCertificates
+			nodes, err := store.FindNodes(readTx, store.All)
+			if err != nil {
+				return err
+			}
+			for _, node := range nodes {
+				if issuance := latestIssuance(node); issuance != nil {
+					issued[node.ID] = issuance.SerialNumber
+				}
+			}
+			return nil
+		},
+		api.EventCreateNode{},
+		api.EventUpdateNode{},
+		api.EventUpdateCluster{},
+	)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	log.G(ctx).WithField("method", "(*Server).WatchCertificateAudit").Debug("started watching for certificate audit events")
+
+	for {
+		var events []*api.CertificateAuditEvent
+		select {
+		case event := <-updates:
+			switch v := event.(type) {
+			case api.EventCreateNode:
+				events = issuanceEvents(issued, v.Node)
+			case api.EventUpdateNode:
+				events = issuanceEvents(issued, v.Node)
+			case api.EventUpdateCluster:
+				events = revocationEvents(revoked, v.Cluster.BlacklistedCertificates)
+				revoked = v.Cluster.BlacklistedCertificates
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-serverCtx.Done():
+			return serverCtx.Err()
+		}
+
+		for _, event := range events {
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// latestIssuance returns the most recent certificate issued for the node, or nil if none was recorded.
+func latestIssuance(node *api.Node) *api.CertificateIssuance {
+	if len(node.CertificateHistory) == 0 {
+		return nil
+	}
+	return node.CertificateHistory[len(node.CertificateHistory)-1]
+}
+
+// issuanceEvents returns the audit event for the node's latest certificate, if it wasn't already reported according
+// to issued, which is updated.
+func issuanceEvents(issued map[string]string, node *api.Node) []*api.CertificateAuditEvent {
+	issuance := latestIssuance(node)
+	if issuance == nil || issued[node.ID] == issuance.SerialNumber {
+		return nil
+	}
+	issued[node.ID] = issuance.SerialNumber
+	return []*api.CertificateAuditEvent{{
+		Action:   api.CertificateAuditActionIssued,
+		NodeID:   node.ID,
+		Role:     node.Certificate.Role,
+		Issuance: issuance.Copy(),
+	}}
+}
+
+// revocationEvents returns the audit events for the CNs blacklisted in current but not in previous.
+func revocationEvents(previous, current map[string]*api.BlacklistedCertificate) []*api.CertificateAuditEvent {
+	var events []*api.CertificateAuditEvent
+	for cn, blacklisted := range current {
+		if _, ok := previous[cn]; ok {
+			continue
+		}
+		event := &api.CertificateAuditEvent{
+			Action: api.CertificateAuditActionRevoked,
+			NodeID: cn,
+		}
+		if blacklisted != nil && blacklisted.Expiry != nil {
+			revokedUntil := *blacklisted.Expiry
+			event.RevokedUntil = &revokedUntil
+		}
+		events = append(events, event)
+	}
+	return events
+}
@@ -88,31 +88,42 @@ func certSubjectFromContext(ctx context.Context) (pkix.Name, error) {
 // AuthorizeOrgAndRole takes in a context and a list of roles, and returns
 // the Node ID of the node.
 func AuthorizeOrgAndRole(ctx context.Context, org string, blacklistedCerts map[string]*api.BlacklistedCertificate, ou ...string) (string, error) {
+	nodeID, _, err := authorizeOrgsAndRole(ctx, []string{org}, blacklistedCerts, ou...)
+	return nodeID, err
+}
+
+// authorizeOrgsAndRole takes in a context, a list of allowed organizations and a
+// list of roles, and returns the Node ID and organization of the node.
+func authorizeOrgsAndRole(ctx context.Context, orgs []string, blacklistedCerts map[string]*api.BlacklistedCertificate, ou ...string) (string, string, error) {
 	certSubj, err := certSubjectFromContext(ctx)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	// Check if the current certificate has an OU that authorizes
 	// access to this method
 	if intersectArrays(certSubj.OrganizationalUnit, ou) {
-		return authorizeOrg(certSubj, org, blacklistedCerts)
+		return authorizeOrg(certSubj, orgs, blacklistedCerts)
 	}
 
-	return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: remote certificate not part of OUs: %v", ou)
+	return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: remote certificate not part of OUs: %v", ou)
 }
 
-// authorizeOrg takes in a certificate subject and an organization, and returns
-// the Node ID of the node.
-func authorizeOrg(certSubj pkix.Name, org string, blacklistedCerts map[string]*api.BlacklistedCertificate) (string, error) {
+// authorizeOrg takes in a certificate subject and a list of allowed organizations,
+// and returns the Node ID and organization of the node.
+func authorizeOrg(certSubj pkix.Name, orgs []string, blacklistedCerts map[string]*api.BlacklistedCertificate) (string, string, error) {
 	if _, ok := blacklistedCerts[certSubj.CommonName]; ok {
-		return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: node %s was removed from swarm", certSubj.CommonName)
+		return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: node %s was removed from swarm", certSubj.CommonName)
 	}
 
-	if len(certSubj.Organization) > 0 && certSubj.Organization[0] == org {
-		return certSubj.CommonName, nil
+	if len(certSubj.Organization) > 0 && intersectArrays(certSubj.Organization[:1], orgs) {
+		return certSubj.CommonName, certSubj.Organization[0], nil
 	}
 
-	return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: remote certificate not part of organization: %s", org)
+	if len(certSubj.Organization) > 0 {
+		return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: remote certificate not part of organization: %s, it belongs to organization %s",
+			strings.Join(orgs, ", "), certSubj.Organization[0])
+	}
+	return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: remote certificate not part of organization: %s", strings.Join(orgs, ", "))
 }
 
 // AuthorizeForwardedRoleAndOrg checks for proper roles and organization of caller. The RPC may have
@@ -120,10 +131,18 @@ func authorizeOrg(certSubj pkix.Name, org string, blacklistedCerts map[string]*a
 // so is the certificate information that it forwarded. It returns the node ID
 // of the original client.
 func AuthorizeForwardedRoleAndOrg(ctx context.Context, authorizedRoles, forwarderRoles []string, org string, blacklistedCerts map[string]*api.BlacklistedCertificate) (string, error) {
+	nodeID, _, err := AuthorizeForwardedRoleAndOrgs(ctx, authorizedRoles, forwarderRoles, org, []string{org}, blacklistedCerts)
+	return nodeID, err
+}
+
+// AuthorizeForwardedRoleAndOrgs is like AuthorizeForwardedRoleAndOrg, but the original
+// client may belong to any of the given organizations.  A forwarder must always belong
+// to forwarderOrg.  It returns the node ID and organization of the original client.
+func AuthorizeForwardedRoleAndOrgs(ctx context.Context, authorizedRoles, forwarderRoles []string, forwarderOrg string, orgs []string, blacklistedCerts map[string]*api.BlacklistedCertificate) (string, string, error) {
 	if isForwardedRequest(ctx) {
-		_, err := AuthorizeOrgAndRole(ctx, org, blacklistedCerts, forwarderRoles...)
+		_, err := AuthorizeOrgAndRole(ctx, forwarderOrg, blacklistedCerts, forwarderRoles...)
 		if err != nil {
-			return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: unauthorized forwarder role: %v", err)
+			return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: unauthorized forwarder role: %v", err)
 		}
 
 		// This was a forwarded request. Authorize the forwarder, and
@@ -132,27 +151,27 @@ func AuthorizeForwardedRoleAndOrg(ctx context.Context, authorizedRoles, forwarde
 		_, forwardedID, forwardedOrg, forwardedOUs := forwardedTLSInfoFromContext(ctx)
 
 		if len(forwardedOUs) == 0 || forwardedID == "" || forwardedOrg == "" {
-			return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: missing information in forwarded request")
+			return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: missing information in forwarded request")
 		}
 
 		if !intersectArrays(forwardedOUs, authorizedRoles) {
-			return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: unauthorized forwarded role, expecting: %v", authorizedRoles)
+			return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: unauthorized forwarded role, expecting: %v", authorizedRoles)
 		}
 
-		if forwardedOrg != org {
-			return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: organization mismatch, expecting: %s", org)
+		if !intersectArrays([]string{forwardedOrg}, orgs) {
+			return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: organization mismatch, expecting: %s", strings.Join(orgs, ", "))
 		}
 
-		return forwardedID, nil
+		return forwardedID, forwardedOrg, nil
 	}
 
 	// There wasn't any node being forwarded, check if this is a direct call by the expected role
-	nodeID, err := AuthorizeOrgAndRole(ctx, org, blacklistedCerts, authorizedRoles...)
+	nodeID, nodeOrg, err := authorizeOrgsAndRole(ctx, orgs, blacklistedCerts, authorizedRoles...)
 	if err == nil {
-		return nodeID, nil
+		return nodeID, nodeOrg, nil
 	}
 
-	return "", grpc.Errorf(codes.PermissionDenied, "Permission denied: unauthorized peer role: %v", err)
+	return "", "", grpc.Errorf(codes.PermissionDenied, "Permission denied: unauthorized peer role: %v", err)
 }
 
 // intersectArrays returns true when there is at least one element in common
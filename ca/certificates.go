@@ -9,16 +9,23 @@ import (
 	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/pem"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
+	"net"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	cfcsr "github.com/cloudflare/cfssl/csr"
+	cferr "github.com/cloudflare/cfssl/errors"
 	"github.com/cloudflare/cfssl/helpers"
 	"github.com/cloudflare/cfssl/initca"
 	cflog "github.com/cloudflare/cfssl/log"
@@ -28,6 +35,8 @@ import (
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/connectionbroker"
 	"github.com/docker/swarmkit/ioutils"
+	"github.com/docker/swarmkit/log"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
@@ -56,10 +65,6 @@ const (
 	// root CA private key material encryption key. It can be used for seamless
 	// KEK rotations.
 	PassphraseENVVarPrev = "SWARM_ROOT_CA_PASSPHRASE_PREV"
-	// RootCAExpiration represents the default expiration for the root CA in seconds (20 years)
-	RootCAExpiration = "630720000s"
-	// DefaultNodeCertExpiration represents the default expiration for node certificates (3 months)
-	DefaultNodeCertExpiration = 2160 * time.Hour
 	// CertBackdate represents the amount of time each certificate is backdated to try to avoid
 	// clock drift issues.
 	CertBackdate = 1 * time.Hour
@@ -73,6 +78,19 @@ const (
 	MinNodeCertExpiration = 1 * time.Hour
 )
 
+// These defaults are variables so that programs embedding swarmkit can override them at startup, before any root CA
+// is created or certificate is issued.
+var (
+	// RootCAExpiration represents the default expiration for the root CA in seconds (20 years)
+	RootCAExpiration = "630720000s"
+	// DefaultNodeCertExpiration represents the default expiration for node certificates (3 months).  Node
+	// certificates are never issued for less than MinNodeCertExpiration, even if this is set lower.
+	DefaultNodeCertExpiration = 2160 * time.Hour
+	// MaxNodeCertExpiration, if not zero, is a hard cap on the validity of node certificates.  Longer configured
+	// expirations are clamped to it, and externally signed certificates that are valid for longer are rejected.
+	MaxNodeCertExpiration time.Duration
+)
+
 // BasicConstraintsOID is the ASN1 Object ID indicating a basic constraints extension
 var BasicConstraintsOID = asn1.ObjectIdentifier{2, 5, 29, 19}
 
@@ -86,6 +104,86 @@ func (r recoverableErr) Error() string {
 	return r.err.Error()
 }
 
+// A CertificateRequestError is returned by RequestAndSaveNewCertificates when
+// a certificate could not be obtained.  Transient errors, such as the manager
+// being unreachable, may succeed if the request is retried later.  Terminal
+// errors, such as a rejected join token, will not.
+type CertificateRequestError struct {
+	Transient bool
+	Err       error
+}
+
+func (c CertificateRequestError) Error() string {
+	return c.Err.Error()
+}
+
+// Cause returns the underlying error, so that errors.Cause reaches it.
+func (c CertificateRequestError) Cause() error {
+	return c.Err
+}
+
+// IsTransientError returns true if the error was caused by a failure that may
+// go away if the certificate request is retried.
+func IsTransientError(err error) bool {
+	c, ok := certificateRequestError(err)
+	return ok && c.Transient
+}
+
+// IsTerminalError returns true if the error was caused by a failure that will
+// not go away if the certificate request is retried.
+func IsTerminalError(err error) bool {
+	c, ok := certificateRequestError(err)
+	return ok && !c.Transient
+}
+
+// certificateRequestError returns the CertificateRequestError in err's chain
+// of causes, if there is one.
+func certificateRequestError(err error) (CertificateRequestError, bool) {
+	for err != nil {
+		if c, ok := err.(CertificateRequestError); ok {
+			return c, true
+		}
+		causer, ok := err.(interface {
+			Cause() error
+		})
+		if !ok {
+			break
+		}
+		err = causer.Cause()
+	}
+	return CertificateRequestError{}, false
+}
+
+// connectionError is returned when no connection to a manager could be
+// established.
+type connectionError struct {
+	err error
+}
+
+func (c connectionError) Error() string {
+	return c.err.Error()
+}
+
+// newCertificateRequestError categorizes an error received while requesting
+// a certificate from a remote CA.  Only failures to reach a manager, including
+// codes.Unavailable and codes.DeadlineExceeded, are transient; everything
+// else, such as a rejection of the request or a local failure, is terminal.
+func newCertificateRequestError(err error) CertificateRequestError {
+	cause := errors.Cause(err)
+	switch cause.(type) {
+	case connectionError, net.Error:
+		return CertificateRequestError{Transient: true, Err: err}
+	}
+	if cause == context.DeadlineExceeded || cause == grpc.ErrClientConnTimeout || cause == grpc.ErrClientConnClosing {
+		return CertificateRequestError{Transient: true, Err: err}
+	}
+	switch grpc.Code(cause) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return CertificateRequestError{Transient: true, Err: err}
+	}
+	return CertificateRequestError{Err: err}
+}
+
 // ErrNoLocalRootCA is an error type used to indicate that the local root CA
 // certificate file does not exist.
 var ErrNoLocalRootCA = errors.New("local root CA certificate does not exist")
@@ -102,6 +200,24 @@ func init() {
 // Cert and corresponding Key
 type CertPaths struct {
 	Cert, Key string
+
+	// Chain is optional.  If set, the CA chain that accompanies the leaf
+	// certificate in Cert (everything but the leaf) is also written to this
+	// path whenever the certificate is written, for consumers that want the
+	// leaf and its chain in separate files.
+	Chain string
+
+	// Combined makes a KeyReadWriter store the key followed by the
+	// certificate chain in a single PEM file at Key, with the key's
+	// permissions, for tools that expect them together.  Cert is then
+	// unused.
+	Combined bool
+
+	// Intermediates is optional, and only used for root CA paths.  If set,
+	// the root CA's intermediates are saved to and loaded from this path,
+	// so that a reloaded root CA appends the same chain to the
+	// certificates it issues.
+	Intermediates string
 }
 
 // LocalSigner is a signer that can sign CSRs
@@ -178,44 +294,91 @@ type RootCA struct {
 	// Digest of the serialized bytes of the certificate(s)
 	Digest digest.Digest
 
+	// IssuancePolicy checks and adjusts the node certificates issued by ParseValidateAndSignCSR.  If it is nil,
+	// DefaultIssuancePolicy is used.
+	IssuancePolicy IssuancePolicy
+
+	// NodeURIFormat, if set, is the fmt format of a URI subject alternative name added to the node certificates
+	// issued locally, such as a SPIFFE ID.  It is given the organization, the role and the CN, in this order, so for
+	// example SPIFFENodeURIFormat gives spiffe://<cluster ID>/node/<node ID>.
+	NodeURIFormat string
+
 	// This signer will be nil if the node doesn't have the appropriate key material
 	signer *LocalSigner
+
+	// If the key material comes from a KeyProvider, the signer is created the first
+	// time it is needed instead
+	lazySigner *lazySigner
 }
 
 // Signer is an accessor for the local signer that returns an error if this root cannot sign.
 func (rca *RootCA) Signer() (*LocalSigner, error) {
-	if rca.Pool == nil || rca.signer == nil || len(rca.signer.Cert) == 0 || rca.signer.Signer == nil {
+	signer := rca.signer
+	if signer == nil && rca.lazySigner != nil {
+		var err error
+		if signer, err = rca.lazySigner.get(); err != nil {
+			return nil, err
+		}
+	}
+	if rca.Pool == nil || signer == nil || len(signer.Cert) == 0 || signer.Signer == nil {
 		return nil, ErrNoValidSigner
 	}
 
-	return rca.signer, nil
+	return signer, nil
+}
+
+// IntermediateCerts returns the parsed intermediate chain the root CA was built with, in the order it is appended to
+// issued certificates, or nothing if there is none.  The PEM encoded chain is in Intermediates.
+func (rca *RootCA) IntermediateCerts() ([]*x509.Certificate, error) {
+	if len(rca.Intermediates) == 0 {
+		return nil, nil
+	}
+	certs, err := helpers.ParseCertificatesPEM(rca.Intermediates)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid intermediate chain")
+	}
+	return certs, nil
 }
 
 // IssueAndSaveNewCertificates generates a new key-pair, signs it with the local root-ca, and returns a
-// tls certificate
-func (rca *RootCA) IssueAndSaveNewCertificates(kw KeyWriter, cn, ou, org string) (*tls.Certificate, error) {
+// tls certificate.  Any additional OUs are added to the certificate alongside ou, which remains the node's role.
+func (rca *RootCA) IssueAndSaveNewCertificates(kw KeyWriter, cn, ou, org string, additionalOUs ...string) (*tls.Certificate, error) {
+	tlsKeyPair, _, err := rca.IssueAndSaveNewCertificatesWithLeaf(kw, cn, ou, org, additionalOUs...)
+	return tlsKeyPair, err
+}
+
+// IssueAndSaveNewCertificatesWithLeaf behaves like IssueAndSaveNewCertificates, but also returns the parsed
+// leaf certificate so callers can inspect its subject, SANs and expiry without parsing it again.  The leaf
+// is also set as the Leaf of the returned tls certificate.
+func (rca *RootCA) IssueAndSaveNewCertificatesWithLeaf(kw KeyWriter, cn, ou, org string, additionalOUs ...string) (*tls.Certificate, *x509.Certificate, error) {
 	csr, key, err := GenerateNewCSR()
 	if err != nil {
-		return nil, errors.Wrap(err, "error when generating new node certs")
+		return nil, nil, errors.Wrap(err, "error when generating new node certs")
 	}
 
 	// Obtain a signed Certificate
-	certChain, err := rca.ParseValidateAndSignCSR(csr, cn, ou, org)
+	certChain, err := rca.ParseValidateAndSignCSR(csr, cn, ou, org, additionalOUs...)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to sign node certificate")
+		return nil, nil, errors.Wrap(err, "failed to sign node certificate")
 	}
 
 	// Create a valid TLSKeyPair out of the PEM encoded private key and certificate
 	tlsKeyPair, err := tls.X509KeyPair(certChain, key)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	leaf, err := x509.ParseCertificate(tlsKeyPair.Certificate[0])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse issued node certificate")
 	}
+	tlsKeyPair.Leaf = leaf
 
 	if err := kw.Write(certChain, key, nil); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &tlsKeyPair, nil
+	return &tlsKeyPair, leaf, nil
 }
 
 // RequestAndSaveNewCertificates gets new certificates issued, either by signing them locally if a signer is
@@ -224,7 +387,7 @@ func (rca *RootCA) RequestAndSaveNewCertificates(ctx context.Context, kw KeyWrit
 	// Create a new key/pair and CSR
 	csr, key, err := GenerateNewCSR()
 	if err != nil {
-		return nil, errors.Wrap(err, "error when generating new node certs")
+		return nil, CertificateRequestError{Err: errors.Wrap(err, "error when generating new node certs")}
 	}
 
 	// Get the remote manager to issue a CA signed certificate for this node
@@ -236,6 +399,11 @@ func (rca *RootCA) RequestAndSaveNewCertificates(ctx context.Context, kw KeyWrit
 		if err == nil {
 			break
 		}
+		// Retrying won't help if the request itself was rejected, or if
+		// the caller has given up
+		if IsTerminalError(newCertificateRequestError(err)) || ctx.Err() != nil {
+			break
+		}
 
 		// If the first attempt fails, we should try a remote
 		// connection. The local node may be a manager that was
@@ -247,7 +415,7 @@ func (rca *RootCA) RequestAndSaveNewCertificates(ctx context.Context, kw KeyWrit
 
 	}
 	if err != nil {
-		return nil, err
+		return nil, newCertificateRequestError(err)
 	}
 
 	// Доверяй, но проверяй.
@@ -256,29 +424,29 @@ func (rca *RootCA) RequestAndSaveNewCertificates(ctx context.Context, kw KeyWrit
 	// Check to see if this certificate was signed by our CA, and isn't expired
 	parsedCerts, err := ValidateCertChain(rca.Pool, signedCert, false)
 	if err != nil {
-		return nil, err
+		return nil, CertificateRequestError{Err: err}
 	}
 
 	// Create a valid TLSKeyPair out of the PEM encoded private key and certificate
 	tlsKeyPair, err := tls.X509KeyPair(signedCert, key)
 	if err != nil {
-		return nil, err
+		return nil, CertificateRequestError{Err: errors.Wrap(err, "issued certificate does not match the key")}
 	}
 
 	var kekUpdate *KEKData
 	for i := 0; i < 5; i++ {
 		// ValidateCertChain will always return at least 1 cert, so indexing at 0 is safe
 		kekUpdate, err = rca.getKEKUpdate(ctx, parsedCerts[0], tlsKeyPair, config.ConnBroker)
-		if err == nil {
+		if err == nil || ctx.Err() != nil {
 			break
 		}
 	}
 	if err != nil {
-		return nil, err
+		return nil, newCertificateRequestError(err)
 	}
 
 	if err := kw.Write(signedCert, key, kekUpdate); err != nil {
-		return nil, err
+		return nil, CertificateRequestError{Err: errors.Wrap(err, "could not save the issued certificate")}
 	}
 
 	return &tlsKeyPair, nil
@@ -297,7 +465,7 @@ func (rca *RootCA) getKEKUpdate(ctx context.Context, cert *x509.Certificate, key
 		mtlsCreds := credentials.NewTLS(&tls.Config{ServerName: CARole, RootCAs: rca.Pool, Certificates: []tls.Certificate{keypair}})
 		conn, err := getGRPCConnection(mtlsCreds, connBroker, false)
 		if err != nil {
-			return nil, err
+			return nil, connectionError{err: err}
 		}
 
 		client := api.NewCAClient(conn.ClientConn)
@@ -324,39 +492,221 @@ func (rca *RootCA) getKEKUpdate(ctx context.Context, cert *x509.Certificate, key
 
 // PrepareCSR creates a CFSSL Sign Request based on the given raw CSR and
 // overrides the Subject and Hosts with the given extra args.
-func PrepareCSR(csrBytes []byte, cn, ou, org string) cfsigner.SignRequest {
-	// All managers get added the subject-alt-name of CA, so they can be
-	// used for cert issuance.
-	hosts := []string{ou, cn}
-	if ou == ManagerRole {
-		hosts = append(hosts, CARole)
+func PrepareCSR(csrBytes []byte, cn, ou, org string, additionalOUs ...string) cfsigner.SignRequest {
+	return newIssuanceRequest(csrBytes, cn, ou, org, additionalOUs...).signRequest()
+}
+
+// ParseValidateAndSignCSR returns a signed certificate from a particular rootCA and a CSR.  Any additional OUs
+// are added to the certificate alongside ou, which remains the node's role; they may not be role names themselves.
+// The root CA's IssuancePolicy is applied to the request first, and may change or refuse it.
+func (rca *RootCA) ParseValidateAndSignCSR(csrBytes []byte, cn, ou, org string, additionalOUs ...string) ([]byte, error) {
+	req := newIssuanceRequest(csrBytes, cn, ou, org, additionalOUs...)
+	if err := rca.addNodeURI(req); err != nil {
+		return nil, err
+	}
+	if err := rca.applyIssuancePolicy(req); err != nil {
+		return nil, err
+	}
+	return rca.signIssuanceRequest(req)
+}
+
+// ParseValidateAndSignCSRWithValidity is like ParseValidateAndSignCSR, but the certificate is valid from exactly
+// notBefore until exactly notAfter, rather than for the configured expiry from slightly before now.  This is meant for
+// migrations, where certificates have to match ones issued by another system.  The window must be within the validity
+// of the signing CA certificate, its intermediates and a root it chains up to.  If MaxNodeCertExpiration is set, a
+// longer window is shortened to end that long after notBefore.  The root CA's IssuancePolicy is applied to the
+// request first, but any expiry it sets is ignored in favor of the window.
+func (rca *RootCA) ParseValidateAndSignCSRWithValidity(csrBytes []byte, notBefore, notAfter time.Time, cn, ou, org string, additionalOUs ...string) ([]byte, error) {
+	req := newIssuanceRequest(csrBytes, cn, ou, org, additionalOUs...)
+	if err := rca.addNodeURI(req); err != nil {
+		return nil, err
+	}
+	if err := rca.applyIssuancePolicy(req); err != nil {
+		return nil, err
+	}
+	if !notBefore.Before(notAfter) {
+		return nil, errors.Errorf("certificate validity start %s is not before its end %s",
+			notBefore.UTC().Format(time.RFC1123), notAfter.UTC().Format(time.RFC1123))
+	}
+	if MaxNodeCertExpiration > 0 && notAfter.Sub(notBefore) > MaxNodeCertExpiration {
+		log.L.Warnf("certificate validity of %v is longer than the maximum of %v, using the maximum instead", notAfter.Sub(notBefore), MaxNodeCertExpiration)
+		notAfter = notBefore.Add(MaxNodeCertExpiration)
+	}
+
+	signer, err := rca.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.parsedCert == nil || signer.cryptoSigner == nil {
+		return nil, ErrNoValidSigner
+	}
+	verifyOpts := x509.VerifyOptions{Roots: rca.Pool}
+	if len(rca.Intermediates) > 0 {
+		intermediates, err := helpers.ParseCertificatesPEM(rca.Intermediates)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid intermediates")
+		}
+		verifyOpts.Intermediates = x509.NewCertPool()
+		for _, cert := range intermediates {
+			verifyOpts.Intermediates.AddCert(cert)
+		}
+	}
+	// Each certificate in a chain is valid for a single span of time, so a chain that is valid at both ends of the
+	// window is valid for all of it.
+	for _, t := range []time.Time{notBefore, notAfter} {
+		verifyOpts.CurrentTime = t
+		if _, err := signer.parsedCert.Verify(verifyOpts); err != nil {
+			return nil, errors.Wrapf(err, "the signing CA certificate does not chain up to a root at %s, so it can't issue certificates valid then",
+				t.UTC().Format(time.RFC1123))
+		}
 	}
 
-	return cfsigner.SignRequest{
-		Request: string(csrBytes),
-		// OU is used for Authentication of the node type. The CN has the random
-		// node ID.
-		Subject: &cfsigner.Subject{CN: cn, Names: []cfcsr.Name{{OU: ou, O: org}}},
-		// Adding ou as DNS alt name, so clients can connect to ManagerRole and CARole
-		Hosts: hosts,
+	policy := *signer.Policy()
+	profile := *policy.Default
+	profile.NotBefore = notBefore
+	profile.NotAfter = notAfter
+	policy.Default = &profile
+	windowSigner, err := local.NewSigner(signer.cryptoSigner, signer.parsedCert, cfsigner.DefaultSigAlgo(signer.cryptoSigner), &policy)
+	if err != nil {
+		return nil, err
 	}
+	cert, err := rca.signCSRWith(windowSigner, req.signRequest(), req.CN, req.OU, req.Org, req.AdditionalOUs...)
+	if err != nil {
+		return nil, err
+	}
+	return rca.addURIs(cert, req.URIs)
 }
 
-// ParseValidateAndSignCSR returns a signed certificate from a particular rootCA and a CSR.
-func (rca *RootCA) ParseValidateAndSignCSR(csrBytes []byte, cn, ou, org string) ([]byte, error) {
-	signRequest := PrepareCSR(csrBytes, cn, ou, org)
+// signCSR signs a request prepared by PrepareCSR, which may have had extra hosts added to it, and checks that the
+// issued certificate has exactly the requested subject.
+func (rca *RootCA) signCSR(signRequest cfsigner.SignRequest, cn, ou, org string, additionalOUs ...string) ([]byte, error) {
 	signer, err := rca.Signer()
 	if err != nil {
 		return nil, err
 	}
+	return rca.signCSRWith(signer, signRequest, cn, ou, org, additionalOUs...)
+}
+
+// signCSRWith is like signCSR, but signs with the given signer rather than the root CA's.
+func (rca *RootCA) signCSRWith(signer cfsigner.Signer, signRequest cfsigner.SignRequest, cn, ou, org string, additionalOUs ...string) ([]byte, error) {
 	cert, err := signer.Sign(signRequest)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign node certificate")
 	}
+	if err := checkIssuedSubject(cert, cn, ou, org, additionalOUs...); err != nil {
+		return nil, err
+	}
+	if err := checkValidityCap(cert); err != nil {
+		return nil, err
+	}
 
 	return append(cert, rca.Intermediates...), nil
 }
 
+// checkValidityCap ensures that the leaf certificate in certChain is not valid for longer than MaxNodeCertExpiration
+// from when it was issued, if a maximum is set.  Certificates are backdated by CertBackdate, which is allowed for.
+func checkValidityCap(certChain []byte) error {
+	if MaxNodeCertExpiration <= 0 {
+		return nil
+	}
+	certs, err := helpers.ParseCertificatesPEM(certChain)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse issued certificate")
+	}
+	if len(certs) == 0 {
+		return errors.New("no certificate was issued")
+	}
+	if validity := certs[0].NotAfter.Sub(certs[0].NotBefore); validity > MaxNodeCertExpiration+CertBackdate {
+		return errors.Errorf("issued certificate is valid for %v, longer than the maximum of %v", validity-CertBackdate, MaxNodeCertExpiration)
+	}
+	return nil
+}
+
+// checkAdditionalOUs makes sure that OUs added to a certificate besides its role can't be mistaken for a role, and
+// are not repeated.
+func checkAdditionalOUs(ou string, additionalOUs []string) error {
+	seen := map[string]struct{}{ou: {}}
+	for _, additionalOU := range additionalOUs {
+		switch additionalOU {
+		case "":
+			return errors.New("additional OUs can't be empty")
+		case ManagerRole, WorkerRole, CARole, ServiceRole:
+			return errors.Errorf("additional OU %q can't be a role", additionalOU)
+		}
+		if _, ok := seen[additionalOU]; ok {
+			return errors.Errorf("OU %q is repeated", additionalOU)
+		}
+		seen[additionalOU] = struct{}{}
+	}
+	return nil
+}
+
+// PreviewSignCSR runs the same validation and signing as ParseValidateAndSignCSR and returns the certificate
+// that the CSR would be issued, parsed, along with its PEM encoded chain.  Nothing is persisted, so this can be
+// used for dry runs, for instance to show which certificates a rotation would produce.
+func (rca *RootCA) PreviewSignCSR(csrBytes []byte, cn, ou, org string, additionalOUs ...string) (*x509.Certificate, []byte, error) {
+	certChain, err := rca.ParseValidateAndSignCSR(csrBytes, cn, ou, org, additionalOUs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	certs, err := helpers.ParseCertificatesPEM(certChain)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to parse previewed certificate")
+	}
+	return certs[0], certChain, nil
+}
+
+// checkIssuedSubject ensures that the leaf certificate in certChain has exactly the CN, OUs and O that were requested,
+// and no others, so that parsing the role out of the certificate is never ambiguous.  An empty org means that the
+// certificate should have no O at all.
+func checkIssuedSubject(certChain []byte, cn, ou, org string, additionalOUs ...string) error {
+	certs, err := helpers.ParseCertificatesPEM(certChain)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse issued certificate")
+	}
+	if len(certs) == 0 {
+		return errors.New("no certificate was issued")
+	}
+
+	var expectedOrg []string
+	if org != "" {
+		expectedOrg = []string{org}
+	}
+
+	expectedOUs := append([]string{ou}, additionalOUs...)
+	issuedOUs := append([]string{}, certs[0].Subject.OrganizationalUnit...)
+	sort.Strings(expectedOUs)
+	sort.Strings(issuedOUs)
+
+	subject := certs[0].Subject
+	if subject.CommonName != cn || !reflect.DeepEqual(issuedOUs, expectedOUs) ||
+		!reflect.DeepEqual(subject.Organization, expectedOrg) {
+		return errors.Errorf("issued certificate subject %q does not match the requested CN %q, OU %q and O %q",
+			subject.String(), cn, strings.Join(expectedOUs, ","), org)
+	}
+	return nil
+}
+
+// SignCSRFile reads a PEM-encoded CSR from csrPath, signs it using the same subject overrides and
+// validation as ParseValidateAndSignCSR, and writes the resulting certificate chain to outCertPath.
+// This allows certificates to be issued offline, without the node ever talking to a manager.
+func SignCSRFile(rootCA RootCA, csrPath, outCertPath, cn, ou, org string) error {
+	csr, err := ioutil.ReadFile(csrPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to read CSR")
+	}
+
+	certChain, err := rootCA.ParseValidateAndSignCSR(csr, cn, ou, org)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outCertPath), 0755); err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(outCertPath, certChain, certPerms)
+}
+
 // CrossSignCACertificate takes a CA root certificate and generates an intermediate CA from it signed with the current root signer
 func (rca *RootCA) CrossSignCACertificate(otherCAPEM []byte) ([]byte, error) {
 	signer, err := rca.Signer()
@@ -385,6 +735,139 @@ func (rca *RootCA) CrossSignCACertificate(otherCAPEM []byte) ([]byte, error) {
 	}), nil
 }
 
+// ReSignLeaf issues a copy of an existing leaf certificate, which may be followed by its intermediates, with a fresh
+// serial number and a validity window starting now, so that leaves issued by another root CA can be migrated to this
+// one without the nodes sending new CSRs.  The subject, public key, subject alternative names and key usages are kept.
+// A validity of zero means the signer's configured expiry, and a validity longer than MaxNodeCertExpiration, if that
+// is set, is shortened to it.  The leaf's signature isn't checked, and it may have
+// expired, so callers must only re-sign leaves they trust.  CA certificates are refused.
+func (rca *RootCA) ReSignLeaf(leafPEM []byte, validity time.Duration) ([]byte, error) {
+	signer, err := rca.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.parsedCert == nil || signer.cryptoSigner == nil {
+		return nil, ErrNoValidSigner
+	}
+
+	certs, err := helpers.ParseCertificatesPEM(leafPEM)
+	if err != nil || len(certs) == 0 {
+		return nil, errors.New("could not parse leaf certificate")
+	}
+	leaf := certs[0]
+	if leaf.IsCA {
+		return nil, errors.New("certificate is a CA, not a leaf")
+	}
+	if err := MinimumKeyStrength.check(leaf.PublicKey); err != nil {
+		return nil, errors.Wrap(err, "leaf key does not satisfy the key strength policy")
+	}
+	if err := checkKeyBlocklist(leaf.PublicKey); err != nil {
+		return nil, errors.Wrap(err, "leaf key rejected")
+	}
+
+	if validity <= 0 {
+		validity = signer.Policy().Default.Expiry
+	}
+	if MaxNodeCertExpiration > 0 && validity > MaxNodeCertExpiration {
+		log.L.Warnf("certificate validity of %v is longer than the maximum of %v, using the maximum instead", validity, MaxNodeCertExpiration)
+		validity = MaxNodeCertExpiration
+	}
+
+	serial, err := cryptorand.Int(cryptorand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate a serial number")
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               leaf.Subject,
+		NotBefore:             now.Add(-CertBackdate),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              leaf.KeyUsage,
+		ExtKeyUsage:           leaf.ExtKeyUsage,
+		BasicConstraintsValid: true,
+		DNSNames:              leaf.DNSNames,
+		EmailAddresses:        leaf.EmailAddresses,
+		IPAddresses:           leaf.IPAddresses,
+		URIs:                  leaf.URIs,
+	}
+
+	derBytes, err := x509.CreateCertificate(cryptorand.Reader, template, signer.parsedCert, leaf.PublicKey, signer.cryptoSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not re-sign leaf certificate")
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: derBytes,
+	})
+	return append(cert, rca.Intermediates...), nil
+}
+
+// IssueIntermediate signs the given PEM encoded CSR as a subordinate CA certificate, so that a manager can run as an
+// intermediate of this root CA.  The intermediate can only sign leaf certificates: its path length is always
+// constrained to zero, whatever the CSR requests.  If any permitted DNS domains are given, the intermediate is also
+// name constrained to them.  It is valid for as long as the signing CA certificate, and is returned followed by this
+// root CA's intermediates, like an issued leaf certificate.
+func (rca *RootCA) IssueIntermediate(csrBytes []byte, permittedDNSDomains ...string) ([]byte, error) {
+	signer, err := rca.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.parsedCert.MaxPathLenZero {
+		return nil, errors.New("the signing CA certificate is not allowed to issue intermediate CAs")
+	}
+
+	block, _ := pem.Decode(csrBytes)
+	if block == nil {
+		return nil, errors.New("invalid PEM-encoded CSR")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse CSR")
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, errors.Wrap(err, "invalid CSR signature")
+	}
+	if err := MinimumKeyStrength.check(csr.PublicKey); err != nil {
+		return nil, errors.Wrap(err, "CSR key does not satisfy the key strength policy")
+	}
+	if err := checkKeyBlocklist(csr.PublicKey); err != nil {
+		return nil, errors.Wrap(err, "CSR key rejected")
+	}
+
+	serial, err := cryptorand.Int(cryptorand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate a serial number")
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               csr.Subject,
+		NotBefore:             time.Now().Add(-CertBackdate),
+		NotAfter:              signer.parsedCert.NotAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            0,
+		MaxPathLenZero:        true,
+		PermittedDNSDomains:   permittedDNSDomains,
+	}
+	if len(permittedDNSDomains) > 0 {
+		template.PermittedDNSDomainsCritical = true
+	}
+
+	derBytes, err := x509.CreateCertificate(cryptorand.Reader, template, signer.parsedCert, csr.PublicKey, signer.cryptoSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign intermediate CA certificate")
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: derBytes,
+	})
+	return append(cert, rca.Intermediates...), nil
+}
+
 func validateSignatureAlgorithm(cert *x509.Certificate) error {
 	switch cert.SignatureAlgorithm {
 	case x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA, x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512:
@@ -394,10 +877,42 @@ func validateSignatureAlgorithm(cert *x509.Certificate) error {
 	}
 }
 
+// RootCAOptions controls optional behavior of NewRootCAWithOptions.
+type RootCAOptions struct {
+	// SkipKeyReencryption, if set, keeps the signing key exactly as it was passed
+	// in.  By default, an unencrypted signing key is encrypted with the passphrase
+	// in PassphraseENVVar, if one is set, so it doesn't hit raft in plain-text.
+	SkipKeyReencryption bool
+	// Passphrases, if not nil, are used for the signing key instead of the
+	// passphrases in PassphraseENVVar and PassphraseENVVarPrev.  The first one is
+	// the current passphrase, which an unencrypted key is encrypted with, and the
+	// rest are tried in order if the key can't be decrypted with it.
+	Passphrases [][]byte
+	// SignerVerificationRoots, if set, are PEM encoded root certificates that the signing CA certificate may chain up
+	// to in addition to the root CA bundle, for instance while cross-signing.  They are only used to validate the
+	// signer, and are neither advertised in Certs nor added to Pool.
+	SignerVerificationRoots []byte
+}
+
 // NewRootCA creates a new RootCA object from unparsed PEM cert bundle and key byte
 // slices. key may be nil, and in this case NewRootCA will return a RootCA
 // without a signer.
 func NewRootCA(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry time.Duration, intermediates []byte) (RootCA, error) {
+	return NewRootCAWithOptions(rootCertBytes, signCertBytes, signKeyBytes, certExpiry, intermediates, RootCAOptions{})
+}
+
+// NewRootCAWithPassphrase is like NewRootCA, but uses the given passphrases for the signing key instead of the ones
+// in the environment, so that several root CAs with different passphrases can be used in the same process.  The
+// previous passphrases are tried in order if the key can't be decrypted with the current one.
+func NewRootCAWithPassphrase(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry time.Duration, intermediates, passphrase []byte, prevPassphrases ...[]byte) (RootCA, error) {
+	return NewRootCAWithOptions(rootCertBytes, signCertBytes, signKeyBytes, certExpiry, intermediates, RootCAOptions{
+		Passphrases: append([][]byte{passphrase}, prevPassphrases...),
+	})
+}
+
+// NewRootCAWithOptions is like NewRootCA, but allows the default behavior to be changed
+// with the given options.
+func NewRootCAWithOptions(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry time.Duration, intermediates []byte, opts RootCAOptions) (RootCA, error) {
 	// Parse all the certificates in the cert bundle
 	parsedCerts, err := helpers.ParseCertificatesPEM(rootCertBytes)
 	if err != nil {
@@ -410,6 +925,10 @@ func NewRootCA(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry tim
 
 	// Create a Pool with all of the certificates found
 	pool := x509.NewCertPool()
+	var (
+		uniqueCerts []*x509.Certificate
+		collisions  [][2]*x509.Certificate
+	)
 	for _, cert := range parsedCerts {
 		if err := validateSignatureAlgorithm(cert); err != nil {
 			return RootCA{}, err
@@ -420,30 +939,91 @@ func NewRootCA(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry tim
 		if _, err := cert.Verify(x509.VerifyOptions{Roots: selfpool}); err != nil {
 			return RootCA{}, errors.Wrap(err, "error while validating Root CA Certificate")
 		}
+
+		// Exact duplicates are dropped, but two different roots with the same subject and different keys make it
+		// ambiguous which root a chain should be built to, so they are rejected unless the intermediates link them.
+		duplicate := false
+		for _, seen := range uniqueCerts {
+			if bytes.Equal(seen.Raw, cert.Raw) {
+				duplicate = true
+				break
+			}
+			if bytes.Equal(seen.RawSubject, cert.RawSubject) &&
+				!bytes.Equal(seen.RawSubjectPublicKeyInfo, cert.RawSubjectPublicKeyInfo) {
+				collisions = append(collisions, [2]*x509.Certificate{seen, cert})
+			}
+		}
+		if duplicate {
+			continue
+		}
+		uniqueCerts = append(uniqueCerts, cert)
 		pool.AddCert(cert)
 	}
 
+	// Roots with the same subject are expected while rotating between two roots created with the same CN, in which
+	// case one of them is cross-signed by the other in the intermediates.
+	if len(collisions) > 0 {
+		crossSigned, _ := helpers.ParseCertificatesPEM(intermediates)
+		for _, pair := range collisions {
+			if !crossSignedPair(pair[0], pair[1], crossSigned) {
+				return RootCA{}, errors.Errorf(
+					"invalid root certificates - multiple roots with subject %q have different public keys", pair[1].Subject.String())
+			}
+		}
+	}
+
+	// If there were any duplicates, rewrite the bundle so that each root only appears once
+	if len(uniqueCerts) != len(parsedCerts) {
+		var deduped []byte
+		for _, cert := range uniqueCerts {
+			deduped = append(deduped, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+		}
+		rootCertBytes = deduped
+	}
+
 	// Calculate the digest for our Root CA bundle
 	digest := digest.FromBytes(rootCertBytes)
 
 	// The intermediates supplied must be able to chain up to the root certificates, so that when they are appended to
 	// a leaf certificate, the leaf certificate can be validated through the intermediates to the root certificates.
-	var intermediatePool *x509.CertPool
 	var parsedIntermediates []*x509.Certificate
 	if len(intermediates) > 0 {
 		parsedIntermediates, err = ValidateCertChain(pool, intermediates, false)
 		if err != nil {
+			if _, ok := errors.Cause(err).(x509.UnknownAuthorityError); ok {
+				// the intermediates form a chain, but its end isn't signed by any of the roots
+				if certs, parseErr := helpers.ParseCertificatesPEM(intermediates); parseErr == nil && len(certs) > 0 {
+					last := certs[len(certs)-1]
+					return RootCA{}, errors.Wrapf(err, "invalid intermediate chain - the chain breaks between the last intermediate (%d - %s) and the root certificates",
+						len(certs), last.Subject.CommonName)
+				}
+			}
 			return RootCA{}, errors.Wrap(err, "invalid intermediate chain")
 		}
-		intermediatePool = x509.NewCertPool()
-		for _, cert := range parsedIntermediates {
-			intermediatePool.AddCert(cert)
+		// Go doesn't check that the certificates in a chain are allowed to sign certificates, but other TLS
+		// implementations do, so a leaf issued through such an intermediate would not be usable everywhere.
+		for i, cert := range parsedIntermediates {
+			if !cert.BasicConstraintsValid || !cert.IsCA {
+				return RootCA{}, errors.Errorf("invalid intermediate chain - intermediate (%d - %s) is not a CA certificate",
+					i+1, cert.Subject.CommonName)
+			}
+			if cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+				return RootCA{}, errors.Errorf("invalid intermediate chain - intermediate (%d - %s) does not have the certificate signing key usage",
+					i+1, cert.Subject.CommonName)
+			}
 		}
 	}
 
 	var localSigner *LocalSigner
 	if len(signKeyBytes) != 0 || len(signCertBytes) != 0 {
-		localSigner, err = newLocalSigner(signKeyBytes, signCertBytes, certExpiry, pool, intermediatePool)
+		signerPool := pool
+		if len(opts.SignerVerificationRoots) > 0 {
+			signerPool, err = signerVerificationPool(uniqueCerts, opts.SignerVerificationRoots)
+			if err != nil {
+				return RootCA{}, err
+			}
+		}
+		localSigner, err = newLocalSigner(signKeyBytes, signCertBytes, certExpiry, signerPool, parsedIntermediates, opts)
 		if err != nil {
 			return RootCA{}, err
 		}
@@ -462,41 +1042,336 @@ func NewRootCA(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry tim
 	return RootCA{signer: localSigner, Intermediates: intermediates, Digest: digest, Certs: rootCertBytes, Pool: pool}, nil
 }
 
-// ValidateCertChain checks checks that the certificates provided chain up to the root pool provided.  In addition
-// it also enforces that every cert in the bundle certificates form a chain, each one certifying the one above,
-// as per RFC5246 section 7.4.2, and that every certificate (whether or not it is necessary to form a chain to the root
-// pool) is currently valid and not yet expired (unless allowExpiry is set to true).
-// This is additional validation not required by go's Certificate.Verify (which allows invalid certs in the
-// intermediate pool), because this function is intended to be used when reading certs from untrusted locations such as
-// from disk or over a network when a CSR is signed, so it is extra pedantic.
-// This function always returns all the parsed certificates in the bundle in order, which means there will always be
-// at least 1 certificate if there is no error.
-func ValidateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool) ([]*x509.Certificate, error) {
-	// Parse all the certificates in the cert bundle
-	parsedCerts, err := helpers.ParseCertificatesPEM(certs)
+// crossSignedPair returns whether one of the certificates contains a copy of one of the roots cross-signed by the
+// other root.
+func crossSignedPair(a, b *x509.Certificate, certs []*x509.Certificate) bool {
+	for _, cert := range certs {
+		if !bytes.Equal(cert.RawSubject, a.RawSubject) {
+			continue
+		}
+		if bytes.Equal(cert.RawSubjectPublicKeyInfo, b.RawSubjectPublicKeyInfo) && cert.CheckSignatureFrom(a) == nil {
+			return true
+		}
+		if bytes.Equal(cert.RawSubjectPublicKeyInfo, a.RawSubjectPublicKeyInfo) && cert.CheckSignatureFrom(b) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// signerVerificationPool returns a pool of the roots and of the extra roots a signing CA certificate may chain up to,
+// which must also be self-signed CA certificates.
+func signerVerificationPool(roots []*x509.Certificate, extraRootsBytes []byte) (*x509.CertPool, error) {
+	extraRoots, err := helpers.ParseCertificatesPEM(extraRootsBytes)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "invalid signer verification roots")
 	}
-	if len(parsedCerts) == 0 {
-		return nil, errors.New("no certificates to validate")
+	pool := x509.NewCertPool()
+	for _, cert := range roots {
+		pool.AddCert(cert)
 	}
-	now := time.Now()
-	// ensure that they form a chain, each one being signed by the one after it
-	var intermediatePool *x509.CertPool
-	for i, cert := range parsedCerts {
-		// Manual expiry validation because we want more information on which certificate in the chain is expired, and
-		// because this is an easier way to allow expired certs.
-		if now.Before(cert.NotBefore) {
-			return nil, errors.Wrapf(
-				x509.CertificateInvalidError{
-					Cert:   cert,
-					Reason: x509.Expired,
-				},
-				"certificate (%d - %s) not valid before %s, and it is currently %s",
+	for _, cert := range extraRoots {
+		if err := validateSignatureAlgorithm(cert); err != nil {
+			return nil, err
+		}
+		if !cert.IsCA {
+			return nil, errors.Errorf("signer verification root %s is not a CA certificate", cert.Subject.CommonName)
+		}
+		if err := cert.CheckSignatureFrom(cert); err != nil {
+			return nil, errors.Wrapf(err, "signer verification root %s is not self-signed", cert.Subject.CommonName)
+		}
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
+
+// OrderChain takes PEM encoded certificates in any order, and returns them as a single PEM bundle ordered from the
+// leaf to the last certificate in the chain, each certificate being issued by the one after it, which is the order
+// ValidateCertChain expects.  A certificate is considered to be issued by another if its issuer matches the other's
+// subject, and its authority key ID, if any, matches the other's subject key ID, if any.  Signatures are not
+// checked - that is left to ValidateCertChain.  An error is returned if the certificates don't form a single chain.
+func OrderChain(certs [][]byte) ([]byte, error) {
+	var parsed []*x509.Certificate
+	for i, certPEM := range certs {
+		cert, err := helpers.ParseCertificatePEM(certPEM)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse certificate %d", i)
+		}
+		for _, other := range parsed {
+			if bytes.Equal(other.Raw, cert.Raw) {
+				return nil, errors.Errorf("certificate %d is repeated", i)
+			}
+		}
+		parsed = append(parsed, cert)
+	}
+	if len(parsed) == 0 {
+		return nil, errors.New("no certificates to order")
+	}
+
+	// issuerOf returns the certificate that issued cert, or nil if none of the others did
+	issuerOf := func(cert *x509.Certificate) (*x509.Certificate, error) {
+		var issuer *x509.Certificate
+		for _, candidate := range parsed {
+			if candidate == cert || !issuedBy(cert, candidate) {
+				continue
+			}
+			if issuer != nil {
+				return nil, errors.Errorf("certificates do not form a single chain: %s has more than one issuer", cert.Subject.CommonName)
+			}
+			issuer = candidate
+		}
+		return issuer, nil
+	}
+
+	// The leaf is the only certificate that didn't issue any of the others.
+	var leaf *x509.Certificate
+	for _, cert := range parsed {
+		issuedOthers := false
+		for _, other := range parsed {
+			if other != cert && issuedBy(other, cert) {
+				issuedOthers = true
+				break
+			}
+		}
+		if !issuedOthers {
+			if leaf != nil {
+				return nil, errors.New("certificates do not form a single chain: there is more than one leaf")
+			}
+			leaf = cert
+		}
+	}
+	if leaf == nil {
+		return nil, errors.New("certificates do not form a single chain: there is no leaf")
+	}
+
+	var ordered []byte
+	seen := make(map[*x509.Certificate]struct{})
+	for cert := leaf; cert != nil; {
+		if _, ok := seen[cert]; ok {
+			return nil, errors.New("certificates do not form a single chain: they issue each other in a loop")
+		}
+		seen[cert] = struct{}{}
+		ordered = append(ordered, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+
+		issuer, err := issuerOf(cert)
+		if err != nil {
+			return nil, err
+		}
+		cert = issuer
+	}
+	if len(seen) != len(parsed) {
+		return nil, errors.Errorf("certificates do not form a single chain: only %d of the %d certificates chain up from the leaf",
+			len(seen), len(parsed))
+	}
+	return ordered, nil
+}
+
+// issuedBy returns true if the names and key IDs of child and parent say that parent issued child.
+func issuedBy(child, parent *x509.Certificate) bool {
+	if !bytes.Equal(child.RawIssuer, parent.RawSubject) {
+		return false
+	}
+	return len(child.AuthorityKeyId) == 0 || len(parent.SubjectKeyId) == 0 ||
+		bytes.Equal(child.AuthorityKeyId, parent.SubjectKeyId)
+}
+
+// ValidateCertChain checks checks that the certificates provided chain up to the root pool provided.  In addition
+// it also enforces that every cert in the bundle certificates form a chain, each one certifying the one above,
+// as per RFC5246 section 7.4.2, and that every certificate (whether or not it is necessary to form a chain to the root
+// pool) is currently valid and not yet expired (unless allowExpiry is set to true).
+// This is additional validation not required by go's Certificate.Verify (which allows invalid certs in the
+// intermediate pool), because this function is intended to be used when reading certs from untrusted locations such as
+// from disk or over a network when a CSR is signed, so it is extra pedantic.
+// This function always returns all the parsed certificates in the bundle in order, which means there will always be
+// at least 1 certificate if there is no error.
+func ValidateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool) ([]*x509.Certificate, error) {
+	return ValidateCertChainWithUsage(rootPool, certs, allowExpired, LeafUsage{})
+}
+
+// ValidateCertChainAnchor validates the chain like ValidateCertChain, and also returns the root certificate from
+// rootPool that anchors it, so that callers can tell, for instance during a root rotation, which root a certificate
+// chains up to.  If the chain can be anchored by more than one root, the root closest to the leaf is returned.  For
+// example, a leaf signed by a new root's key that also chains up to the old root through a cross-signed intermediate
+// is anchored by the new root, if rootPool contains it.
+func ValidateCertChainAnchor(rootPool *x509.CertPool, certs []byte, allowExpired bool) ([]*x509.Certificate, *x509.Certificate, error) {
+	parsedCerts, chains, err := validateCertChain(rootPool, certs, allowExpired, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	shortest := chains[0]
+	for _, chain := range chains[1:] {
+		if len(chain) < len(shortest) {
+			shortest = chain
+		}
+	}
+	return parsedCerts, shortest[len(shortest)-1], nil
+}
+
+// LeafUsage lists the key usages that the leaf certificate of a chain must permit.  Zero values require nothing.
+type LeafUsage struct {
+	// KeyUsage is the set of key usage bits that must all be set on the leaf
+	KeyUsage x509.KeyUsage
+	// ExtKeyUsage are the extended key usages that must all be permitted by the leaf
+	ExtKeyUsage []x509.ExtKeyUsage
+}
+
+// ValidateCertChainWithUsage validates the chain like ValidateCertChain, and additionally checks that the leaf
+// certificate permits the given key usages, for instance client authentication for certificates presented by
+// gRPC clients.
+func ValidateCertChainWithUsage(rootPool *x509.CertPool, certs []byte, allowExpired bool, usage LeafUsage) ([]*x509.Certificate, error) {
+	return ValidateCertChainWithOptions(rootPool, certs, CertChainOptions{AllowExpired: allowExpired, Usage: usage})
+}
+
+// CertChainOptions controls the validation done by ValidateCertChainWithOptions.
+type CertChainOptions struct {
+	// AllowExpired allows expired certificates, as long as there is a time at which the whole chain was valid
+	AllowExpired bool
+	// Usage lists the key usages that the leaf certificate must permit
+	Usage LeafUsage
+	// RequireFullChain requires the presented certificates to form a chain all the way to a self-signed root in the
+	// root pool.  By default, the chain may also be anchored by any other certificate in the root pool, such as an
+	// intermediate, so that a peer can present fewer certificates.  Strict mTLS setups can set it to require peers to
+	// present their full chain.
+	RequireFullChain bool
+	// ExtraIntermediates are PEM encoded intermediate certificates that may be used to complete the chain in addition
+	// to the presented ones, for instance a cross-signed intermediate held out-of-band during a root rotation.  Unlike
+	// the presented certificates, they don't have to form a chain.
+	ExtraIntermediates []byte
+}
+
+// ValidateCertChainWithOptions validates the chain like ValidateCertChain, with the given options.
+func ValidateCertChainWithOptions(rootPool *x509.CertPool, certs []byte, opts CertChainOptions) ([]*x509.Certificate, error) {
+	var extraIntermediates []*x509.Certificate
+	if len(opts.ExtraIntermediates) > 0 {
+		var err error
+		if extraIntermediates, err = parseCertificateBlocks(opts.ExtraIntermediates); err != nil {
+			return nil, errors.Wrap(err, "invalid extra intermediates")
+		}
+	}
+	parsedCerts, chains, err := validateCertChain(rootPool, certs, opts.AllowExpired, extraIntermediates)
+	if err != nil {
+		return nil, err
+	}
+	if opts.RequireFullChain {
+		if err := checkFullChain(chains); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkLeafUsage(parsedCerts[0], opts.Usage); err != nil {
+		return nil, err
+	}
+	return parsedCerts, nil
+}
+
+// checkFullChain ensures that at least one of the verified chains is anchored by a self-signed root.  Since only the
+// presented certificates are used as intermediates, this means they form a chain all the way to that root.
+func checkFullChain(chains [][]*x509.Certificate) error {
+	for _, chain := range chains {
+		anchor := chain[len(chain)-1]
+		if bytes.Equal(anchor.RawSubject, anchor.RawIssuer) && anchor.CheckSignatureFrom(anchor) == nil {
+			return nil
+		}
+	}
+	return errors.New("certificate chain does not reach a self-signed root: the full chain must be presented")
+}
+
+func checkLeafUsage(leaf *x509.Certificate, usage LeafUsage) error {
+	if leaf.KeyUsage&usage.KeyUsage != usage.KeyUsage {
+		return errors.Errorf("leaf certificate (%s) key usage %#x does not permit the required key usage %#x",
+			leaf.Subject.CommonName, leaf.KeyUsage, usage.KeyUsage)
+	}
+	for _, required := range usage.ExtKeyUsage {
+		permitted := false
+		for _, eku := range leaf.ExtKeyUsage {
+			if eku == required || eku == x509.ExtKeyUsageAny {
+				permitted = true
+				break
+			}
+		}
+		if !permitted {
+			return errors.Errorf("leaf certificate (%s) extended key usage does not permit %s",
+				leaf.Subject.CommonName, extKeyUsageName(required))
+		}
+	}
+	return nil
+}
+
+func extKeyUsageName(eku x509.ExtKeyUsage) string {
+	switch eku {
+	case x509.ExtKeyUsageServerAuth:
+		return "server authentication"
+	case x509.ExtKeyUsageClientAuth:
+		return "client authentication"
+	case x509.ExtKeyUsageCodeSigning:
+		return "code signing"
+	case x509.ExtKeyUsageEmailProtection:
+		return "email protection"
+	case x509.ExtKeyUsageTimeStamping:
+		return "time stamping"
+	case x509.ExtKeyUsageOCSPSigning:
+		return "OCSP signing"
+	}
+	return fmt.Sprintf("extended key usage %d", eku)
+}
+
+// parseCertificateBlocks parses the CERTIFICATE blocks in PEM data, skipping any other PEM blocks and any text
+// between them, since real world PEM files sometimes contain comments.  Data that doesn't contain any PEM blocks at
+// all, and certificate blocks that can't be parsed, are still errors.
+func parseCertificateBlocks(pemBytes []byte) ([]*x509.Certificate, error) {
+	var (
+		certs     []*x509.Certificate
+		hasBlocks bool
+	)
+	for rest := pemBytes; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		hasBlocks = true
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		parsed, err := helpers.ParseCertificatesPEM(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, parsed...)
+	}
+	if !hasBlocks && len(bytes.TrimSpace(pemBytes)) > 0 {
+		return nil, cferr.New(cferr.CertificateError, cferr.DecodeFailed)
+	}
+	return certs, nil
+}
+
+// validateCertChain returns the parsed certificates, and the chains from the leaf to a root in rootPool that were
+// verified.  Any extra intermediates may also be used to build the chains.
+func validateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool, extraIntermediates []*x509.Certificate) ([]*x509.Certificate, [][]*x509.Certificate, error) {
+	// Parse all the certificates in the cert bundle
+	parsedCerts, err := parseCertificateBlocks(certs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(parsedCerts) == 0 {
+		return nil, nil, errors.New("no certificates to validate")
+	}
+	now := time.Now()
+	// ensure that they form a chain, each one being signed by the one after it
+	var intermediatePool *x509.CertPool
+	for i, cert := range parsedCerts {
+		// Manual expiry validation because we want more information on which certificate in the chain is expired, and
+		// because this is an easier way to allow expired certs.
+		if now.Before(cert.NotBefore) {
+			return nil, nil, errors.Wrapf(
+				x509.CertificateInvalidError{
+					Cert:   cert,
+					Reason: x509.Expired,
+				},
+				"certificate (%d - %s) not valid before %s, and it is currently %s",
 				i+1, cert.Subject.CommonName, cert.NotBefore.UTC().Format(time.RFC1123), now.Format(time.RFC1123))
 		}
 		if !allowExpired && now.After(cert.NotAfter) {
-			return nil, errors.Wrapf(
+			return nil, nil, errors.Wrapf(
 				x509.CertificateInvalidError{
 					Cert:   cert,
 					Reason: x509.Expired,
@@ -509,7 +1384,7 @@ func ValidateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool)
 			// check that the previous cert was signed by this cert
 			prevCert := parsedCerts[i-1]
 			if err := prevCert.CheckSignatureFrom(cert); err != nil {
-				return nil, errors.Wrapf(err, "certificates do not form a chain: (%d - %s) is not signed by (%d - %s)",
+				return nil, nil, errors.Wrapf(err, "certificates do not form a chain: (%d - %s) is not signed by (%d - %s)",
 					i, prevCert.Subject.CommonName, i+1, cert.Subject.CommonName)
 			}
 
@@ -521,11 +1396,19 @@ func ValidateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool)
 		}
 	}
 
+	for _, cert := range extraIntermediates {
+		if intermediatePool == nil {
+			intermediatePool = x509.NewCertPool()
+		}
+		intermediatePool.AddCert(cert)
+	}
+
 	verifyOpts := x509.VerifyOptions{
 		Roots:         rootPool,
 		Intermediates: intermediatePool,
 		CurrentTime:   now,
 	}
+	var chains [][]*x509.Certificate
 
 	// If we accept expired certs, try to build a valid cert chain using some subset of the certs.  We start off using the
 	// first certificate's NotAfter as the current time, thus ensuring that the first cert is not expired. If the chain
@@ -541,26 +1424,26 @@ func ValidateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool)
 			}
 			verifyOpts.CurrentTime = cert.NotAfter
 
-			_, err = parsedCerts[0].Verify(verifyOpts)
+			chains, err = parsedCerts[0].Verify(verifyOpts)
 			if err == nil {
-				return parsedCerts, nil
+				return parsedCerts, chains, nil
 			}
 		}
 		if invalid, ok := err.(x509.CertificateInvalidError); ok && invalid.Reason == x509.Expired {
-			return nil, errors.New("there is no time span for which all of the certificates, including a root, are valid")
+			return nil, nil, errors.New("there is no time span for which all of the certificates, including a root, are valid")
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
-	_, err = parsedCerts[0].Verify(verifyOpts)
+	chains, err = parsedCerts[0].Verify(verifyOpts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return parsedCerts, nil
+	return parsedCerts, chains, nil
 }
 
 // newLocalSigner validates the signing cert and signing key to create a local signer, which accepts a crypto signer and a cert
-func newLocalSigner(keyBytes, certBytes []byte, certExpiry time.Duration, rootPool, intermediatePool *x509.CertPool) (*LocalSigner, error) {
+func newLocalSigner(keyBytes, certBytes []byte, certExpiry time.Duration, rootPool *x509.CertPool, intermediates []*x509.Certificate, opts RootCAOptions) (*LocalSigner, error) {
 	if len(keyBytes) == 0 || len(certBytes) == 0 {
 		return nil, errors.New("must provide both a signing key and a signing cert, or neither")
 	}
@@ -572,47 +1455,74 @@ func newLocalSigner(keyBytes, certBytes []byte, certExpiry time.Duration, rootPo
 	if len(parsedCerts) == 0 {
 		return nil, errors.New("no valid signing CA certificates found")
 	}
-	if err := validateSignatureAlgorithm(parsedCerts[0]); err != nil {
-		return nil, err
+	for _, cert := range parsedCerts {
+		if err := validateSignatureAlgorithm(cert); err != nil {
+			return nil, err
+		}
 	}
-	opts := x509.VerifyOptions{
-		Roots:         rootPool,
-		Intermediates: intermediatePool,
+
+	passphrases := opts.Passphrases
+	if passphrases == nil {
+		passphrases = envPassphrases()
 	}
-	if _, err := parsedCerts[0].Verify(opts); err != nil {
-		return nil, errors.Wrap(err, "error while validating signing CA certificate against roots and intermediates")
+	var passphraseStr string
+	if len(passphrases) > 0 {
+		passphraseStr = string(passphrases[0])
+	} else {
+		passphrases = [][]byte{nil}
 	}
 
-	var (
-		passphraseStr              string
-		passphrase, passphrasePrev []byte
-		priv                       crypto.Signer
-	)
-
-	// Attempt two distinct passphrases, so we can do a hitless passphrase rotation
-	if passphraseStr = os.Getenv(PassphraseENVVar); passphraseStr != "" {
-		passphrase = []byte(passphraseStr)
+	// Attempt to decrypt the current private-key with the passphrases provided, in order, so we can do a hitless
+	// passphrase rotation
+	var priv crypto.Signer
+	for _, passphrase := range passphrases {
+		if len(passphrase) == 0 {
+			passphrase = nil
+		}
+		if priv, err = helpers.ParsePrivateKeyPEMWithPassword(keyBytes, passphrase); err == nil {
+			break
+		}
 	}
-
-	if p := os.Getenv(PassphraseENVVarPrev); p != "" {
-		passphrasePrev = []byte(p)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed private key")
 	}
 
-	// Attempt to decrypt the current private-key with the passphrases provided
-	priv, err = helpers.ParsePrivateKeyPEMWithPassword(keyBytes, passphrase)
+	// The active certificate is the one in the bundle that matches the key, which is usually the first one
+	position, err := findSigningCert(parsedCerts, priv.Public())
 	if err != nil {
-		priv, err = helpers.ParsePrivateKeyPEMWithPassword(keyBytes, passphrasePrev)
-		if err != nil {
-			return nil, errors.Wrap(err, "malformed private key")
-		}
+		return nil, err
+	}
+	signingCert := parsedCerts[position]
+	if err := checkKeyBlocklist(signingCert.PublicKey); err != nil {
+		return nil, errors.Wrap(err, "signing CA key rejected")
+	}
+	if position > 0 {
+		certBytes = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: signingCert.Raw})
 	}
 
-	// We will always use the first certificate inside of the root bundle as the active one
-	if err := ensureCertKeyMatch(parsedCerts[0], priv.Public()); err != nil {
-		return nil, err
+	verifyOpts := x509.VerifyOptions{
+		Roots: rootPool,
+	}
+	if len(intermediates) > 0 {
+		verifyOpts.Intermediates = x509.NewCertPool()
+		for _, cert := range intermediates {
+			verifyOpts.Intermediates.AddCert(cert)
+		}
+	}
+	if _, err := signingCert.Verify(verifyOpts); err != nil {
+		// The intermediates were already validated against the roots, so if there are any, the chain can only break
+		// between the signing CA certificate and the first intermediate.
+		if _, ok := err.(x509.UnknownAuthorityError); ok && len(intermediates) > 0 {
+			return nil, errors.Wrapf(err, "error while validating signing CA certificate - the chain breaks between the signing CA certificate (%s) and the first intermediate (%s)",
+				signingCert.Subject.CommonName, intermediates[0].Subject.CommonName)
+		}
+		if len(parsedCerts) > 1 {
+			return nil, errors.Wrapf(err, "error while validating signing CA certificate at position %d of the bundle against roots and intermediates", position)
+		}
+		return nil, errors.Wrap(err, "error while validating signing CA certificate against roots and intermediates")
 	}
 
-	signer, err := local.NewSigner(priv, parsedCerts[0], cfsigner.DefaultSigAlgo(priv), SigningPolicy(certExpiry))
+	signer, err := local.NewSigner(priv, signingCert, cfsigner.DefaultSigAlgo(priv), SigningPolicy(certExpiry))
 	if err != nil {
 		return nil, err
 	}
@@ -621,62 +1531,146 @@ func newLocalSigner(keyBytes, certBytes []byte, certExpiry time.Duration, rootPo
 	// ensure it is encrypted, so it doesn't hit raft in plain-text
 	// we don't have to check for nil, because if we couldn't pem-decode the bytes, then parsing above would have failed
 	keyBlock, _ := pem.Decode(keyBytes)
-	if passphraseStr != "" && !x509.IsEncryptedPEMBlock(keyBlock) {
+	if passphraseStr != "" && !x509.IsEncryptedPEMBlock(keyBlock) && !opts.SkipKeyReencryption {
 		keyBytes, err = EncryptECPrivateKey(keyBytes, passphraseStr)
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to encrypt signing CA key material")
 		}
 	}
 
-	return &LocalSigner{Cert: certBytes, Key: keyBytes, Signer: signer, parsedCert: parsedCerts[0], cryptoSigner: priv}, nil
+	return &LocalSigner{Cert: certBytes, Key: keyBytes, Signer: signer, parsedCert: signingCert, cryptoSigner: priv}, nil
+}
+
+// envPassphrases returns the current and previous passphrases for the root CA key from the environment
+func envPassphrases() [][]byte {
+	var passphrase, passphrasePrev []byte
+	if p := os.Getenv(PassphraseENVVar); p != "" {
+		passphrase = []byte(p)
+	}
+	if p := os.Getenv(PassphraseENVVarPrev); p != "" {
+		passphrasePrev = []byte(p)
+	}
+	return [][]byte{passphrase, passphrasePrev}
+}
+
+// findSigningCert returns the position of the certificate in the bundle whose public key matches the key,
+// preferring the first certificate.  If none does, the error says so in terms of the bundle, so that a
+// misassembled bundle can be told apart from a wrong key.
+func findSigningCert(certs []*x509.Certificate, key crypto.PublicKey) (int, error) {
+	for i, cert := range certs {
+		if certKeyMatches(cert, key) {
+			if err := MinimumKeyStrength.check(cert.PublicKey); err != nil {
+				return 0, err
+			}
+			return i, nil
+		}
+	}
+	if len(certs) == 1 {
+		return 0, ensureCertKeyMatch(certs[0], key)
+	}
+	return 0, errors.Errorf("certificate key mismatch: the signing key does not match any of the %d certificates in the bundle", len(certs))
 }
 
 func ensureCertKeyMatch(cert *x509.Certificate, key crypto.PublicKey) error {
+	if err := MinimumKeyStrength.check(cert.PublicKey); err != nil {
+		return err
+	}
+	if !certKeyMatches(cert, key) {
+		return errors.New("certificate key mismatch")
+	}
+	return nil
+}
+
+func certKeyMatches(cert *x509.Certificate, key crypto.PublicKey) bool {
 	switch certPub := cert.PublicKey.(type) {
 	case *rsa.PublicKey:
-		if certPub.N.BitLen() < 2048 || certPub.E == 1 {
-			return errors.New("unsupported RSA key parameters")
-		}
 		rsaKey, ok := key.(*rsa.PublicKey)
-		if ok && certPub.E == rsaKey.E && certPub.N.Cmp(rsaKey.N) == 0 {
-			return nil
+		return ok && certPub.E == rsaKey.E && certPub.N.Cmp(rsaKey.N) == 0
+	case *ecdsa.PublicKey:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		return ok && certPub.X.Cmp(ecKey.X) == 0 && certPub.Y.Cmp(ecKey.Y) == 0
+	}
+	return false
+}
+
+// KeyStrengthPolicy defines the weakest keys that will be accepted for CA certificates and for
+// the CSRs that get signed by them.
+type KeyStrengthPolicy struct {
+	// MinRSABits is the minimum size of an RSA modulus, in bits
+	MinRSABits int
+	// MinECDSABits is the minimum size of an ECDSA curve, in bits.  Only the
+	// P-256, P-384 and P-521 curves are ever supported.
+	MinECDSABits int
+}
+
+// MinimumKeyStrength is the policy enforced when loading a root CA, when signing a CSR, and when
+// generating new keys.  By default, RSA keys must be at least 2048 bits and ECDSA keys must use
+// at least the P-256 curve.
+var MinimumKeyStrength = KeyStrengthPolicy{
+	MinRSABits:   2048,
+	MinECDSABits: 256,
+}
+
+// check returns an error if the public key does not satisfy the policy
+func (p KeyStrengthPolicy) check(pub crypto.PublicKey) error {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < p.MinRSABits || pub.E == 1 {
+			return errors.New("unsupported RSA key parameters")
 		}
 	case *ecdsa.PublicKey:
-		switch certPub.Curve {
+		switch pub.Curve {
 		case elliptic.P256(), elliptic.P384(), elliptic.P521():
-			break
-		default:
-			return errors.New("unsupported ECDSA key parameters")
-		}
-
-		ecKey, ok := key.(*ecdsa.PublicKey)
-		if ok && certPub.X.Cmp(ecKey.X) == 0 && certPub.Y.Cmp(ecKey.Y) == 0 {
-			return nil
+			if pub.Curve.Params().BitSize >= p.MinECDSABits {
+				return nil
+			}
 		}
+		return errors.New("unsupported ECDSA key parameters")
 	default:
 		return errors.New("unknown or unsupported certificate public key algorithm")
 	}
+	return nil
+}
 
-	return errors.New("certificate key mismatch")
+// ecdsaKeySize returns the smallest ECDSA key size that is at least as big as the
+// requested size and that satisfies the policy
+func (p KeyStrengthPolicy) ecdsaKeySize(size int) int {
+	for _, supported := range []int{256, 384, 521} {
+		if supported >= size && supported >= p.MinECDSABits {
+			return supported
+		}
+	}
+	return 521
+}
+
+// checkCSRKeyStrength returns an error if the key in the CSR does not satisfy the key strength policy, or is
+// blocked.  CSRs that cannot be parsed are left for the signer to reject.
+func checkCSRKeyStrength(csrBytes []byte) error {
+	block, _ := pem.Decode(csrBytes)
+	if block == nil {
+		return nil
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	if err := MinimumKeyStrength.check(csr.PublicKey); err != nil {
+		return errors.Wrap(err, "CSR key does not satisfy the key strength policy")
+	}
+	return errors.Wrap(checkKeyBlocklist(csr.PublicKey), "CSR key rejected")
 }
 
 // GetLocalRootCA validates if the contents of the file are a valid self-signed
 // CA certificate, and returns the PEM-encoded Certificate if so
 func GetLocalRootCA(paths CertPaths) (RootCA, error) {
-	// Check if we have a Certificate file
-	cert, err := ioutil.ReadFile(paths.Cert)
+	cert, signingCert, intermediates, err := readLocalRootCACerts(paths)
 	if err != nil {
-		if os.IsNotExist(err) {
-			err = ErrNoLocalRootCA
-		}
-
 		return RootCA{}, err
 	}
-	signingCert := cert
 
-	key, err := ioutil.ReadFile(paths.Key)
+	key, err := FileKeyProvider{Path: paths.Key}.GetRootKey(context.Background())
 	if err != nil {
-		if !os.IsNotExist(err) {
+		if err != ErrNoValidSigner {
 			return RootCA{}, err
 		}
 		// There may not be a local key. It's okay to pass in a nil
@@ -685,29 +1679,77 @@ func GetLocalRootCA(paths CertPaths) (RootCA, error) {
 		signingCert = nil
 	}
 
-	return NewRootCA(cert, signingCert, key, DefaultNodeCertExpiration, nil)
+	return NewRootCA(cert, signingCert, key, DefaultNodeCertExpiration, intermediates)
 }
 
-func getGRPCConnection(creds credentials.TransportCredentials, connBroker *connectionbroker.Broker, forceRemote bool) (*connectionbroker.Conn, error) {
+// readLocalRootCACerts reads the root CA certificate and intermediates from
+// disk, and returns them along with the certificate that signs leaves.
+func readLocalRootCACerts(paths CertPaths) (cert, signingCert, intermediates []byte, err error) {
+	// Check if we have a Certificate file
+	cert, err = ioutil.ReadFile(paths.Cert)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = ErrNoLocalRootCA
+		}
+
+		return nil, nil, nil, err
+	}
+	signingCert = cert
+
+	if paths.Intermediates != "" {
+		intermediates, err = ioutil.ReadFile(paths.Intermediates)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, nil, nil, err
+		}
+		// The first intermediate is the signing certificate, for instance
+		// a new root cross-signed by the old one during a root rotation.
+		if len(intermediates) > 0 {
+			signingCert = intermediates
+		}
+	}
+	return cert, signingCert, intermediates, nil
+}
+
+func getGRPCConnection(creds credentials.TransportCredentials, connBroker *connectionbroker.Broker, forceRemote bool, excludes ...string) (*connectionbroker.Conn, error) {
 	dialOpts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 		grpc.WithTimeout(5 * time.Second),
 		grpc.WithBackoffMaxDelay(5 * time.Second),
 	}
 	if forceRemote {
-		return connBroker.SelectRemote(dialOpts...)
+		return connBroker.SelectRemoteExcluding(excludes, dialOpts...)
 	}
-	return connBroker.Select(dialOpts...)
+	return connBroker.SelectExcluding(excludes, dialOpts...)
 }
 
 // GetRemoteCA returns the remote endpoint's CA certificate bundle
 func GetRemoteCA(ctx context.Context, d digest.Digest, connBroker *connectionbroker.Broker) (RootCA, error) {
+	return GetRemoteCAWithCN(ctx, d, connBroker, "")
+}
+
+// GetRemoteCAWithCN returns the remote endpoint's CA certificate bundle, like GetRemoteCA, and if expectedCN is
+// not empty, additionally checks that every root certificate in the bundle has that common name.  The common name
+// is only checked after the digest, as a defense in depth.
+func GetRemoteCAWithCN(ctx context.Context, d digest.Digest, connBroker *connectionbroker.Broker, expectedCN string) (RootCA, error) {
+	rootCA, _, _, err := getRemoteCA(ctx, d, connBroker, expectedCN, "")
+	return rootCA, err
+}
+
+// GetRemoteCAIfModified returns the remote endpoint's CA certificate bundle like GetRemoteCA, unless it is the
+// bundle identified by etag, as returned by a previous call, in which case the bundle is not transferred again and
+// modified is false.  It also returns the ETag of the remote endpoint's current bundle.  The digest is checked
+// whenever a bundle is returned.
+func GetRemoteCAIfModified(ctx context.Context, d digest.Digest, connBroker *connectionbroker.Broker, etag string) (rootCA RootCA, newETag string, modified bool, err error) {
+	return getRemoteCA(ctx, d, connBroker, "", etag)
+}
+
+func getRemoteCA(ctx context.Context, d digest.Digest, connBroker *connectionbroker.Broker, expectedCN, etag string) (RootCA, string, bool, error) {
 	// This TLS Config is intentionally using InsecureSkipVerify. We use the
 	// digest instead to check the integrity of the CA certificate.
 	insecureCreds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
 	conn, err := getGRPCConnection(insecureCreds, connBroker, false)
 	if err != nil {
-		return RootCA{}, err
+		return RootCA{}, "", false, err
 	}
 
 	client := api.NewCAClient(conn.ClientConn)
@@ -716,9 +1758,12 @@ func GetRemoteCA(ctx context.Context, d digest.Digest, connBroker *connectionbro
 	defer func() {
 		conn.Close(err == nil)
 	}()
-	response, err := client.GetRootCACertificate(ctx, &api.GetRootCACertificateRequest{})
+	response, err := client.GetRootCACertificate(ctx, &api.GetRootCACertificateRequest{IfNoneMatch: etag})
 	if err != nil {
-		return RootCA{}, err
+		return RootCA{}, "", false, err
+	}
+	if etag != "" && response.NotModified {
+		return RootCA{}, response.ETag, false, nil
 	}
 
 	// If a bundle of certificates are provided, the digest covers the entire bundle and not just
@@ -728,36 +1773,139 @@ func GetRemoteCA(ctx context.Context, d digest.Digest, connBroker *connectionbro
 	if d != "" {
 		verifier := d.Verifier()
 		if err != nil {
-			return RootCA{}, errors.Wrap(err, "unexpected error getting digest verifier")
+			return RootCA{}, "", false, errors.Wrap(err, "unexpected error getting digest verifier")
 		}
 
 		io.Copy(verifier, bytes.NewReader(response.Certificate))
 
 		if !verifier.Verified() {
-			return RootCA{}, errors.Errorf("remote CA does not match fingerprint. Expected: %s", d.Hex())
+			return RootCA{}, "", false, errors.Errorf("remote CA does not match fingerprint. Expected: %s", d.Hex())
 		}
 	}
 
 	// NewRootCA will validate that the certificates are otherwise valid and create a RootCA object.
 	// Since there is no key, the certificate expiry does not matter and will not be used.
-	return NewRootCA(response.Certificate, nil, nil, DefaultNodeCertExpiration, nil)
+	rootCA, err := NewRootCA(response.Certificate, nil, nil, DefaultNodeCertExpiration, nil)
+	if err != nil {
+		return RootCA{}, "", false, err
+	}
+
+	if expectedCN != "" {
+		roots, err := helpers.ParseCertificatesPEM(rootCA.Certs)
+		if err != nil {
+			return RootCA{}, "", false, errors.Wrap(err, "invalid root certificates")
+		}
+		for _, root := range roots {
+			if root.Subject.CommonName != expectedCN {
+				return RootCA{}, "", false, errors.Errorf("remote CA common name %q does not match the expected common name %q",
+					root.Subject.CommonName, expectedCN)
+			}
+		}
+	}
+	return rootCA, response.ETag, true, nil
 }
 
 // CreateRootCA creates a Certificate authority for a new Swarm Cluster, potentially
 // overwriting any existing CAs.
 func CreateRootCA(rootCN string) (RootCA, error) {
-	// Create a simple CSR for the CA using the default CA validator and policy
+	return CreateRootCAWithSubject(pkix.Name{CommonName: rootCN})
+}
+
+// CreateRootCAWithSubject creates a Certificate authority for a new Swarm Cluster like CreateRootCA, but with the
+// given subject rather than only a common name.  Certificates issued by the root CA carry this subject as their
+// issuer.  Street addresses, postal codes and extra names are not supported.
+func CreateRootCAWithSubject(subject pkix.Name) (RootCA, error) {
+	return CreateRootCAWithOptions(subject, CreateRootCAOptions{})
+}
+
+// CreateRootCAOptions controls the key and signature of a root CA created by CreateRootCAWithOptions.
+type CreateRootCAOptions struct {
+	// Key is the key to generate for the root CA.  It defaults to an ECDSA key of RootKeySize bits, or of the
+	// smallest size allowed by MinimumKeyStrength.
+	Key KeyRequest
+	// SignatureHash is the hash the root CA certificate is signed with: crypto.SHA256, crypto.SHA384 or
+	// crypto.SHA512.  It defaults to the hash that matches the key, which is SHA-256 for RSA and P-256 keys.  ECDSA
+	// keys can't be used with a hash that is weaker than their curve.
+	SignatureHash crypto.Hash
+	// NameConstraints, if any are set, are added to the root CA certificate, so that certificates for other names
+	// don't validate against it even if its key is compromised.  They are kept by NewRootCA, since they are part of
+	// the certificate, and are enforced by ValidateCertChain.  Note that node certificates carry the node ID and role
+	// as DNS names, which must be permitted for nodes to join.
+	NameConstraints NameConstraints
+}
+
+// NameConstraints are X.509 name constraints for a CA certificate.  A DNS domain such as "swarm.local" matches the
+// domain and all of its subdomains, while ".swarm.local" only matches its subdomains; URI domains apply to the host of
+// URI names in the same way.
+type NameConstraints struct {
+	PermittedDNSDomains []string
+	ExcludedDNSDomains  []string
+	PermittedURIDomains []string
+	ExcludedURIDomains  []string
+}
+
+func (n NameConstraints) empty() bool {
+	return len(n.PermittedDNSDomains) == 0 && len(n.ExcludedDNSDomains) == 0 &&
+		len(n.PermittedURIDomains) == 0 && len(n.ExcludedURIDomains) == 0
+}
+
+// CreateRootCAWithOptions creates a Certificate authority for a new Swarm Cluster like CreateRootCAWithSubject, but
+// with the given key algorithm and size and signature hash, for instance to interoperate with an existing PKI that
+// requires RSA.
+func CreateRootCAWithOptions(subject pkix.Name, opts CreateRootCAOptions) (RootCA, error) {
+	names, err := csrNames(subject)
+	if err != nil {
+		return RootCA{}, err
+	}
+	if subject.CommonName == "" && len(names) == 0 {
+		return RootCA{}, errors.New("missing subject information")
+	}
+	keyRequest, err := rootCAKeyRequest(opts.Key)
+	if err != nil {
+		return RootCA{}, err
+	}
+
+	// Create a simple CSR for the CA using the default CA policy
 	req := cfcsr.CertificateRequest{
-		CN:         rootCN,
-		KeyRequest: &cfcsr.BasicKeyRequest{A: RootKeyAlgo, S: RootKeySize},
-		CA:         &cfcsr.CAConfig{Expiry: RootCAExpiration},
+		CN:           subject.CommonName,
+		Names:        names,
+		SerialNumber: subject.SerialNumber,
+		KeyRequest:   keyRequest,
+		CA:           &cfcsr.CAConfig{Expiry: RootCAExpiration},
 	}
 
 	// Generate the CA and get the certificate and private key
-	cert, _, key, err := initca.New(&req)
+	csrPEM, key, err := cfcsr.ParseRequest(&req)
+	if err != nil {
+		return RootCA{}, err
+	}
+	priv, err := helpers.ParsePrivateKeyPEM(key)
+	if err != nil {
+		return RootCA{}, err
+	}
+	sigAlgo, err := rootCASignatureAlgorithm(priv, opts.SignatureHash)
 	if err != nil {
 		return RootCA{}, err
 	}
+	policy := initca.CAPolicy()
+	policy.Default.ExpiryString = RootCAExpiration
+	policy.Default.Expiry, err = time.ParseDuration(RootCAExpiration)
+	if err != nil {
+		return RootCA{}, err
+	}
+	caSigner, err := local.NewSigner(priv, nil, sigAlgo, policy)
+	if err != nil {
+		return RootCA{}, err
+	}
+	cert, err := caSigner.Sign(cfsigner.SignRequest{Request: string(csrPEM)})
+	if err != nil {
+		return RootCA{}, err
+	}
+	if !opts.NameConstraints.empty() {
+		if cert, err = constrainRootCert(cert, priv, opts.NameConstraints); err != nil {
+			return RootCA{}, err
+		}
+	}
 
 	rootCA, err := NewRootCA(cert, cert, key, DefaultNodeCertExpiration, nil)
 	if err != nil {
@@ -767,6 +1915,114 @@ func CreateRootCA(rootCN string) (RootCA, error) {
 	return rootCA, nil
 }
 
+// constrainRootCert re-signs a self-signed root CA certificate with the given name constraints added.  cfssl can't
+// add name constraints itself.
+func constrainRootCert(certPEM []byte, priv crypto.Signer, constraints NameConstraints) ([]byte, error) {
+	template, err := helpers.ParseCertificatePEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	template.PermittedDNSDomainsCritical = true
+	template.PermittedDNSDomains = constraints.PermittedDNSDomains
+	template.ExcludedDNSDomains = constraints.ExcludedDNSDomains
+	template.PermittedURIDomains = constraints.PermittedURIDomains
+	template.ExcludedURIDomains = constraints.ExcludedURIDomains
+
+	derBytes, err := x509.CreateCertificate(cryptorand.Reader, template, template, priv.Public(), priv)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not add name constraints to the root CA certificate")
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: derBytes,
+	}), nil
+}
+
+// rootCAKeyRequest returns the request for a root CA key, applying the defaults and MinimumKeyStrength.
+func rootCAKeyRequest(key KeyRequest) (*cfcsr.BasicKeyRequest, error) {
+	switch key.Algo {
+	case "", "ecdsa":
+		size := key.Size
+		if size == 0 {
+			size = RootKeySize
+		}
+		return &cfcsr.BasicKeyRequest{A: "ecdsa", S: MinimumKeyStrength.ecdsaKeySize(size)}, nil
+	case "rsa":
+		size := key.Size
+		if size == 0 {
+			size = MinimumKeyStrength.MinRSABits
+		}
+		if size < MinimumKeyStrength.MinRSABits {
+			return nil, errors.Errorf("RSA keys must be at least %d bits", MinimumKeyStrength.MinRSABits)
+		}
+		return &cfcsr.BasicKeyRequest{A: "rsa", S: size}, nil
+	default:
+		return nil, errors.Errorf("unsupported key algorithm %q", key.Algo)
+	}
+}
+
+// rootCASignatureAlgorithm returns the algorithm to sign a root CA certificate with the key and hash, or the
+// default one for the key if the hash is zero, and rejects hashes that don't suit the key.
+func rootCASignatureAlgorithm(priv crypto.Signer, hash crypto.Hash) (x509.SignatureAlgorithm, error) {
+	if hash == 0 {
+		return cfsigner.DefaultSigAlgo(priv), nil
+	}
+	switch pub := priv.Public().(type) {
+	case *rsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return x509.SHA256WithRSA, nil
+		case crypto.SHA384:
+			return x509.SHA384WithRSA, nil
+		case crypto.SHA512:
+			return x509.SHA512WithRSA, nil
+		}
+	case *ecdsa.PublicKey:
+		var sigAlgo x509.SignatureAlgorithm
+		switch hash {
+		case crypto.SHA256:
+			sigAlgo = x509.ECDSAWithSHA256
+		case crypto.SHA384:
+			sigAlgo = x509.ECDSAWithSHA384
+		case crypto.SHA512:
+			sigAlgo = x509.ECDSAWithSHA512
+		default:
+			return x509.UnknownSignatureAlgorithm, errors.Errorf("unsupported signature hash %v", hash)
+		}
+		// a hash weaker than the curve would weaken the signature
+		if curveBits := pub.Curve.Params().BitSize; hash.Size()*8 < curveBits && hash != crypto.SHA512 {
+			return x509.UnknownSignatureAlgorithm, errors.Errorf("signature hash %v is too weak for a P-%d key", hash, curveBits)
+		}
+		return sigAlgo, nil
+	}
+	return x509.UnknownSignatureAlgorithm, errors.Errorf("unsupported signature hash %v", hash)
+}
+
+// csrNames converts the attributes of a subject other than its CN and serial number into CFSSL names, with one name
+// per attribute value.
+func csrNames(subject pkix.Name) ([]cfcsr.Name, error) {
+	if len(subject.StreetAddress) > 0 || len(subject.PostalCode) > 0 || len(subject.ExtraNames) > 0 {
+		return nil, errors.New("street addresses, postal codes and extra names are not supported in the subject")
+	}
+	var names []cfcsr.Name
+	for _, c := range subject.Country {
+		names = append(names, cfcsr.Name{C: c})
+	}
+	for _, st := range subject.Province {
+		names = append(names, cfcsr.Name{ST: st})
+	}
+	for _, l := range subject.Locality {
+		names = append(names, cfcsr.Name{L: l})
+	}
+	for _, o := range subject.Organization {
+		names = append(names, cfcsr.Name{O: o})
+	}
+	for _, ou := range subject.OrganizationalUnit {
+		names = append(names, cfcsr.Name{OU: ou})
+	}
+	return names, nil
+}
+
 // GetRemoteSignedCertificate submits a CSR to a remote CA server address,
 // and that is part of a CA identified by a specific certificate pool.
 func GetRemoteSignedCertificate(ctx context.Context, csr []byte, rootCAPool *x509.CertPool, config CertificateRequestConfig) ([]byte, error) {
@@ -782,7 +2038,12 @@ func GetRemoteSignedCertificate(ctx context.Context, csr []byte, rootCAPool *x50
 		creds = credentials.NewTLS(&tls.Config{ServerName: CARole, RootCAs: rootCAPool})
 	}
 
-	conn, err := getGRPCConnection(creds, config.ConnBroker, config.ForceRemote)
+	// Send the Request and retrieve the request token
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: config.Token, Availability: config.Availability}
+	if config.RequestedExpiry > 0 {
+		issueRequest.RequestedExpiry = gogotypes.DurationProto(config.RequestedExpiry)
+	}
+	conn, issueResponse, err := submitCSR(ctx, creds, issueRequest, config)
 	if err != nil {
 		return nil, err
 	}
@@ -790,17 +2051,6 @@ func GetRemoteSignedCertificate(ctx context.Context, csr []byte, rootCAPool *x50
 	// Create a CAClient to retrieve a new Certificate
 	caClient := api.NewNodeCAClient(conn.ClientConn)
 
-	issueCtx, issueCancel := context.WithTimeout(ctx, 5*time.Second)
-	defer issueCancel()
-
-	// Send the Request and retrieve the request token
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: config.Token, Availability: config.Availability}
-	issueResponse, err := caClient.IssueNodeCertificate(issueCtx, issueRequest)
-	if err != nil {
-		conn.Close(false)
-		return nil, err
-	}
-
 	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
 	expBackoff := events.NewExponentialBackoff(events.ExponentialBackoffConfig{
 		Base:   time.Second,
@@ -811,9 +2061,9 @@ func GetRemoteSignedCertificate(ctx context.Context, csr []byte, rootCAPool *x50
 	// Exponential backoff with Max of 30 seconds to wait for a new retry
 	for {
 		// Send the Request and retrieve the certificate
-		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
-		statusResponse, err := caClient.NodeCertificateStatus(ctx, statusRequest)
+		statusCtx, statusCancel := context.WithTimeout(ctx, 5*time.Second)
+		statusResponse, err := caClient.NodeCertificateStatus(statusCtx, statusRequest)
+		statusCancel()
 		if err != nil {
 			conn.Close(false)
 			return nil, err
@@ -833,14 +2083,111 @@ func GetRemoteSignedCertificate(ctx context.Context, csr []byte, rootCAPool *x50
 			// current request.
 			if bytes.Equal(statusResponse.Certificate.CSR, csr) {
 				conn.Close(true)
-				return statusResponse.Certificate.Certificate, nil
+				return issuedChain(statusResponse.Certificate.Certificate, rootCAPool, config.IncludeRoot)
 			}
 		}
 
 		// If we're still pending, the issuance failed, or the state is unknown
 		// let's continue trying.
 		expBackoff.Failure(nil, nil)
-		time.Sleep(expBackoff.Proceed(nil))
+		select {
+		case <-time.After(expBackoff.Proceed(nil)):
+		case <-ctx.Done():
+			conn.Close(true)
+			return nil, errors.Wrap(ctx.Err(), "gave up waiting for certificate to be issued")
+		}
+	}
+}
+
+// issuedChain returns the leaf and intermediates of a chain issued by a CA, without any self-signed root the CA
+// may have appended to the intermediates, followed by the root in rootCAPool that anchors the chain if includeRoot
+// is set.  The chain is returned unchanged if there is nothing to add or remove.
+func issuedChain(certs []byte, rootCAPool *x509.CertPool, includeRoot bool) ([]byte, error) {
+	parsedCerts, err := parseCertificateBlocks(certs)
+	if err != nil || len(parsedCerts) == 0 {
+		if !includeRoot {
+			// leave it to the caller's validation to reject the chain
+			return certs, nil
+		}
+		if err == nil {
+			return nil, errors.New("no certificate in the issued chain")
+		}
+		return nil, errors.Wrap(err, "could not parse the issued certificate chain")
+	}
+
+	end := len(parsedCerts)
+	if last := parsedCerts[end-1]; end > 1 && bytes.Equal(last.RawSubject, last.RawIssuer) && last.CheckSignatureFrom(last) == nil {
+		end--
+	}
+	if !includeRoot && end == len(parsedCerts) {
+		return certs, nil
+	}
+
+	var chain []byte
+	for _, cert := range parsedCerts[:end] {
+		chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	if !includeRoot {
+		return chain, nil
+	}
+
+	_, anchor, err := ValidateCertChainAnchor(rootCAPool, chain, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find the root of the issued certificate chain")
+	}
+	if !bytes.Equal(anchor.Raw, parsedCerts[end-1].Raw) {
+		chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: anchor.Raw})...)
+	}
+	return chain, nil
+}
+
+// submitCSR sends the certificate issuance request to a manager, retrying with another manager as configured if
+// it fails for a reason that may go away.  It returns the connection to the manager that accepted the request.
+func submitCSR(ctx context.Context, creds credentials.TransportCredentials, issueRequest *api.IssueNodeCertificateRequest, config CertificateRequestConfig) (*connectionbroker.Conn, *api.IssueNodeCertificateResponse, error) {
+	var deadline <-chan time.Time
+	if config.SubmitDeadline > 0 {
+		timer := time.NewTimer(config.SubmitDeadline)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	expBackoff := events.NewExponentialBackoff(events.ExponentialBackoffConfig{
+		Base:   100 * time.Millisecond,
+		Factor: 500 * time.Millisecond,
+		Max:    5 * time.Second,
+	})
+
+	var failed []string
+	for attempt := 0; ; attempt++ {
+		conn, err := getGRPCConnection(creds, config.ConnBroker, config.ForceRemote, failed...)
+		if err != nil {
+			err = connectionError{err: err}
+		} else {
+			issueCtx, issueCancel := context.WithTimeout(ctx, 5*time.Second)
+			var issueResponse *api.IssueNodeCertificateResponse
+			issueResponse, err = api.NewNodeCAClient(conn.ClientConn).IssueNodeCertificate(issueCtx, issueRequest)
+			issueCancel()
+			if err == nil {
+				return conn, issueResponse, nil
+			}
+			conn.Close(false)
+			if addr := conn.Peer().Addr; addr != "" {
+				failed = append(failed, addr)
+			}
+		}
+
+		if attempt >= config.SubmitRetries || !newCertificateRequestError(err).Transient {
+			return nil, nil, err
+		}
+		log.G(ctx).WithError(err).Debugf("failed to submit CSR, retrying (attempt %d of %d)", attempt+1, config.SubmitRetries)
+
+		expBackoff.Failure(nil, nil)
+		select {
+		case <-time.After(expBackoff.Proceed(nil)):
+		case <-deadline:
+			return nil, nil, errors.Wrap(err, "gave up submitting CSR after the submission deadline")
+		case <-ctx.Done():
+			return nil, nil, errors.Wrap(err, "gave up submitting CSR")
+		}
 	}
 }
 
@@ -876,14 +2223,84 @@ func SaveRootCA(rootCA RootCA, paths CertPaths) error {
 	}
 
 	// If the root certificate got returned successfully, save the rootCA to disk.
-	return ioutils.AtomicWriteFile(paths.Cert, rootCA.Certs, 0644)
+	if err := ioutils.AtomicWriteFile(paths.Cert, rootCA.Certs, 0644); err != nil {
+		return err
+	}
+
+	// Keep the intermediates file in sync with the root certificate, so
+	// that an old chain isn't loaded along with a new root.
+	if paths.Intermediates == "" {
+		return nil
+	}
+	if len(rootCA.Intermediates) == 0 {
+		if err := os.Remove(paths.Intermediates); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return ioutils.AtomicWriteFile(paths.Intermediates, rootCA.Intermediates, 0644)
 }
 
 // GenerateNewCSR returns a newly generated key and CSR signed with said key
 func GenerateNewCSR() ([]byte, []byte, error) {
+	return BuildCSR(Subject{}, SANs{}, KeyRequest{})
+}
+
+// Subject is the subject to request in a CSR.  Empty fields are left out.
+type Subject struct {
+	CN  string
+	OU  string
+	Org string
+}
+
+// SANs are the subject alternative names to request in a CSR.
+type SANs struct {
+	DNSNames       []string
+	IPAddresses    []net.IP
+	EmailAddresses []string
+}
+
+// KeyRequest describes the key to generate for a CSR.  Algo is either "ecdsa" or "rsa", and defaults to
+// "ecdsa".  Size is in bits, and defaults to the smallest size allowed by MinimumKeyStrength for the algorithm.
+type KeyRequest struct {
+	Algo string
+	Size int
+}
+
+// BuildCSR generates a new key and a CSR for it that requests the given subject and SANs, and returns both
+// PEM encoded.  Signers apply their own policy and may override what is requested, as ParseValidateAndSignCSR
+// does with the subject, but having it in the CSR helps external signers and debugging.
+func BuildCSR(subject Subject, sans SANs, key KeyRequest) ([]byte, []byte, error) {
+	keyRequest := &cfcsr.BasicKeyRequest{A: key.Algo, S: key.Size}
+	switch keyRequest.A {
+	case "", "ecdsa":
+		keyRequest.A = "ecdsa"
+		keyRequest.S = MinimumKeyStrength.ecdsaKeySize(key.Size)
+	case "rsa":
+		if keyRequest.S == 0 {
+			keyRequest.S = MinimumKeyStrength.MinRSABits
+		}
+		if keyRequest.S < MinimumKeyStrength.MinRSABits {
+			return nil, nil, errors.Errorf("RSA keys must be at least %d bits", MinimumKeyStrength.MinRSABits)
+		}
+	default:
+		return nil, nil, errors.Errorf("unsupported key algorithm %q", key.Algo)
+	}
+
 	req := &cfcsr.CertificateRequest{
-		KeyRequest: cfcsr.NewBasicKeyRequest(),
+		CN:         subject.CN,
+		KeyRequest: keyRequest,
 	}
+	if subject.OU != "" || subject.Org != "" {
+		req.Names = []cfcsr.Name{{OU: subject.OU, O: subject.Org}}
+	}
+	// cfssl sorts hosts into DNS names, IP addresses and email addresses by parsing them
+	req.Hosts = append(req.Hosts, sans.DNSNames...)
+	for _, ip := range sans.IPAddresses {
+		req.Hosts = append(req.Hosts, ip.String())
+	}
+	req.Hosts = append(req.Hosts, sans.EmailAddresses...)
+
 	return cfcsr.ParseRequest(req)
 }
 
@@ -1,32 +1,47 @@
 package ca_test
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	cryptorand "crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"net"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	cfcsr "github.com/cloudflare/cfssl/csr"
 	"github.com/cloudflare/cfssl/helpers"
+	cfsigner "github.com/cloudflare/cfssl/signer"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/ca"
 	"github.com/docker/swarmkit/ca/testutils"
+	"github.com/docker/swarmkit/connectionbroker"
 	"github.com/docker/swarmkit/manager/state"
 	raftutils "github.com/docker/swarmkit/manager/state/raft/testutils"
 	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/docker/swarmkit/remotes"
 	"github.com/opencontainers/go-digest"
 	"github.com/phayes/permbits"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 func init() {
@@ -94,6 +109,148 @@ func TestCreateRootCAExpiry(t *testing.T) {
 	assert.True(t, time.Now().Add(duration).AddDate(0, -1, 0).Before(parsedCert.NotAfter))
 }
 
+func TestOverrideDefaultNodeCertExpiration(t *testing.T) {
+	defer func(expiry time.Duration) {
+		ca.DefaultNodeCertExpiration = expiry
+	}(ca.DefaultNodeCertExpiration)
+
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+	krw := ca.NewKeyReadWriter(ca.NewConfigPaths(tempBaseDir).Node, nil, nil)
+
+	issuedExpiry := func() time.Time {
+		rootCA, err := ca.CreateRootCA("rootCN")
+		require.NoError(t, err)
+		cert, err := rootCA.IssueAndSaveNewCertificates(krw, "cn", "ou", "org")
+		require.NoError(t, err)
+		return cert.Leaf.NotAfter
+	}
+
+	// newly issued certificates are valid for the overridden default
+	ca.DefaultNodeCertExpiration = 10 * time.Hour
+	notAfter := issuedExpiry()
+	require.WithinDuration(t, time.Now().Add(10*time.Hour), notAfter, 2*time.Minute)
+
+	// but never for less than the minimum
+	ca.DefaultNodeCertExpiration = 10 * time.Minute
+	notAfter = issuedExpiry()
+	require.WithinDuration(t, time.Now().Add(ca.MinNodeCertExpiration), notAfter, 2*time.Minute)
+}
+
+func TestMaxNodeCertExpiration(t *testing.T) {
+	defer func(expiry time.Duration) {
+		ca.MaxNodeCertExpiration = expiry
+	}(ca.MaxNodeCertExpiration)
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// a root CA created before the cap is set can't issue certificates longer than the cap
+	longLivedRootCA, err := ca.NewRootCA(rootCA.Certs, rootCA.Certs, s.Key, 1000*time.Hour, nil)
+	require.NoError(t, err)
+	ca.MaxNodeCertExpiration = 48 * time.Hour
+	_, err = longLivedRootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "longer than the maximum")
+
+	// a configured expiration longer than the cap is clamped
+	cappedRootCA, err := ca.NewRootCA(rootCA.Certs, rootCA.Certs, s.Key, 1000*time.Hour, nil)
+	require.NoError(t, err)
+	certChain, err := cappedRootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	cert, err := helpers.ParseCertificatePEM(certChain)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(48*time.Hour), cert.NotAfter, 2*time.Minute)
+
+	// shorter expirations are unaffected
+	shortRootCA, err := ca.NewRootCA(rootCA.Certs, rootCA.Certs, s.Key, 10*time.Hour, nil)
+	require.NoError(t, err)
+	certChain, err = shortRootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	cert, err = helpers.ParseCertificatePEM(certChain)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(10*time.Hour), cert.NotAfter, 2*time.Minute)
+}
+
+func TestCreateRootCAWithSubject(t *testing.T) {
+	subject := pkix.Name{
+		CommonName:         "rootCN",
+		Country:            []string{"US"},
+		Organization:       []string{"org"},
+		OrganizationalUnit: []string{"ou"},
+	}
+	rootCA, err := ca.CreateRootCAWithSubject(subject)
+	require.NoError(t, err)
+
+	root, err := helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+	require.Equal(t, "rootCN", root.Subject.CommonName)
+	require.Equal(t, []string{"US"}, root.Subject.Country)
+	require.Equal(t, []string{"org"}, root.Subject.Organization)
+	require.Equal(t, []string{"ou"}, root.Subject.OrganizationalUnit)
+	require.Equal(t, root.Subject.String(), root.Issuer.String())
+
+	// issued certificates have the full subject as their issuer
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	certChain, err := rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	leaf, err := helpers.ParseCertificatePEM(certChain)
+	require.NoError(t, err)
+	require.Equal(t, root.Subject.String(), leaf.Issuer.String())
+
+	_, err = ca.CreateRootCAWithSubject(pkix.Name{CommonName: "rootCN", PostalCode: []string{"12345"}})
+	require.Error(t, err)
+}
+
+func TestCreateRootCAWithOptions(t *testing.T) {
+	rootCA, err := ca.CreateRootCAWithOptions(pkix.Name{CommonName: "rootCN"}, ca.CreateRootCAOptions{
+		Key:           ca.KeyRequest{Algo: "rsa", Size: 3072},
+		SignatureHash: crypto.SHA256,
+	})
+	require.NoError(t, err)
+
+	root, err := helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+	require.Equal(t, x509.SHA256WithRSA, root.SignatureAlgorithm)
+	rsaKey, ok := root.PublicKey.(*rsa.PublicKey)
+	require.True(t, ok)
+	require.Equal(t, 3072, rsaKey.N.BitLen())
+	duration, err := time.ParseDuration(ca.RootCAExpiration)
+	require.NoError(t, err)
+	require.True(t, time.Now().Add(duration).AddDate(0, -1, 0).Before(root.NotAfter))
+
+	// the RSA root can sign leaf certificates which chain up to it
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	certChain, err := rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+
+	// ECDSA roots may be signed with a stronger hash than their curve
+	rootCA, err = ca.CreateRootCAWithOptions(pkix.Name{CommonName: "rootCN"}, ca.CreateRootCAOptions{SignatureHash: crypto.SHA384})
+	require.NoError(t, err)
+	root, err = helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+	require.Equal(t, x509.ECDSAWithSHA384, root.SignatureAlgorithm)
+
+	for _, opts := range []ca.CreateRootCAOptions{
+		{Key: ca.KeyRequest{Algo: "ecdsa", Size: 384}, SignatureHash: crypto.SHA256},
+		{Key: ca.KeyRequest{Algo: "rsa", Size: 3072}, SignatureHash: crypto.SHA1},
+		{Key: ca.KeyRequest{Algo: "rsa", Size: 1024}},
+		{Key: ca.KeyRequest{Algo: "dsa"}},
+	} {
+		_, err := ca.CreateRootCAWithOptions(pkix.Name{CommonName: "rootCN"}, opts)
+		require.Error(t, err, "options %+v", opts)
+	}
+}
+
 func TestGetLocalRootCA(t *testing.T) {
 	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
 	assert.NoError(t, err)
@@ -143,6 +300,88 @@ func TestGetLocalRootCA(t *testing.T) {
 	assert.EqualError(t, err, "certificate key mismatch")
 }
 
+func TestGetLocalRootCAWithIntermediates(t *testing.T) {
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+	paths := ca.NewConfigPaths(tempBaseDir)
+
+	rootCA, err := ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	require.NoError(t, ca.SaveRootCA(rootCA, paths.RootCA))
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Key, testutils.ECDSACertChainKeys[1], 0600))
+
+	reloaded, err := ca.GetLocalRootCA(paths.RootCA)
+	require.NoError(t, err)
+	require.Equal(t, rootCA.Certs, reloaded.Certs)
+	require.Equal(t, rootCA.Intermediates, reloaded.Intermediates)
+
+	// leaves issued by the reloaded root CA chain up through the intermediate
+	krw := ca.NewKeyReadWriter(paths.Node, nil, nil)
+	_, err = reloaded.IssueAndSaveNewCertificates(krw, "cn", "ou", "org")
+	require.NoError(t, err)
+	tlsCert, _, err := krw.Read()
+	require.NoError(t, err)
+	parsedIntermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	parsedCerts, err := ca.ValidateCertChain(reloaded.Pool, tlsCert, false)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 2)
+	require.Equal(t, parsedIntermediate.Raw, parsedCerts[1].Raw)
+
+	// saving a root CA without intermediates removes the old chain
+	rootCA, err = ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	require.NoError(t, ca.SaveRootCA(rootCA, paths.RootCA))
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Key, s.Key, 0600))
+	_, err = os.Stat(paths.RootCA.Intermediates)
+	require.True(t, os.IsNotExist(err))
+	reloaded, err = ca.GetLocalRootCA(paths.RootCA)
+	require.NoError(t, err)
+	require.Empty(t, reloaded.Intermediates)
+}
+
+func TestGetLocalRootCABundleKeyPosition(t *testing.T) {
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+	paths := ca.NewConfigPaths(tempBaseDir)
+
+	cert1, _, err := testutils.CreateRootCertAndKey("rootCN1")
+	require.NoError(t, err)
+	cert2, key2, err := testutils.CreateRootCertAndKey("rootCN2")
+	require.NoError(t, err)
+	_, key3, err := testutils.CreateRootCertAndKey("rootCN3")
+	require.NoError(t, err)
+
+	bundle := append(append([]byte{}, cert1...), cert2...)
+	require.NoError(t, os.MkdirAll(filepath.Dir(paths.RootCA.Cert), 0755))
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Cert, bundle, 0644))
+
+	// the key matches the second certificate in the bundle, which is then used to sign
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Key, key2, 0600))
+	rootCA, err := ca.GetLocalRootCA(paths.RootCA)
+	require.NoError(t, err)
+	require.Equal(t, bundle, rootCA.Certs)
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	require.Equal(t, cert2, s.Cert)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	signedCert, err := rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	checkSingleCert(t, signedCert, "rootCN2", "CN", ca.WorkerRole, "ORG")
+
+	// the key matches none of the certificates in the bundle
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Key, key3, 0600))
+	_, err = ca.GetLocalRootCA(paths.RootCA)
+	require.EqualError(t, err, "certificate key mismatch: the signing key does not match any of the 2 certificates in the bundle")
+}
+
 func TestGetLocalRootCAInvalidCert(t *testing.T) {
 	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
 	assert.NoError(t, err)
@@ -195,6 +434,51 @@ func TestEncryptECPrivateKey(t *testing.T) {
 	assert.Contains(t, keyBlock.Headers["DEK-Info"], "AES-256-CBC")
 }
 
+func TestBuildCSR(t *testing.T) {
+	csrBytes, keyBytes, err := ca.BuildCSR(
+		ca.Subject{CN: "node", OU: ca.WorkerRole, Org: "org"},
+		ca.SANs{
+			DNSNames:       []string{"node.example.com"},
+			IPAddresses:    []net.IP{net.ParseIP("10.0.0.1")},
+			EmailAddresses: []string{"ops@example.com"},
+		},
+		ca.KeyRequest{},
+	)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(csrBytes)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+	require.Equal(t, "node", csr.Subject.CommonName)
+	require.Equal(t, []string{ca.WorkerRole}, csr.Subject.OrganizationalUnit)
+	require.Equal(t, []string{"org"}, csr.Subject.Organization)
+	require.Equal(t, []string{"node.example.com"}, csr.DNSNames)
+	require.Len(t, csr.IPAddresses, 1)
+	require.True(t, csr.IPAddresses[0].Equal(net.ParseIP("10.0.0.1")))
+	require.Equal(t, []string{"ops@example.com"}, csr.EmailAddresses)
+
+	// the default key is an ECDSA key satisfying the key strength policy
+	key, err := helpers.ParsePrivateKeyPEM(keyBytes)
+	require.NoError(t, err)
+	require.IsType(t, &ecdsa.PrivateKey{}, key)
+	require.Equal(t, key.Public(), csr.PublicKey)
+
+	// RSA keys can be requested, but not below the minimum size
+	_, _, err = ca.BuildCSR(ca.Subject{CN: "node"}, ca.SANs{}, ca.KeyRequest{Algo: "rsa", Size: 1024})
+	require.Error(t, err)
+	_, _, err = ca.BuildCSR(ca.Subject{CN: "node"}, ca.SANs{}, ca.KeyRequest{Algo: "dsa"})
+	require.Error(t, err)
+
+	// the signer still applies its own subject
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	signedCert, err := rootCA.ParseValidateAndSignCSR(csrBytes, "CN", "OU", "ORG")
+	require.NoError(t, err)
+	checkSingleCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
+}
+
 func TestParseValidateAndSignCSR(t *testing.T) {
 	rootCA, err := ca.CreateRootCA("rootCN")
 	assert.NoError(t, err)
@@ -209,6 +493,101 @@ func TestParseValidateAndSignCSR(t *testing.T) {
 	checkSingleCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
 }
 
+// rejectCNPolicy refuses to issue certificates for a particular CN
+type rejectCNPolicy struct {
+	cn string
+}
+
+func (p rejectCNPolicy) Apply(req *ca.IssuanceRequest) error {
+	if req.CN == p.cn {
+		return errors.Errorf("CN %s is not allowed", req.CN)
+	}
+	return ca.DefaultIssuancePolicy{}.Apply(req)
+}
+
+// addSANPolicy adds a SAN to every certificate, and issues them for a fixed expiry
+type addSANPolicy struct {
+	san    string
+	expiry time.Duration
+}
+
+func (p addSANPolicy) Apply(req *ca.IssuanceRequest) error {
+	req.Hosts = append(req.Hosts, p.san)
+	req.Expiry = p.expiry
+	return ca.DefaultIssuancePolicy{}.Apply(req)
+}
+
+// allowAllPolicy issues every request as is, without the default checks
+type allowAllPolicy struct{}
+
+func (allowAllPolicy) Apply(req *ca.IssuanceRequest) error {
+	return nil
+}
+
+func TestParseValidateAndSignCSRIssuancePolicy(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	csr, key, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	rootCA.IssuancePolicy = rejectCNPolicy{cn: "forbidden"}
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "forbidden", ca.WorkerRole, "ORG")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "CN forbidden is not allowed")
+
+	signedCert, err := rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	checkSingleCert(t, signedCert, "rootCN", "CN", ca.WorkerRole, "ORG")
+
+	rootCA.IssuancePolicy = addSANPolicy{san: "node.example.com", expiry: 2 * time.Hour}
+	signedCert, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	parsed, err := helpers.ParseCertificatePEM(signedCert)
+	require.NoError(t, err)
+	require.Contains(t, parsed.DNSNames, "node.example.com")
+	require.Contains(t, parsed.DNSNames, ca.WorkerRole)
+	require.Equal(t, 2*time.Hour+ca.CertBackdate, parsed.NotAfter.Sub(parsed.NotBefore))
+
+	// the default checks still apply unless the policy skips them
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG", ca.ManagerRole)
+	require.Error(t, err)
+
+	// the policy is also applied to certificates issued for a fixed validity window, but the window wins
+	rootCA.IssuancePolicy = rejectCNPolicy{cn: "forbidden"}
+	notBefore := time.Now()
+	notAfter := time.Now().Add(time.Hour)
+	_, err = rootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, "forbidden", ca.WorkerRole, "ORG")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "CN forbidden is not allowed")
+
+	rootCA.IssuancePolicy = addSANPolicy{san: "node.example.com", expiry: 2 * time.Hour}
+	signedCert, err = rootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	parsed, err = helpers.ParseCertificatePEM(signedCert)
+	require.NoError(t, err)
+	require.Contains(t, parsed.DNSNames, "node.example.com")
+	require.Equal(t, notAfter.Unix(), parsed.NotAfter.Unix())
+
+	// a policy that skips the default checks can't skip the key checks
+	rootCA.IssuancePolicy = allowAllPolicy{}
+	parsedKey, err := helpers.ParsePrivateKeyPEM(key)
+	require.NoError(t, err)
+	fingerprint, err := ca.PublicKeyFingerprint(parsedKey.Public())
+	require.NoError(t, err)
+	defer func(blocklist ca.KeyBlocklist) {
+		ca.BlockedKeys = blocklist
+	}(ca.BlockedKeys)
+	ca.BlockedKeys = ca.NewFingerprintBlocklist(fingerprint)
+
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "blocked key")
+	_, err = rootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, "CN", ca.WorkerRole, "ORG")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "blocked key")
+}
+
 func TestParseValidateAndSignMaliciousCSR(t *testing.T) {
 	rootCA, err := ca.CreateRootCA("rootCN")
 	assert.NoError(t, err)
@@ -236,6 +615,143 @@ func TestParseValidateAndSignMaliciousCSR(t *testing.T) {
 	checkSingleCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
 }
 
+func TestParseValidateAndSignCSRSingleSubjectValues(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	req := &cfcsr.CertificateRequest{
+		Names: []cfcsr.Name{
+			{O: "org1", OU: ca.ManagerRole},
+			{O: "org2", OU: "otherOU"},
+		},
+		CN:         "CN1",
+		Hosts:      []string{"CN2", "CN3"},
+		KeyRequest: &cfcsr.BasicKeyRequest{A: "ecdsa", S: 256},
+	}
+
+	csr, _, err := cfcsr.ParseRequest(req)
+	require.NoError(t, err)
+
+	signedCert, err := rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	checkSingleCert(t, signedCert, "rootCN", "CN", ca.WorkerRole, "ORG")
+
+	parsed, err := helpers.ParseCertificatePEM(signedCert)
+	require.NoError(t, err)
+	require.Equal(t, []string{ca.WorkerRole, "CN"}, parsed.DNSNames)
+
+	// a role is always required, and the CSR's OUs are never used in its place
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", "", "ORG")
+	require.Error(t, err)
+}
+
+func TestIssueServiceIdentityCertificate(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	certChain, err := rootCA.IssueServiceIdentityCertificate(csr, "service1", "org")
+	require.NoError(t, err)
+	checkSingleCert(t, certChain, "rootCN", "service1", ca.ServiceRole, "org")
+
+	serviceID, err := ca.ValidateServiceIdentityCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.Equal(t, "service1", serviceID)
+
+	// node certificates don't identify a service
+	nodeCert, err := rootCA.ParseValidateAndSignCSR(csr, "node1", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateServiceIdentityCertChain(rootCA.Pool, nodeCert, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not a service identity certificate")
+
+	// a service identity can't be added to a node certificate either
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "node1", ca.WorkerRole, "org", ca.ServiceRole)
+	require.Error(t, err)
+
+	_, err = rootCA.IssueServiceIdentityCertificate(csr, "", "org")
+	require.Error(t, err)
+}
+
+func TestPreviewSignCSR(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	var nodesBefore []*api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		var err error
+		nodesBefore, err = store.FindNodes(tx, store.All)
+		require.NoError(t, err)
+	})
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	leaf, certChain, err := tc.RootCA.PreviewSignCSR(csr, "CN", ca.WorkerRole, tc.Organization)
+	require.NoError(t, err)
+	require.NotNil(t, leaf.SerialNumber)
+	require.True(t, leaf.NotAfter.After(leaf.NotBefore))
+	checkSingleCert(t, certChain, "swarm-test-CA", "CN", ca.WorkerRole, tc.Organization)
+
+	// the previewed certificate validates against the root
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: tc.RootCA.Pool})
+	require.NoError(t, err)
+
+	// nothing was persisted
+	var nodesAfter []*api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		nodesAfter, err = store.FindNodes(tx, store.All)
+		require.NoError(t, err)
+	})
+	require.Equal(t, nodesBefore, nodesAfter)
+	_, err = os.Stat(tc.Paths.Node.Cert)
+	require.True(t, os.IsNotExist(err))
+
+	// invalid CSRs are rejected just like when signing for real
+	_, _, err = tc.RootCA.PreviewSignCSR([]byte("not a csr"), "CN", ca.WorkerRole, tc.Organization)
+	require.Error(t, err)
+}
+
+func TestSignCSRFile(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+
+	csrPath := filepath.Join(tempBaseDir, "node.csr")
+	certPath := filepath.Join(tempBaseDir, "out", "node.crt")
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(csrPath, csr, 0600))
+
+	require.NoError(t, ca.SignCSRFile(rootCA, csrPath, certPath, "CN", ca.WorkerRole, "ORG"))
+
+	certBytes, err := ioutil.ReadFile(certPath)
+	require.NoError(t, err)
+	checkSingleCert(t, certBytes, "rootCN", "CN", ca.WorkerRole, "ORG")
+	_, err = ca.ValidateCertChain(rootCA.Pool, certBytes, false)
+	require.NoError(t, err)
+
+	perms, err := permbits.Stat(certPath)
+	require.NoError(t, err)
+	require.False(t, perms.GroupWrite())
+	require.False(t, perms.OtherWrite())
+
+	// an invalid CSR is rejected, and no certificate is written
+	require.NoError(t, ioutil.WriteFile(csrPath, []byte("not a CSR"), 0600))
+	require.Error(t, ca.SignCSRFile(rootCA, csrPath, certPath+"2", "CN", ca.WorkerRole, "ORG"))
+	_, err = os.Stat(certPath + "2")
+	require.True(t, os.IsNotExist(err))
+
+	// a missing CSR file is an error
+	require.Error(t, ca.SignCSRFile(rootCA, filepath.Join(tempBaseDir, "missing.csr"), certPath, "CN", ca.WorkerRole, "ORG"))
+}
+
 func TestGetRemoteCA(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -306,6 +822,58 @@ func TestGetRemoteCA(t *testing.T) {
 	}
 }
 
+func TestGetRemoteCAWithCN(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	d := digest.FromBytes(tc.RootCA.Certs)
+
+	// the fingerprint check still applies when a common name is expected
+	_, err := ca.GetRemoteCAWithCN(tc.Context, digest.FromBytes([]byte("wrong")), tc.ConnBroker, "swarm-test-CA")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "remote CA does not match fingerprint")
+
+	downloadedRootCA, err := ca.GetRemoteCAWithCN(tc.Context, d, tc.ConnBroker, "swarm-test-CA")
+	require.NoError(t, err)
+	require.Equal(t, tc.RootCA.Certs, downloadedRootCA.Certs)
+
+	// the right fingerprint with the wrong common name is rejected
+	_, err = ca.GetRemoteCAWithCN(tc.Context, d, tc.ConnBroker, "some-other-CA")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `remote CA common name "swarm-test-CA" does not match the expected common name "some-other-CA"`)
+}
+
+func TestGetRemoteCAIfModified(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	d := digest.FromBytes(tc.RootCA.Certs)
+
+	downloadedRootCA, etag, modified, err := ca.GetRemoteCAIfModified(tc.Context, d, tc.ConnBroker, "")
+	require.NoError(t, err)
+	require.True(t, modified)
+	require.NotEmpty(t, etag)
+	require.Equal(t, tc.RootCA.Certs, downloadedRootCA.Certs)
+
+	// fetching again with the same ETag doesn't transfer the bundle, since the root hasn't changed
+	downloadedRootCA, newETag, modified, err := ca.GetRemoteCAIfModified(tc.Context, d, tc.ConnBroker, etag)
+	require.NoError(t, err)
+	require.False(t, modified)
+	require.Equal(t, etag, newETag)
+	require.Empty(t, downloadedRootCA.Certs)
+
+	// a stale ETag gets the current bundle, which is still checked against the fingerprint
+	downloadedRootCA, newETag, modified, err = ca.GetRemoteCAIfModified(tc.Context, d, tc.ConnBroker, "stale")
+	require.NoError(t, err)
+	require.True(t, modified)
+	require.Equal(t, etag, newETag)
+	require.Equal(t, tc.RootCA.Certs, downloadedRootCA.Certs)
+
+	_, _, _, err = ca.GetRemoteCAIfModified(tc.Context, digest.FromBytes([]byte("wrong")), tc.ConnBroker, "stale")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "remote CA does not match fingerprint")
+}
+
 func TestGetRemoteCAInvalidHash(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -387,9 +955,263 @@ func TestRequestAndSaveNewCertificates(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRequestAndSaveNewCertificatesErrorCategories(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	// a bad join token is rejected by the CA, so retrying won't help
+	_, err := tc.RootCA.RequestAndSaveNewCertificates(tc.Context, tc.KeyReadWriter,
+		ca.CertificateRequestConfig{
+			Token:      "SWMTKN-1-invalid",
+			ConnBroker: tc.ConnBroker,
+		})
+	require.Error(t, err)
+	require.True(t, ca.IsTerminalError(err))
+	require.False(t, ca.IsTransientError(err))
+
+	// a manager that can't be reached may come back later
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unreachable := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	_, err = tc.RootCA.RequestAndSaveNewCertificates(tc.Context, tc.KeyReadWriter,
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: connectionbroker.New(remotes.NewRemotes(api.Peer{Addr: unreachable})),
+		})
+	require.Error(t, err)
+	require.True(t, ca.IsTransientError(err))
+	require.False(t, ca.IsTerminalError(err))
+
+	// a certificate that can't be saved locally won't be saved on retry either
+	writeErr := errors.New("disk full")
+	_, err = tc.RootCA.RequestAndSaveNewCertificates(tc.Context, failingKeyWriter{tc.KeyReadWriter, writeErr},
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: tc.ConnBroker,
+		})
+	require.Error(t, err)
+	require.True(t, ca.IsTerminalError(err))
+	require.False(t, ca.IsTransientError(err))
+	require.Equal(t, writeErr, errors.Cause(err))
+}
+
+// failingKeyWriter is a KeyWriter whose writes always fail
+type failingKeyWriter struct {
+	*ca.KeyReadWriter
+	err error
+}
+
+func (f failingKeyWriter) Write(certPEM, keyPEM []byte, kekUpdate *ca.KEKData) error {
+	return f.err
+}
+
+func TestRequestAndSaveNewCertificatesRenewalKeepsNodeIdentity(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	securityConfig, err := tc.WriteNewNodeConfig(ca.WorkerRole)
+	require.NoError(t, err)
+	nodeID := securityConfig.ClientTLSCreds.NodeID()
+
+	var nodesBefore []*api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		nodesBefore, err = store.FindNodes(tx, store.All)
+	})
+	require.NoError(t, err)
+
+	// renew twice with a brand new CSR each time - the CA should recognize the node
+	// by its TLS identity and update the existing node object rather than adding one
+	for i := 0; i < 2; i++ {
+		cert, err := tc.RootCA.RequestAndSaveNewCertificates(tc.Context, tc.KeyReadWriter,
+			ca.CertificateRequestConfig{
+				ConnBroker:  tc.ConnBroker,
+				Credentials: securityConfig.ClientTLSCreds,
+			})
+		require.NoError(t, err)
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		require.Equal(t, nodeID, parsed.Subject.CommonName)
+	}
+
+	var (
+		nodesAfter []*api.Node
+		node       *api.Node
+	)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		nodesAfter, err = store.FindNodes(tx, store.All)
+		node = store.GetNode(tx, nodeID)
+	})
+	require.NoError(t, err)
+	require.Len(t, nodesAfter, len(nodesBefore))
+	require.NotNil(t, node)
+	require.Equal(t, api.IssuanceStateIssued, node.Certificate.Status.State)
+}
+
 // TODO(cyli):  add test for RequestAndSaveNewCertificates but with intermediates - this involves adding
 // support for appending intermediates on the CA server first
 
+func TestIssueAndSaveNewCertificatesWithLeaf(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	cert, leaf, err := tc.RootCA.IssueAndSaveNewCertificatesWithLeaf(tc.KeyReadWriter, "CN", ca.WorkerRole, tc.Organization)
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+	require.NotNil(t, leaf)
+	require.Equal(t, leaf, cert.Leaf)
+	require.Equal(t, "CN", leaf.Subject.CommonName)
+	require.Equal(t, []string{ca.WorkerRole}, leaf.Subject.OrganizationalUnit)
+	require.Equal(t, []string{tc.Organization}, leaf.Subject.Organization)
+
+	// the parsed leaf is the certificate that was written to disk
+	certBytes, err := ioutil.ReadFile(tc.Paths.Node.Cert)
+	require.NoError(t, err)
+	onDisk, err := helpers.ParseCertificatePEM(certBytes)
+	require.NoError(t, err)
+	require.True(t, onDisk.Equal(leaf))
+	require.Equal(t, onDisk.SerialNumber, leaf.SerialNumber)
+	require.Equal(t, onDisk.NotAfter, leaf.NotAfter)
+}
+
+func TestGenerateOCSPResponse(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	issuer, err := helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+
+	tempdir, err := ioutil.TempDir("", "test-ocsp")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+	krw := ca.NewKeyReadWriter(ca.NewConfigPaths(tempdir).Node, nil, nil)
+	_, leaf, err := rootCA.IssueAndSaveNewCertificatesWithLeaf(krw, "CN", ca.WorkerRole, "org")
+	require.NoError(t, err)
+
+	nextUpdate := time.Now().Add(time.Hour)
+	revokedAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	for _, status := range []ca.OCSPStatus{ca.OCSPGood, ca.OCSPRevoked} {
+		der, err := rootCA.GenerateOCSPResponse(leaf.SerialNumber, status, revokedAt, nextUpdate)
+		require.NoError(t, err)
+
+		parsed, err := ca.ParseOCSPResponse(der, issuer)
+		require.NoError(t, err)
+		require.Equal(t, status, parsed.Status)
+		require.Equal(t, 0, leaf.SerialNumber.Cmp(parsed.SerialNumber))
+		require.Equal(t, nextUpdate.Unix(), parsed.NextUpdate.Unix())
+		require.False(t, parsed.ThisUpdate.After(time.Now()))
+		if status == ca.OCSPRevoked {
+			require.True(t, revokedAt.Equal(parsed.RevokedAt))
+		} else {
+			require.True(t, parsed.RevokedAt.IsZero())
+		}
+	}
+
+	// revoked certificates need a revocation time that isn't in the future
+	_, err = rootCA.GenerateOCSPResponse(leaf.SerialNumber, ca.OCSPRevoked, time.Time{}, nextUpdate)
+	require.Error(t, err)
+	_, err = rootCA.GenerateOCSPResponse(leaf.SerialNumber, ca.OCSPRevoked, time.Now().Add(time.Hour), nextUpdate)
+	require.Error(t, err)
+
+	// the response does not validate against a different issuer
+	otherRootCA, err := ca.CreateRootCA("otherCN")
+	require.NoError(t, err)
+	otherIssuer, err := helpers.ParseCertificatePEM(otherRootCA.Certs)
+	require.NoError(t, err)
+	der, err := rootCA.GenerateOCSPResponse(leaf.SerialNumber, ca.OCSPGood, time.Time{}, nextUpdate)
+	require.NoError(t, err)
+	_, err = ca.ParseOCSPResponse(der, otherIssuer)
+	require.Error(t, err)
+
+	// a next update in the past is rejected
+	_, err = rootCA.GenerateOCSPResponse(leaf.SerialNumber, ca.OCSPGood, time.Time{}, time.Now().Add(-time.Hour))
+	require.Error(t, err)
+
+	// a root CA without a signer cannot generate OCSP responses
+	noSigner, err := ca.NewRootCA(rootCA.Certs, nil, nil, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, err = noSigner.GenerateOCSPResponse(leaf.SerialNumber, ca.OCSPGood, time.Time{}, nextUpdate)
+	require.Equal(t, ca.ErrNoValidSigner, err)
+}
+
+// ocspFixtureIssuer and ocspFixtureResponse were generated with the OpenSSL OCSP responder:
+//
+//	openssl ocsp -index index.txt -rsigner ca.crt -rkey ca.key -CA ca.crt -reqin req.der -respout resp.der \
+//	  -resp_key_id -resp_no_certs -rmd sha256 -ndays 36500
+//
+// The response states that serial 0x1234 was revoked at 2020-01-02 03:04:05 UTC.
+var (
+	ocspFixtureIssuer = []byte(`-----BEGIN CERTIFICATE-----
+MIIBhTCCASugAwIBAgIUdpsxKvdQr/Ki/rA04cmKL8UwrrswCgYIKoZIzj0EAwIw
+FzEVMBMGA1UEAwwMb2NzcC10ZXN0LWNhMCAXDTI2MTAxNjE0MzAwNVoYDzIxMjYw
+OTIyMTQzMDA1WjAXMRUwEwYDVQQDDAxvY3NwLXRlc3QtY2EwWTATBgcqhkjOPQIB
+BggqhkjOPQMBBwNCAASek/Y24jZlbd5Uan1+yAFpurvBUhS5w8LQqWl/4dhReH0y
+zLKnwRBT6Tip9S7cnYfwLM93+lQAK8nduS9Lmh4jo1MwUTAdBgNVHQ4EFgQU0tLX
+ml0pxEwnbppJWeGuBhsC6EMwHwYDVR0jBBgwFoAU0tLXml0pxEwnbppJWeGuBhsC
+6EMwDwYDVR0TAQH/BAUwAwEB/zAKBggqhkjOPQQDAgNIADBFAiA75vFPm+kdQNw8
+i1mIVH/BCj4POfBiKHXjIhpChwoyPAIhAP54no42vYrJEvOD28OO6XEx/Vt6MRvM
+A2LmwtskvWNg
+-----END CERTIFICATE-----
+`)
+	ocspFixtureResponse = `MIIBFwoBAKCCARAwggEMBgkrBgEFBQcwAQEEgf4wgfswgaGiFgQU0tLXml0pxEwnbppJWeGuBhsC
+6EMYDzIwMjYxMDE2MTQzMDA1WjB2MHQwOzAJBgUrDgMCGgUABBTFEGf6qAe3PRBm1X12PAJAc0JP
+OQQU0tLXml0pxEwnbppJWeGuBhsC6EMCAhI0oREYDzIwMjAwMTAyMDMwNDA1WhgPMjAyNjEwMTYx
+NDMwMDVaoBEYDzIxMjYwOTIyMTQzMDA1WjAKBggqhkjOPQQDAgNJADBGAiEA/8oerH0nKvTlDkgF
+LK04ArSQOdTHc6VWOVnhRYeueAwCIQCgU0P81FHBkGb+M50a6JardVvPmk4akxnFAeCpSNNyew==`
+)
+
+func TestParseOCSPResponseFromOpenSSL(t *testing.T) {
+	issuer, err := helpers.ParseCertificatePEM(ocspFixtureIssuer)
+	require.NoError(t, err)
+	der, err := base64.StdEncoding.DecodeString(strings.Replace(ocspFixtureResponse, "\n", "", -1))
+	require.NoError(t, err)
+
+	parsed, err := ca.ParseOCSPResponse(der, issuer)
+	require.NoError(t, err)
+	require.Equal(t, ca.OCSPRevoked, parsed.Status)
+	require.Equal(t, 0, big.NewInt(0x1234).Cmp(parsed.SerialNumber))
+	require.True(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC).Equal(parsed.RevokedAt))
+	require.True(t, parsed.ThisUpdate.Before(parsed.NextUpdate))
+
+	// a corrupted signature is detected
+	der[len(der)-1] ^= 0xff
+	_, err = ca.ParseOCSPResponse(der, issuer)
+	require.Error(t, err)
+}
+
+func TestIssueAndSaveNewCertificatesAdditionalOUs(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	cert, err := tc.RootCA.IssueAndSaveNewCertificates(tc.KeyReadWriter, "CN", ca.ManagerRole, tc.Organization, "gpu")
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	require.Len(t, leaf.Subject.OrganizationalUnit, 2)
+	require.Contains(t, leaf.Subject.OrganizationalUnit, ca.ManagerRole)
+	require.Contains(t, leaf.Subject.OrganizationalUnit, "gpu")
+	require.Equal(t, []string{tc.Organization}, leaf.Subject.Organization)
+
+	// the role is still parsed as the manager role
+	subject, err := ca.GetAndValidateCertificateSubject([]tls.Certificate{*cert})
+	require.NoError(t, err)
+	require.Equal(t, []string{ca.ManagerRole, "gpu"}, subject.OrganizationalUnit)
+	creds, err := tc.RootCA.NewServerTLSCredentials(cert)
+	require.NoError(t, err)
+	require.Equal(t, ca.ManagerRole, creds.Role())
+
+	// additional OUs can't be roles, empty, or repeated
+	for _, additionalOUs := range [][]string{
+		{ca.WorkerRole},
+		{""},
+		{"gpu", "gpu"},
+		{ca.ManagerRole},
+	} {
+		_, err := tc.RootCA.IssueAndSaveNewCertificates(tc.KeyReadWriter, "CN", ca.ManagerRole, tc.Organization, additionalOUs...)
+		require.Error(t, err)
+	}
+}
+
 func TestIssueAndSaveNewCertificates(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -422,6 +1244,52 @@ func TestIssueAndSaveNewCertificates(t *testing.T) {
 	checkSingleCert(t, certBytes, "swarm-test-CA", "CN", ca.WorkerRole, tc.Organization)
 }
 
+func TestIssueAndSaveNewCertificatesWithChainFile(t *testing.T) {
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+
+	paths := ca.NewConfigPaths(tempBaseDir)
+	paths.Node.Chain = filepath.Join(tempBaseDir, "swarm-node-chain.crt")
+	kw := ca.NewKeyReadWriter(paths.Node, nil, nil)
+
+	rootCA, err := ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+
+	_, err = rootCA.IssueAndSaveNewCertificates(kw, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+
+	perms, err := permbits.Stat(paths.Node.Chain)
+	require.NoError(t, err)
+	require.False(t, perms.GroupWrite())
+	require.False(t, perms.OtherWrite())
+
+	// the chain file has just the intermediate, and the cert file still has the full chain
+	chainBytes, err := ioutil.ReadFile(paths.Node.Chain)
+	require.NoError(t, err)
+	chain, err := helpers.ParseCertificatesPEM(chainBytes)
+	require.NoError(t, err)
+	require.Len(t, chain, 1)
+	intermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	require.Equal(t, intermediate.Raw, chain[0].Raw)
+
+	certBytes, err := ioutil.ReadFile(paths.Node.Cert)
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(rootCA.Pool, certBytes, false)
+	require.NoError(t, err)
+
+	// issuing a certificate without intermediates keeps the chain file in sync
+	rootCA, err = ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	_, err = rootCA.IssueAndSaveNewCertificates(kw, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	chainBytes, err = ioutil.ReadFile(paths.Node.Chain)
+	require.NoError(t, err)
+	require.Empty(t, chainBytes)
+}
+
 func TestGetRemoteSignedCertificate(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -446,20 +1314,110 @@ func TestGetRemoteSignedCertificate(t *testing.T) {
 	assert.True(t, time.Now().Add(ca.DefaultNodeCertExpiration).AddDate(0, 0, 1).After(parsedCerts[0].NotAfter))
 	assert.Equal(t, parsedCerts[0].Subject.OrganizationalUnit[0], ca.ManagerRole)
 
-	// Test the expiration for an worker certificate
+	// Test the expiration for an worker certificate
+	certs, err = ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: tc.ConnBroker,
+		})
+	assert.NoError(t, err)
+	assert.NotNil(t, certs)
+	parsedCerts, err = helpers.ParseCertificatesPEM(certs)
+	assert.NoError(t, err)
+	assert.Len(t, parsedCerts, 1)
+	assert.True(t, time.Now().Add(ca.DefaultNodeCertExpiration).AddDate(0, 0, -1).Before(parsedCerts[0].NotAfter))
+	assert.True(t, time.Now().Add(ca.DefaultNodeCertExpiration).AddDate(0, 0, 1).After(parsedCerts[0].NotAfter))
+	assert.Equal(t, parsedCerts[0].Subject.OrganizationalUnit[0], ca.WorkerRole)
+}
+
+func TestGetRemoteSignedCertificateRequestedExpiry(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// a shorter expiry than the cluster's is honored
+	certs, err := ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
+		ca.CertificateRequestConfig{
+			Token:           tc.WorkerToken,
+			ConnBroker:      tc.ConnBroker,
+			RequestedExpiry: time.Hour,
+		})
+	require.NoError(t, err)
+	parsedCerts, err := helpers.ParseCertificatesPEM(certs)
+	require.NoError(t, err)
+	require.NotEmpty(t, parsedCerts)
+	if testutils.External {
+		// external CAs apply their own policy
+		require.True(t, time.Now().Add(ca.DefaultNodeCertExpiration).AddDate(0, 0, -1).Before(parsedCerts[0].NotAfter))
+		return
+	}
+	require.Equal(t, time.Hour+ca.CertBackdate, parsedCerts[0].NotAfter.Sub(parsedCerts[0].NotBefore))
+
+	// a longer one isn't
+	certs, err = ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
+		ca.CertificateRequestConfig{
+			Token:           tc.WorkerToken,
+			ConnBroker:      tc.ConnBroker,
+			RequestedExpiry: 2 * ca.DefaultNodeCertExpiration,
+		})
+	require.NoError(t, err)
+	parsedCerts, err = helpers.ParseCertificatesPEM(certs)
+	require.NoError(t, err)
+	require.NotEmpty(t, parsedCerts)
+	require.Equal(t, ca.DefaultNodeCertExpiration+ca.CertBackdate, parsedCerts[0].NotAfter.Sub(parsedCerts[0].NotBefore))
+}
+
+func TestGetRemoteSignedCertificateIncludeRoot(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	// the CA appends its intermediate followed by the self-signed root
+	rootCA, err := ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, append(testutils.ECDSACertChain[1], testutils.ECDSACertChain[2]...))
+	require.NoError(t, err)
+	tc := testutils.NewTestCAFromRootCA(t, tempdir, rootCA, nil)
+	defer tc.Stop()
+
+	intermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	root, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[2])
+	require.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// by default, the root is omitted
+	certs, err := ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: tc.ConnBroker,
+		})
+	require.NoError(t, err)
+	parsedCerts, err := helpers.ParseCertificatesPEM(certs)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 2)
+	require.Equal(t, intermediate.Raw, parsedCerts[1].Raw)
+
+	// the leaf and intermediates validate against the root pool
+	_, err = ca.ValidateCertChain(tc.RootCA.Pool, certs, false)
+	require.NoError(t, err)
+
+	// when asked to, the root anchoring the chain is appended
 	certs, err = ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
 		ca.CertificateRequestConfig{
-			Token:      tc.WorkerToken,
-			ConnBroker: tc.ConnBroker,
+			Token:       tc.WorkerToken,
+			ConnBroker:  tc.ConnBroker,
+			IncludeRoot: true,
 		})
-	assert.NoError(t, err)
-	assert.NotNil(t, certs)
+	require.NoError(t, err)
 	parsedCerts, err = helpers.ParseCertificatesPEM(certs)
-	assert.NoError(t, err)
-	assert.Len(t, parsedCerts, 1)
-	assert.True(t, time.Now().Add(ca.DefaultNodeCertExpiration).AddDate(0, 0, -1).Before(parsedCerts[0].NotAfter))
-	assert.True(t, time.Now().Add(ca.DefaultNodeCertExpiration).AddDate(0, 0, 1).After(parsedCerts[0].NotAfter))
-	assert.Equal(t, parsedCerts[0].Subject.OrganizationalUnit[0], ca.WorkerRole)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 3)
+	require.Equal(t, intermediate.Raw, parsedCerts[1].Raw)
+	require.Equal(t, root.Raw, parsedCerts[2].Raw)
 }
 
 func TestGetRemoteSignedCertificateNodeInfo(t *testing.T) {
@@ -517,6 +1475,89 @@ func TestGetRemoteSignedCertificateWithPending(t *testing.T) {
 	assert.NoError(t, <-completed)
 }
 
+// unavailableNodeCA is a NodeCA server that rejects every request as unavailable
+type unavailableNodeCA struct {
+	issueRequests chan struct{}
+}
+
+func (u *unavailableNodeCA) IssueNodeCertificate(context.Context, *api.IssueNodeCertificateRequest) (*api.IssueNodeCertificateResponse, error) {
+	u.issueRequests <- struct{}{}
+	return nil, grpc.Errorf(codes.Unavailable, "manager is unavailable")
+}
+
+func (u *unavailableNodeCA) NodeCertificateStatus(context.Context, *api.NodeCertificateStatusRequest) (*api.NodeCertificateStatusResponse, error) {
+	return nil, grpc.Errorf(codes.Unavailable, "manager is unavailable")
+}
+
+// orderedRemotes always selects the first of its peers that is not excluded
+type orderedRemotes struct {
+	remotes.Remotes
+	peers []api.Peer
+}
+
+func (o *orderedRemotes) Select(excludes ...string) (api.Peer, error) {
+Loop:
+	for _, peer := range o.peers {
+		for _, exclude := range excludes {
+			if peer.Addr == exclude {
+				continue Loop
+			}
+		}
+		return peer, nil
+	}
+	return api.Peer{}, errors.New("no remotes")
+}
+
+func TestGetRemoteSignedCertificateRetriesSubmission(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	managerConfig, err := tc.NewNodeConfig(ca.ManagerRole)
+	require.NoError(t, err)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unavailable := &unavailableNodeCA{issueRequests: make(chan struct{}, 10)}
+	grpcServer := grpc.NewServer(grpc.Creds(managerConfig.ServerTLSCreds))
+	api.RegisterNodeCAServer(grpcServer, unavailable)
+	go grpcServer.Serve(l)
+	defer grpcServer.Stop()
+
+	// the unavailable manager is always tried first
+	connBroker := connectionbroker.New(&orderedRemotes{
+		Remotes: remotes.NewRemotes(),
+		peers:   []api.Peer{{Addr: l.Addr().String()}, {Addr: tc.Addr}},
+	})
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// without retries, the request fails
+	_, err = ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: connBroker,
+		})
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable, grpc.Code(errors.Cause(err)))
+	require.Len(t, unavailable.issueRequests, 1)
+	<-unavailable.issueRequests
+
+	// with a retry, the request is sent to the other manager, which issues the certificate
+	certs, err := ca.GetRemoteSignedCertificate(tc.Context, csr, tc.RootCA.Pool,
+		ca.CertificateRequestConfig{
+			Token:         tc.WorkerToken,
+			ConnBroker:    connBroker,
+			SubmitRetries: 1,
+		})
+	require.NoError(t, err)
+	require.Len(t, unavailable.issueRequests, 1)
+	parsedCerts, err := helpers.ParseCertificatesPEM(certs)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 1)
+	require.Equal(t, ca.WorkerRole, parsedCerts[0].Subject.OrganizationalUnit[0])
+}
+
 func TestNewRootCA(t *testing.T) {
 	for _, pair := range []struct{ cert, key []byte }{
 		{cert: testutils.ECDSA256SHA256Cert, key: testutils.ECDSA256Key},
@@ -570,6 +1611,117 @@ func TestNewRootCABundle(t *testing.T) {
 	checkSingleCert(t, certBytes, "rootCN1", "CN", "OU", "ORG")
 }
 
+func TestNewRootCABundleDuplicates(t *testing.T) {
+	firstRootCA, err := ca.CreateRootCA("rootCN1")
+	require.NoError(t, err)
+	secondRootCA, err := ca.CreateRootCA("rootCN2")
+	require.NoError(t, err)
+	s, err := firstRootCA.Signer()
+	require.NoError(t, err)
+
+	// the same root appearing multiple times in the bundle is only added once
+	bundle := append(append(append([]byte{}, firstRootCA.Certs...), secondRootCA.Certs...), firstRootCA.Certs...)
+	newRootCA, err := ca.NewRootCA(bundle, firstRootCA.Certs, s.Key, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	require.Len(t, newRootCA.Pool.Subjects(), 2)
+	parsedCerts, err := helpers.ParseCertificatesPEM(newRootCA.Certs)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 2)
+	require.Equal(t, "rootCN1", parsedCerts[0].Subject.CommonName)
+	require.Equal(t, "rootCN2", parsedCerts[1].Subject.CommonName)
+	require.Equal(t, digest.FromBytes(newRootCA.Certs), newRootCA.Digest)
+
+	// two different roots with the same subject are rejected
+	collidingRootCA, err := ca.CreateRootCA("rootCN1")
+	require.NoError(t, err)
+	bundle = append(append([]byte{}, firstRootCA.Certs...), collidingRootCA.Certs...)
+	_, err = ca.NewRootCA(bundle, firstRootCA.Certs, s.Key, ca.DefaultNodeCertExpiration, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "multiple roots with subject")
+}
+
+func TestKeyStrengthPolicy(t *testing.T) {
+	// RSA-2048 is accepted by default
+	_, err := ca.NewRootCA(testutils.RSA2048SHA256Cert, testutils.RSA2048SHA256Cert, testutils.RSA2048Key,
+		ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	defer func(policy ca.KeyStrengthPolicy) {
+		ca.MinimumKeyStrength = policy
+	}(ca.MinimumKeyStrength)
+	ca.MinimumKeyStrength = ca.KeyStrengthPolicy{MinRSABits: 3072, MinECDSABits: 384}
+
+	_, err = ca.NewRootCA(testutils.RSA2048SHA256Cert, testutils.RSA2048SHA256Cert, testutils.RSA2048Key,
+		ca.DefaultNodeCertExpiration, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported RSA key parameters")
+
+	// new root CAs are created with a key that satisfies the policy
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	parsed, err := helpers.ParseCertificatePEM(s.Cert)
+	require.NoError(t, err)
+	require.Equal(t, elliptic.P384(), parsed.PublicKey.(*ecdsa.PublicKey).Curve)
+
+	// a CSR with a P-256 key is rejected, but newly generated CSRs satisfy the policy
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported ECDSA key parameters")
+
+	csr, _, err = ca.GenerateNewCSR()
+	require.NoError(t, err)
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+}
+
+func TestKeyBlocklist(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	parsedRoot, err := helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+	rootFingerprint, err := ca.PublicKeyFingerprint(parsedRoot.PublicKey)
+	require.NoError(t, err)
+
+	csr, key, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	parsedKey, err := helpers.ParsePrivateKeyPEM(key)
+	require.NoError(t, err)
+	csrFingerprint, err := ca.PublicKeyFingerprint(parsedKey.Public())
+	require.NoError(t, err)
+
+	// nothing is blocked by default
+	_, err = ca.NewRootCA(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+
+	defer func(blocklist ca.KeyBlocklist) {
+		ca.BlockedKeys = blocklist
+	}(ca.BlockedKeys)
+	// fingerprints are matched whatever their case and separators
+	ca.BlockedKeys = ca.NewFingerprintBlocklist(strings.ToUpper(rootFingerprint), csrFingerprint[:2]+":"+csrFingerprint[2:])
+
+	_, err = ca.NewRootCA(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "blocked key")
+
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "blocked key")
+
+	// other keys are still accepted
+	csr, _, err = ca.GenerateNewCSR()
+	require.NoError(t, err)
+	_, err = rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+}
+
 func TestNewRootCANonDefaultExpiry(t *testing.T) {
 	rootCA, err := ca.CreateRootCA("rootCN")
 	assert.NoError(t, err)
@@ -619,6 +1771,23 @@ func TestNewRootCAInvalidCertAndKeys(t *testing.T) {
 	notYetValidIntermediate := testutils.ReDateCert(t, testutils.ECDSACertChain[1],
 		testutils.ECDSACertChain[2], testutils.ECDSACertChainKeys[2], now.Add(time.Hour), now.Add(2*time.Hour))
 
+	// reissueIntermediate re-signs the intermediate with the root's key, after changing what it is allowed to do
+	reissueIntermediate := func(keyUsage x509.KeyUsage, isCA bool) []byte {
+		intermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+		require.NoError(t, err)
+		root, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[2])
+		require.NoError(t, err)
+		rootKey, err := helpers.ParsePrivateKeyPEM(testutils.ECDSACertChainKeys[2])
+		require.NoError(t, err)
+		intermediate.KeyUsage = keyUsage
+		intermediate.IsCA = isCA
+		derBytes, err := x509.CreateCertificate(cryptorand.Reader, intermediate, root, intermediate.PublicKey, rootKey)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+	}
+	noCertSignIntermediate := reissueIntermediate(x509.KeyUsageDigitalSignature|x509.KeyUsageCRLSign, true)
+	notCAIntermediate := reissueIntermediate(x509.KeyUsageCertSign|x509.KeyUsageCRLSign, false)
+
 	invalids := []invalidNewRootCATestCase{
 		// invalid root or signer cert
 		{
@@ -772,6 +1941,20 @@ func TestNewRootCAInvalidCertAndKeys(t *testing.T) {
 			intermediates: testutils.ECDSA256SHA256Cert,
 			errorStr:      "unknown authority", // intermediates don't chain up to root
 		},
+		{
+			roots:         testutils.ECDSACertChain[2],
+			cert:          testutils.ECDSACertChain[1],
+			key:           testutils.ECDSACertChainKeys[1],
+			intermediates: noCertSignIntermediate,
+			errorStr:      "does not have the certificate signing key usage",
+		},
+		{
+			roots:         testutils.ECDSACertChain[2],
+			cert:          testutils.ECDSACertChain[1],
+			key:           testutils.ECDSACertChainKeys[1],
+			intermediates: notCAIntermediate,
+			errorStr:      "is not a CA certificate",
+		},
 	}
 
 	for i, invalid := range invalids {
@@ -781,6 +1964,299 @@ func TestNewRootCAInvalidCertAndKeys(t *testing.T) {
 	}
 }
 
+func TestNewRootCAChainBreaks(t *testing.T) {
+	intermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	unrelated, err := helpers.ParseCertificatePEM(testutils.ECDSA256SHA256Cert)
+	require.NoError(t, err)
+
+	// the intermediates are fine, but the signer isn't signed by the first intermediate
+	_, err = ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSA256SHA256Cert, testutils.ECDSA256Key,
+		ca.DefaultNodeCertExpiration, testutils.ECDSACertChain[1])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf(
+		"the chain breaks between the signing CA certificate (%s) and the first intermediate (%s)",
+		unrelated.Subject.CommonName, intermediate.Subject.CommonName))
+	require.IsType(t, x509.UnknownAuthorityError{}, errors.Cause(err))
+
+	// the intermediates form a chain, but it doesn't end at a root
+	_, err = ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, testutils.ECDSA256SHA256Cert)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf(
+		"the chain breaks between the last intermediate (1 - %s) and the root certificates", unrelated.Subject.CommonName))
+	require.IsType(t, x509.UnknownAuthorityError{}, errors.Cause(err))
+
+	// without intermediates, the signer must chain directly to the roots
+	_, err = ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, err = ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSA256SHA256Cert, testutils.ECDSA256Key,
+		ca.DefaultNodeCertExpiration, nil)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "the chain breaks")
+}
+
+func TestRootCAIntermediateCerts(t *testing.T) {
+	rootCA, err := ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	require.Equal(t, testutils.ECDSACertChain[1], rootCA.Intermediates)
+
+	intermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	intermediates, err := rootCA.IntermediateCerts()
+	require.NoError(t, err)
+	require.Len(t, intermediates, 1)
+	require.Equal(t, intermediate.Raw, intermediates[0].Raw)
+
+	// there are none without intermediates
+	rootCA, err = ca.NewRootCA(testutils.ECDSACertChain[2], nil, nil, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	intermediates, err = rootCA.IntermediateCerts()
+	require.NoError(t, err)
+	require.Empty(t, intermediates)
+}
+
+func TestCreateRootCAWithNameConstraints(t *testing.T) {
+	rootCA, err := ca.CreateRootCAWithOptions(pkix.Name{CommonName: "rootCN"}, ca.CreateRootCAOptions{
+		NameConstraints: ca.NameConstraints{
+			PermittedDNSDomains: []string{".swarm.local"},
+			ExcludedDNSDomains:  []string{"forbidden.swarm.local"},
+		},
+	})
+	require.NoError(t, err)
+
+	root, err := helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+	require.True(t, root.IsCA)
+	require.Equal(t, []string{".swarm.local"}, root.PermittedDNSDomains)
+	require.Equal(t, []string{"forbidden.swarm.local"}, root.ExcludedDNSDomains)
+	require.True(t, root.PermittedDNSDomainsCritical)
+
+	// the constraints survive loading the root CA again
+	signer, err := rootCA.Signer()
+	require.NoError(t, err)
+	rootCA, err = ca.NewRootCA(rootCA.Certs, rootCA.Certs, signer.Key, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	signer, err = rootCA.Signer()
+	require.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		host  string
+		valid bool
+	}{
+		{host: "node.swarm.local", valid: true},
+		{host: "node.example.com"},
+		{host: "node.forbidden.swarm.local"},
+	} {
+		cert, err := signer.Sign(cfsigner.SignRequest{Request: string(csr), Hosts: []string{tc.host}})
+		require.NoError(t, err)
+		_, err = ca.ValidateCertChain(rootCA.Pool, cert, false)
+		if tc.valid {
+			require.NoError(t, err, tc.host)
+		} else {
+			require.Error(t, err, tc.host)
+		}
+	}
+}
+
+func TestIssueCertificateWithNodeURI(t *testing.T) {
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+	krw := ca.NewKeyReadWriter(ca.NewConfigPaths(tempBaseDir).Node, nil, nil)
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	// no URI is added by default
+	_, leaf, err := rootCA.IssueAndSaveNewCertificatesWithLeaf(krw, "nodeID", ca.WorkerRole, "clusterID")
+	require.NoError(t, err)
+	require.Empty(t, leaf.URIs)
+
+	rootCA.NodeURIFormat = ca.SPIFFENodeURIFormat
+	_, leaf, err = rootCA.IssueAndSaveNewCertificatesWithLeaf(krw, "nodeID", ca.WorkerRole, "clusterID")
+	require.NoError(t, err)
+	require.Len(t, leaf.URIs, 1)
+	require.Equal(t, "spiffe://clusterID/node/nodeID", leaf.URIs[0].String())
+	require.Contains(t, leaf.DNSNames, "nodeID")
+
+	// the URI is kept when the certificate is issued for a different expiry, and the chain still validates
+	rootCA.IssuancePolicy = addSANPolicy{san: "extra.example.com", expiry: time.Hour}
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	certChain, err := rootCA.ParseValidateAndSignCSR(csr, "nodeID", ca.ManagerRole, "clusterID")
+	require.NoError(t, err)
+	parsed, err := ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.Len(t, parsed[0].URIs, 1)
+	require.Equal(t, "spiffe://clusterID/node/nodeID", parsed[0].URIs[0].String())
+	require.Contains(t, parsed[0].DNSNames, "extra.example.com")
+	require.WithinDuration(t, time.Now().Add(time.Hour), parsed[0].NotAfter, time.Minute)
+	// certificates issued for a fixed validity window get the URI too
+	notBefore, notAfter := time.Now(), time.Now().Add(time.Hour)
+	certChain, err = rootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, "nodeID", ca.WorkerRole, "clusterID")
+	require.NoError(t, err)
+	parsed, err = ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.Len(t, parsed[0].URIs, 1)
+	require.Equal(t, "spiffe://clusterID/node/nodeID", parsed[0].URIs[0].String())
+	require.Equal(t, notAfter.Unix(), parsed[0].NotAfter.Unix())
+
+	// but service identity certificates don't, since they don't identify a node
+	certChain, err = rootCA.IssueServiceIdentityCertificate(csr, "serviceID", "clusterID")
+	require.NoError(t, err)
+	parsed, err = ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.Empty(t, parsed[0].URIs)
+}
+
+func TestReSignLeaf(t *testing.T) {
+	oldRoot, err := ca.CreateRootCA("oldRoot")
+	require.NoError(t, err)
+	oldRoot.NodeURIFormat = ca.SPIFFENodeURIFormat
+	newRoot, err := ca.CreateRootCA("newRoot")
+	require.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	oldChain, err := oldRoot.ParseValidateAndSignCSR(csr, "cn", ca.ManagerRole, "org")
+	require.NoError(t, err)
+	oldLeaf, err := helpers.ParseCertificatePEM(oldChain)
+	require.NoError(t, err)
+
+	newChain, err := newRoot.ReSignLeaf(oldChain, time.Hour)
+	require.NoError(t, err)
+	parsed, err := ca.ValidateCertChain(newRoot.Pool, newChain, false)
+	require.NoError(t, err)
+	newLeaf := parsed[0]
+
+	_, err = ca.ValidateCertChain(oldRoot.Pool, newChain, false)
+	require.Error(t, err)
+
+	// the subject, key and SANs are kept, with a fresh serial and validity
+	require.Equal(t, oldLeaf.Subject.CommonName, newLeaf.Subject.CommonName)
+	require.Equal(t, oldLeaf.Subject.OrganizationalUnit, newLeaf.Subject.OrganizationalUnit)
+	require.Equal(t, oldLeaf.Subject.Organization, newLeaf.Subject.Organization)
+	require.Equal(t, oldLeaf.RawSubjectPublicKeyInfo, newLeaf.RawSubjectPublicKeyInfo)
+	require.Equal(t, oldLeaf.DNSNames, newLeaf.DNSNames)
+	require.Len(t, oldLeaf.URIs, 1)
+	require.Equal(t, oldLeaf.URIs, newLeaf.URIs)
+	require.Equal(t, oldLeaf.ExtKeyUsage, newLeaf.ExtKeyUsage)
+	require.NotEqual(t, oldLeaf.SerialNumber, newLeaf.SerialNumber)
+	require.WithinDuration(t, time.Now().Add(time.Hour), newLeaf.NotAfter, time.Minute)
+
+	// a validity longer than the maximum is shortened to it
+	defer func(max time.Duration) {
+		ca.MaxNodeCertExpiration = max
+	}(ca.MaxNodeCertExpiration)
+	ca.MaxNodeCertExpiration = 48 * time.Hour
+	newChain, err = newRoot.ReSignLeaf(oldChain, 1000*time.Hour)
+	require.NoError(t, err)
+	parsed, err = ca.ValidateCertChain(newRoot.Pool, newChain, false)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(48*time.Hour), parsed[0].NotAfter, time.Minute)
+
+	// CA certificates are not re-signed
+	_, err = newRoot.ReSignLeaf(oldRoot.Certs, time.Hour)
+	require.Error(t, err)
+
+	// a root CA that can't sign can't re-sign either
+	verifyOnly, err := ca.NewRootCA(newRoot.Certs, nil, nil, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, err = verifyOnly.ReSignLeaf(oldChain, time.Hour)
+	require.Equal(t, ca.ErrNoValidSigner, err)
+
+	// leaves with keys that don't satisfy the key strength policy are refused
+	defer func(policy ca.KeyStrengthPolicy) {
+		ca.MinimumKeyStrength = policy
+	}(ca.MinimumKeyStrength)
+	ca.MinimumKeyStrength = ca.KeyStrengthPolicy{MinRSABits: 3072, MinECDSABits: 384}
+	_, err = newRoot.ReSignLeaf(oldChain, time.Hour)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "key strength policy")
+}
+
+func TestIssueIntermediate(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	csr, key, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	intermediateChain, err := rootCA.IssueIntermediate(csr, "example.com")
+	require.NoError(t, err)
+
+	intermediate, err := helpers.ParseCertificatePEM(intermediateChain)
+	require.NoError(t, err)
+	require.True(t, intermediate.IsCA)
+	require.Equal(t, 0, intermediate.MaxPathLen)
+	require.True(t, intermediate.MaxPathLenZero)
+	require.Equal(t, []string{"example.com"}, intermediate.PermittedDNSDomains)
+	require.True(t, intermediate.PermittedDNSDomainsCritical)
+
+	parsedKey, err := helpers.ParsePrivateKeyPEM(key)
+	require.NoError(t, err)
+	issue := func(template, parent *x509.Certificate) *x509.Certificate {
+		template.SerialNumber = big.NewInt(1)
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = time.Now().Add(time.Hour)
+		derBytes, err := x509.CreateCertificate(cryptorand.Reader, template, parent, parsedKey.Public(), parsedKey)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(derBytes)
+		require.NoError(t, err)
+		return cert
+	}
+	intermediatePool := x509.NewCertPool()
+	intermediatePool.AddCert(intermediate)
+
+	// the intermediate can sign leaf certificates within its name constraints
+	leaf := issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "node.example.com"},
+		DNSNames:    []string{"node.example.com"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, intermediate)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: rootCA.Pool, Intermediates: intermediatePool})
+	require.NoError(t, err)
+
+	// but not outside of them
+	leaf = issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "node.example.org"},
+		DNSNames:    []string{"node.example.org"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, intermediate)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: rootCA.Pool, Intermediates: intermediatePool})
+	require.Error(t, err)
+
+	// and any CA it signs is not trusted
+	subCA := issue(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "subCA"},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, intermediate)
+	intermediatePool.AddCert(subCA)
+	leaf = issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "leaf.example.com"},
+		DNSNames:    []string{"leaf.example.com"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, subCA)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: rootCA.Pool, Intermediates: intermediatePool})
+	require.Error(t, err)
+
+	// nor will a root CA that signs with the intermediate issue further intermediates
+	intermediateRootCA, err := ca.NewRootCA(rootCA.Certs, intermediateChain, key, ca.DefaultNodeCertExpiration, intermediateChain)
+	require.NoError(t, err)
+	_, err = intermediateRootCA.IssueIntermediate(csr)
+	require.Error(t, err)
+
+	// invalid CSRs are rejected
+	_, err = rootCA.IssueIntermediate([]byte("not a csr"))
+	require.Error(t, err)
+}
+
 func TestRootCAWithCrossSignedIntermediates(t *testing.T) {
 	tempdir, err := ioutil.TempDir("", "swarm-ca-test-")
 	require.NoError(t, err)
@@ -849,21 +2325,85 @@ func TestRootCAWithCrossSignedIntermediates(t *testing.T) {
 	secConfig, err := connectToExternalRootCA.CreateSecurityConfig(context.Background(), krw, ca.CertificateRequestConfig{})
 	require.NoError(t, err)
 
-	externalCA := secConfig.ExternalCA()
-	externalCA.UpdateURLs(tc.ExternalSigningServer.URL)
-
-	newCSR, _, err := ca.GenerateNewCSR()
+	externalCA := secConfig.ExternalCA()
+	externalCA.UpdateURLs(tc.ExternalSigningServer.URL)
+
+	newCSR, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	tlsCert, err = externalCA.Sign(context.Background(), ca.PrepareCSR(newCSR, "cn", ca.ManagerRole, secConfig.ClientTLSCreds.Organization()))
+	require.NoError(t, err)
+
+	for _, root := range []ca.RootCA{signWithIntermediate, oldRoot, newRoot} {
+		parsedCerts, err = ca.ValidateCertChain(root.Pool, tlsCert, false)
+		require.NoError(t, err)
+		require.Len(t, parsedCerts, 2)
+		require.Equal(t, parsedIntermediate.Raw, parsedCerts[1].Raw)
+	}
+}
+
+func TestValidateCertChainAnchor(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+	krw := ca.NewKeyReadWriter(ca.NewConfigPaths(tempdir).Node, nil, nil)
+
+	// the new root has the same subject and key as the intermediate, which is cross-signed by the old root
+	parsedKey, err := helpers.ParsePrivateKeyPEM(testutils.ECDSACertChainKeys[1])
+	require.NoError(t, err)
+	parsedIntermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	newRootDER, err := x509.CreateCertificate(cryptorand.Reader, parsedIntermediate, parsedIntermediate, parsedKey.Public(), parsedKey)
+	require.NoError(t, err)
+	newRootCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newRootDER})
+	oldRootCert := testutils.ECDSACertChain[2]
+
+	oldRoot, err := helpers.ParseCertificatePEM(oldRootCert)
 	require.NoError(t, err)
-
-	tlsCert, err = externalCA.Sign(context.Background(), ca.PrepareCSR(newCSR, "cn", ca.ManagerRole, secConfig.ClientTLSCreds.Organization()))
+	newRoot, err := helpers.ParseCertificatePEM(newRootCert)
 	require.NoError(t, err)
 
-	for _, root := range []ca.RootCA{signWithIntermediate, oldRoot, newRoot} {
-		parsedCerts, err = ca.ValidateCertChain(root.Pool, tlsCert, false)
+	issue := func(rootCA ca.RootCA) []byte {
+		_, err := rootCA.IssueAndSaveNewCertificates(krw, "cn", "ou", "org")
 		require.NoError(t, err)
-		require.Len(t, parsedCerts, 2)
-		require.Equal(t, parsedIntermediate.Raw, parsedCerts[1].Raw)
+		certChain, _, err := krw.Read()
+		require.NoError(t, err)
+		return certChain
+	}
+	oldRootCA, err := ca.NewRootCA(oldRootCert, oldRootCert, testutils.ECDSACertChainKeys[2], ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	oldRootLeaf := issue(oldRootCA)
+	// the new root's leaves have the cross-signed intermediate appended, so they chain up to both roots
+	newRootCA, err := ca.NewRootCA(oldRootCert, testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	newRootLeaf := issue(newRootCA)
+
+	bothRoots := x509.NewCertPool()
+	bothRoots.AddCert(oldRoot)
+	bothRoots.AddCert(newRoot)
+	onlyOldRoot := x509.NewCertPool()
+	onlyOldRoot.AddCert(oldRoot)
+
+	for _, testcase := range []struct {
+		pool     *x509.CertPool
+		leaf     []byte
+		expected *x509.Certificate
+	}{
+		{pool: bothRoots, leaf: oldRootLeaf, expected: oldRoot},
+		{pool: bothRoots, leaf: newRootLeaf, expected: newRoot},
+		{pool: onlyOldRoot, leaf: oldRootLeaf, expected: oldRoot},
+		{pool: onlyOldRoot, leaf: newRootLeaf, expected: oldRoot},
+	} {
+		parsedCerts, anchor, err := ca.ValidateCertChainAnchor(testcase.pool, testcase.leaf, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, parsedCerts)
+		require.Equal(t, testcase.expected.Raw, anchor.Raw)
 	}
+
+	// the new root's leaves don't chain up to the old root without the intermediate
+	_, _, err = ca.ValidateCertChainAnchor(onlyOldRoot, newRootLeaf[:len(newRootLeaf)-len(testutils.ECDSACertChain[1])], false)
+	require.Error(t, err)
 }
 
 func TestNewRootCAWithPassphrase(t *testing.T) {
@@ -919,6 +2459,131 @@ func TestNewRootCAWithPassphrase(t *testing.T) {
 	assert.Contains(t, string(anrcaSigner.Key), "Proc-Type: 4,ENCRYPTED")
 }
 
+func TestNewRootCAWithPassphraseArguments(t *testing.T) {
+	defer os.Setenv(ca.PassphraseENVVar, "")
+
+	// two root CAs with different passphrases can be used at the same time
+	var (
+		rootCAs     []ca.RootCA
+		passphrases = [][]byte{[]byte("password1"), []byte("password2")}
+	)
+	for i, passphrase := range passphrases {
+		rootCA, err := ca.CreateRootCA(fmt.Sprintf("rootCN%d", i))
+		require.NoError(t, err)
+		s, err := rootCA.Signer()
+		require.NoError(t, err)
+
+		encrypted, err := ca.NewRootCAWithPassphrase(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil, passphrase)
+		require.NoError(t, err)
+		es, err := encrypted.Signer()
+		require.NoError(t, err)
+		require.Contains(t, string(es.Key), "Proc-Type: 4,ENCRYPTED")
+		rootCAs = append(rootCAs, encrypted)
+	}
+
+	// the passphrase in the environment is not used
+	os.Setenv(ca.PassphraseENVVar, "envpassword")
+
+	for i, rootCA := range rootCAs {
+		s, err := rootCA.Signer()
+		require.NoError(t, err)
+		other := passphrases[(i+1)%2]
+
+		_, err = ca.NewRootCAWithPassphrase(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil, passphrases[i])
+		require.NoError(t, err)
+
+		// the key can't be decrypted with the environment passphrase, or the other root CA's passphrase
+		_, err = ca.NewRootCA(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil)
+		require.Error(t, err)
+		_, err = ca.NewRootCAWithPassphrase(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil, other)
+		require.Error(t, err)
+
+		// but it can be with a previous passphrase
+		reloaded, err := ca.NewRootCAWithPassphrase(rootCA.Certs, s.Cert, s.Key, ca.DefaultNodeCertExpiration, nil, other, []byte("wrong"), passphrases[i])
+		require.NoError(t, err)
+		rs, err := reloaded.Signer()
+		require.NoError(t, err)
+		require.Equal(t, s.Key, rs.Key)
+	}
+}
+
+func TestNewRootCAWithOptionsSignerVerificationRoots(t *testing.T) {
+	primaryRootCA, err := ca.CreateRootCA("primary")
+	require.NoError(t, err)
+	otherRootCA, err := ca.CreateRootCA("other")
+	require.NoError(t, err)
+
+	// the signer is an intermediate which only chains up to the other root
+	signerRootCA, err := ca.CreateRootCA("signer")
+	require.NoError(t, err)
+	signerCert, err := otherRootCA.CrossSignCACertificate(signerRootCA.Certs)
+	require.NoError(t, err)
+	s, err := signerRootCA.Signer()
+	require.NoError(t, err)
+
+	_, err = ca.NewRootCA(primaryRootCA.Certs, signerCert, s.Key, ca.DefaultNodeCertExpiration, nil)
+	require.Error(t, err)
+
+	rootCA, err := ca.NewRootCAWithOptions(primaryRootCA.Certs, signerCert, s.Key, ca.DefaultNodeCertExpiration, nil,
+		ca.RootCAOptions{SignerVerificationRoots: otherRootCA.Certs})
+	require.NoError(t, err)
+
+	// only the primary root is advertised
+	require.Equal(t, primaryRootCA.Certs, rootCA.Certs)
+	require.Equal(t, primaryRootCA.Digest, rootCA.Digest)
+	_, err = ca.ValidateCertChain(rootCA.Pool, otherRootCA.Certs, false)
+	require.Error(t, err)
+
+	// and the signer issues certificates which chain up to the other root
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	leaf, err := rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(otherRootCA.Pool, append(leaf, signerCert...), false)
+	require.NoError(t, err)
+
+	// the extra roots must be valid roots themselves
+	_, err = ca.NewRootCAWithOptions(primaryRootCA.Certs, signerCert, s.Key, ca.DefaultNodeCertExpiration, nil,
+		ca.RootCAOptions{SignerVerificationRoots: signerCert})
+	require.Error(t, err)
+}
+
+func TestNewRootCAWithOptionsSkipKeyReencryption(t *testing.T) {
+	defer os.Setenv(ca.PassphraseENVVar, "")
+	defer os.Setenv(ca.PassphraseENVVarPrev, "")
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	rcaSigner, err := rootCA.Signer()
+	require.NoError(t, err)
+
+	os.Setenv(ca.PassphraseENVVar, "password1")
+	opts := ca.RootCAOptions{SkipKeyReencryption: true}
+
+	// an unencrypted key is left unencrypted
+	newRootCA, err := ca.NewRootCAWithOptions(rootCA.Certs, rcaSigner.Cert, rcaSigner.Key, ca.DefaultNodeCertExpiration, nil, opts)
+	require.NoError(t, err)
+	nrcaSigner, err := newRootCA.Signer()
+	require.NoError(t, err)
+	require.Equal(t, rcaSigner.Key, nrcaSigner.Key)
+
+	// by default it would have been encrypted
+	defaultRootCA, err := ca.NewRootCA(rootCA.Certs, rcaSigner.Cert, rcaSigner.Key, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	defaultSigner, err := defaultRootCA.Signer()
+	require.NoError(t, err)
+	require.Contains(t, string(defaultSigner.Key), "Proc-Type: 4,ENCRYPTED")
+
+	// a key encrypted with the previous passphrase is returned as is
+	os.Setenv(ca.PassphraseENVVar, "password2")
+	os.Setenv(ca.PassphraseENVVarPrev, "password1")
+	prevRootCA, err := ca.NewRootCAWithOptions(rootCA.Certs, rcaSigner.Cert, defaultSigner.Key, ca.DefaultNodeCertExpiration, nil, opts)
+	require.NoError(t, err)
+	prevSigner, err := prevRootCA.Signer()
+	require.NoError(t, err)
+	require.Equal(t, defaultSigner.Key, prevSigner.Key)
+}
+
 type certTestCase struct {
 	cert        []byte
 	errorStr    string
@@ -926,6 +2591,46 @@ type certTestCase struct {
 	allowExpiry bool
 }
 
+func TestOrderChain(t *testing.T) {
+	leaf, intermediate, root := testutils.ECDSACertChain[0], testutils.ECDSACertChain[1], testutils.ECDSACertChain[2]
+	expected := append(append(append([]byte{}, leaf...), intermediate...), root...)
+	expectedCerts, err := helpers.ParseCertificatesPEM(expected)
+	require.NoError(t, err)
+
+	for _, shuffled := range [][][]byte{
+		{leaf, intermediate, root},
+		{leaf, root, intermediate},
+		{intermediate, leaf, root},
+		{intermediate, root, leaf},
+		{root, leaf, intermediate},
+		{root, intermediate, leaf},
+	} {
+		ordered, err := ca.OrderChain(shuffled)
+		require.NoError(t, err)
+		orderedCerts, err := helpers.ParseCertificatesPEM(ordered)
+		require.NoError(t, err)
+		require.Equal(t, expectedCerts, orderedCerts)
+	}
+
+	// the root doesn't have to be included, and the ordered chain validates
+	ordered, err := ca.OrderChain([][]byte{intermediate, leaf})
+	require.NoError(t, err)
+	rootPool := x509.NewCertPool()
+	rootPool.AppendCertsFromPEM(root)
+	_, err = ca.ValidateCertChain(rootPool, ordered, false)
+	require.NoError(t, err)
+
+	for _, invalid := range [][][]byte{
+		nil,
+		{leaf, root},
+		{leaf, intermediate, testutils.ECDSA256SHA256Cert},
+		{leaf, leaf, intermediate},
+	} {
+		_, err := ca.OrderChain(invalid)
+		require.Error(t, err)
+	}
+}
+
 func TestValidateCertificateChain(t *testing.T) {
 	leaf, intermediate, root := testutils.ECDSACertChain[0], testutils.ECDSACertChain[1], testutils.ECDSACertChain[2]
 	intermediateKey, rootKey := testutils.ECDSACertChainKeys[1], testutils.ECDSACertChainKeys[2] // we don't care about the leaf key
@@ -1062,6 +2767,125 @@ func TestValidateCertificateChain(t *testing.T) {
 	}
 }
 
+func TestValidateCertChainSkipsNonCertificateBlocks(t *testing.T) {
+	leaf, intermediate, root := testutils.ECDSACertChain[0], testutils.ECDSACertChain[1], testutils.ECDSACertChain[2]
+	rootPool := x509.NewCertPool()
+	rootPool.AppendCertsFromPEM(root)
+
+	comment := pem.EncodeToMemory(&pem.Block{Type: "COMMENT", Bytes: []byte("issued by the test CA")})
+	var mixed []byte
+	mixed = append(mixed, comment...)
+	mixed = append(mixed, leaf...)
+	mixed = append(mixed, []byte("# the intermediate\n")...)
+	mixed = append(mixed, intermediate...)
+	mixed = append(mixed, testutils.ECDSACertChainKeys[1]...)
+	mixed = append(mixed, []byte("# end of chain\n")...)
+
+	parsedCerts, err := ca.ValidateCertChain(rootPool, mixed, false)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 2)
+	leafCert, err := helpers.ParseCertificatePEM(leaf)
+	require.NoError(t, err)
+	require.Equal(t, leafCert.Raw, parsedCerts[0].Raw)
+
+	// without any certificates, there is still nothing to validate
+	_, err = ca.ValidateCertChain(rootPool, comment, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no certificates to validate")
+
+	// a malformed certificate block is still an error
+	malformed := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("malformed")}), leaf...)
+	_, err = ca.ValidateCertChain(rootPool, append(comment, malformed...), false)
+	require.Error(t, err)
+}
+
+func TestValidateCertChainRequireFullChain(t *testing.T) {
+	leaf, intermediate, root := testutils.ECDSACertChain[0], testutils.ECDSACertChain[1], testutils.ECDSACertChain[2]
+	parsedIntermediate, err := helpers.ParseCertificatePEM(intermediate)
+	require.NoError(t, err)
+	parsedRoot, err := helpers.ParseCertificatePEM(root)
+	require.NoError(t, err)
+
+	// the pool trusts the intermediate as well as the root, so it can complete a leaf-only chain
+	pool := x509.NewCertPool()
+	pool.AddCert(parsedIntermediate)
+	pool.AddCert(parsedRoot)
+
+	certs, err := ca.ValidateCertChainWithOptions(pool, leaf, ca.CertChainOptions{})
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+
+	_, err = ca.ValidateCertChainWithOptions(pool, leaf, ca.CertChainOptions{RequireFullChain: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "full chain must be presented")
+
+	// once the intermediate is presented too, the chain reaches the root
+	certs, err = ca.ValidateCertChainWithOptions(pool, append(append([]byte{}, leaf...), intermediate...), ca.CertChainOptions{RequireFullChain: true})
+	require.NoError(t, err)
+	require.Len(t, certs, 2)
+
+	// a leaf signed by the root itself is a full chain on its own
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	rootSignedLeaf, err := rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChainWithOptions(rootCA.Pool, rootSignedLeaf, ca.CertChainOptions{RequireFullChain: true})
+	require.NoError(t, err)
+}
+
+func TestValidateCertChainWithUsage(t *testing.T) {
+	root, rootKey := testutils.ECDSACertChain[2], testutils.ECDSACertChainKeys[2]
+	parsedRoot, err := helpers.ParseCertificatePEM(root)
+	require.NoError(t, err)
+	parsedRootKey, err := helpers.ParsePrivateKeyPEM(rootKey)
+	require.NoError(t, err)
+	rootPool := x509.NewCertPool()
+	rootPool.AddCert(parsedRoot)
+
+	// a leaf that can only be used for server authentication
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	leafDER, err := x509.CreateCertificate(cryptorand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "server-only"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, parsedRoot, leafKey.Public(), parsedRootKey)
+	require.NoError(t, err)
+	leaf := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})
+
+	// no requirements, and requirements that the leaf satisfies
+	for _, usage := range []ca.LeafUsage{
+		{},
+		{KeyUsage: x509.KeyUsageDigitalSignature},
+		{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}},
+	} {
+		certs, err := ca.ValidateCertChainWithUsage(rootPool, leaf, false, usage)
+		require.NoError(t, err)
+		require.Len(t, certs, 1)
+	}
+
+	// the leaf can't be used as a client certificate
+	_, err = ca.ValidateCertChainWithUsage(rootPool, leaf, false, ca.LeafUsage{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.EqualError(t, err, "leaf certificate (server-only) extended key usage does not permit client authentication")
+
+	_, err = ca.ValidateCertChainWithUsage(rootPool, leaf, false, ca.LeafUsage{
+		KeyUsage: x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not permit the required key usage")
+
+	// the chain itself is still validated first
+	_, err = ca.ValidateCertChainWithUsage(x509.NewCertPool(), leaf, false, ca.LeafUsage{})
+	require.Error(t, err)
+}
+
 // Tests cross-signing using a certificate
 func TestRootCACrossSignCACertificate(t *testing.T) {
 	t.Parallel()
@@ -1124,3 +2948,214 @@ func TestRootCACrossSignCACertificate(t *testing.T) {
 	_, err = leafCert.Verify(x509.VerifyOptions{Roots: rootCA2.Pool, Intermediates: intermediatePool})
 	require.NoError(t, err)
 }
+
+func TestValidateCertChainExtraIntermediates(t *testing.T) {
+	rootCA1, err := ca.CreateRootCA("rootCN1")
+	require.NoError(t, err)
+	rootCA2, err := ca.CreateRootCA("rootCN2")
+	require.NoError(t, err)
+
+	// a leaf issued by the new root, presented without any intermediates
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	leaf, err := rootCA2.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+
+	// the new root cross-signed by the old one, held out-of-band
+	crossSigned, err := rootCA1.CrossSignCACertificate(rootCA2.Certs)
+	require.NoError(t, err)
+
+	// the leaf doesn't chain up to the old root on its own
+	_, err = ca.ValidateCertChain(rootCA1.Pool, leaf, false)
+	require.Error(t, err)
+
+	// but it does through the cross-signed intermediate, which isn't returned as part of the presented chain
+	certs, err := ca.ValidateCertChainWithOptions(rootCA1.Pool, leaf, ca.CertChainOptions{ExtraIntermediates: crossSigned})
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+
+	// and it still validates against the new root
+	_, err = ca.ValidateCertChainWithOptions(rootCA2.Pool, leaf, ca.CertChainOptions{ExtraIntermediates: crossSigned})
+	require.NoError(t, err)
+
+	// unrelated intermediates don't help
+	_, err = ca.ValidateCertChainWithOptions(rootCA1.Pool, leaf, ca.CertChainOptions{ExtraIntermediates: testutils.ECDSACertChain[1]})
+	require.Error(t, err)
+
+	_, err = ca.ValidateCertChainWithOptions(rootCA1.Pool, leaf, ca.CertChainOptions{ExtraIntermediates: []byte("garbage")})
+	require.Error(t, err)
+}
+
+func TestFindExpiringNodeCerts(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	issue := func(expiry time.Duration) []byte {
+		issuingCA, err := ca.NewRootCA(rootCA.Certs, rootCA.Certs, s.Key, expiry, nil)
+		require.NoError(t, err)
+		cert, err := issuingCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+		require.NoError(t, err)
+		return cert
+	}
+
+	nodeCerts := map[string][]byte{
+		"in-an-hour":   issue(time.Hour),
+		"in-two-days":  issue(48 * time.Hour),
+		"in-a-month":   issue(30 * 24 * time.Hour),
+		"not-issued":   nil,
+		"garbage-cert": []byte("not a certificate"),
+	}
+	memStore := store.NewMemoryStore(nil)
+	defer memStore.Close()
+	require.NoError(t, memStore.Update(func(tx store.Tx) error {
+		for id, cert := range nodeCerts {
+			if err := store.CreateNode(tx, &api.Node{ID: id, Certificate: api.Certificate{Certificate: cert}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	var expiring []ca.NodeCertExpiry
+	memStore.View(func(tx store.ReadTx) {
+		expiring, err = ca.FindExpiringNodeCerts(tx, 72*time.Hour)
+	})
+	require.NoError(t, err)
+	require.Len(t, expiring, 3)
+
+	require.Equal(t, "garbage-cert", expiring[0].NodeID)
+	require.Error(t, expiring[0].Err)
+	for i, id := range []string{"in-an-hour", "in-two-days"} {
+		require.Equal(t, id, expiring[i+1].NodeID)
+		require.NoError(t, expiring[i+1].Err)
+	}
+	require.True(t, expiring[1].NotAfter.Before(expiring[2].NotAfter))
+}
+
+func TestValidationCache(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	otherRootCA, err := ca.CreateRootCA("otherRootCN")
+	require.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	leaf, err := rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	now := time.Now()
+	shortLivedLeaf := testutils.ReDateCert(t, leaf, rootCA.Certs, s.Key, now.Add(-time.Hour), now.Add(time.Second))
+
+	cache := ca.NewValidationCache(time.Hour, 10)
+	for _, c := range []*ca.ValidationCache{cache, nil} {
+		// cache hits return the same result as validating from scratch
+		expected, err := ca.ValidateCertChain(rootCA.Pool, leaf, false)
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			certs, err := c.ValidateCertChain(rootCA.Pool, leaf, false)
+			require.NoError(t, err)
+			require.Equal(t, expected, certs)
+		}
+
+		// a chain that is valid for one pool isn't valid for another just because it is cached
+		for i := 0; i < 2; i++ {
+			_, err = c.ValidateCertChain(otherRootCA.Pool, leaf, false)
+			require.Error(t, err)
+		}
+	}
+
+	_, err = cache.ValidateCertChain(rootCA.Pool, shortLivedLeaf, false)
+	require.NoError(t, err)
+	_, err = cache.ValidateCertChain(rootCA.Pool, shortLivedLeaf, true)
+	require.NoError(t, err)
+
+	// once the certificate expires, the cached result doesn't apply anymore, unless expired certificates are allowed
+	time.Sleep(2 * time.Second)
+	_, err = ca.ValidateCertChain(rootCA.Pool, shortLivedLeaf, false)
+	require.Error(t, err)
+	_, err = cache.ValidateCertChain(rootCA.Pool, shortLivedLeaf, false)
+	require.Error(t, err)
+	_, err = cache.ValidateCertChain(rootCA.Pool, shortLivedLeaf, true)
+	require.NoError(t, err)
+}
+
+func benchmarkValidateCertChain(b *testing.B, validate func(*x509.CertPool, []byte, bool) ([]*x509.Certificate, error)) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(b, err)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(b, err)
+	leaf, err := rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := validate(rootCA.Pool, leaf, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidateCertChain(b *testing.B) {
+	benchmarkValidateCertChain(b, ca.ValidateCertChain)
+}
+
+func BenchmarkValidateCertChainCached(b *testing.B) {
+	benchmarkValidateCertChain(b, ca.NewValidationCache(time.Hour, 10).ValidateCertChain)
+}
+
+func TestParseValidateAndSignCSRWithValidity(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	root, err := helpers.ParseCertificatePEM(rootCA.Certs)
+	require.NoError(t, err)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	notBefore := time.Now().Truncate(time.Second).UTC()
+	notAfter := notBefore.Add(72 * time.Hour)
+	certChain, err := rootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	certs, err := ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.Equal(t, notBefore, certs[0].NotBefore)
+	require.Equal(t, notAfter, certs[0].NotAfter)
+	require.Equal(t, "cn", certs[0].Subject.CommonName)
+
+	// the regular issuance path is unaffected
+	certChain, err = rootCA.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	certs, err = ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.NotEqual(t, notAfter, certs[0].NotAfter)
+
+	for _, window := range [][2]time.Time{
+		{notAfter, notBefore},
+		{notBefore, notBefore},
+		{root.NotBefore.Add(-time.Hour), notAfter},
+		{notBefore, root.NotAfter.Add(time.Hour)},
+	} {
+		_, err = rootCA.ParseValidateAndSignCSRWithValidity(csr, window[0], window[1], "cn", ca.WorkerRole, "org")
+		require.Error(t, err)
+	}
+
+	defer func(max time.Duration) {
+		ca.MaxNodeCertExpiration = max
+	}(ca.MaxNodeCertExpiration)
+	ca.MaxNodeCertExpiration = 48 * time.Hour
+	certChain, err = rootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	certs, err = ca.ValidateCertChain(rootCA.Pool, certChain, false)
+	require.NoError(t, err)
+	require.Equal(t, notBefore, certs[0].NotBefore)
+	require.Equal(t, notBefore.Add(48*time.Hour), certs[0].NotAfter)
+
+	// a root CA that can't sign can't issue certificates with an explicit validity either
+	noSignerRootCA := ca.RootCA{Certs: rootCA.Certs, Pool: rootCA.Pool}
+	_, err = noSignerRootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notBefore.Add(time.Hour), "cn", ca.WorkerRole, "org")
+	require.Equal(t, ca.ErrNoValidSigner, err)
+}
@@ -27,11 +27,12 @@ import (
 )
 
 const (
-	rootCACertFilename  = "swarm-root-ca.crt"
-	rootCAKeyFilename   = "swarm-root-ca.key"
-	nodeTLSCertFilename = "swarm-node.crt"
-	nodeTLSKeyFilename  = "swarm-node.key"
-	nodeCSRFilename     = "swarm-node.csr"
+	rootCACertFilename          = "swarm-root-ca.crt"
+	rootCAKeyFilename           = "swarm-root-ca.key"
+	rootCAIntermediatesFilename = "swarm-root-ca-intermediates.crt"
+	nodeTLSCertFilename         = "swarm-node.crt"
+	nodeTLSKeyFilename          = "swarm-node.key"
+	nodeCSRFilename             = "swarm-node.csr"
 
 	// DefaultRootCN represents the root CN that we should create roots CAs with by default
 	DefaultRootCN = "swarm-ca"
@@ -41,6 +42,9 @@ const (
 	WorkerRole = "swarm-worker"
 	// CARole represents the CA node type, and is used for clients attempting to get new certificates issued
 	CARole = "swarm-ca"
+	// ServiceRole marks certificates issued to a service identity rather than to a node.  Such certificates
+	// can't be used to authenticate to any of the manager endpoints.
+	ServiceRole = "swarm-service"
 
 	generatedSecretEntropyBytes = 16
 	joinTokenBase               = 36
@@ -73,10 +77,41 @@ type SecurityConfig struct {
 
 	externalCAClientRootPool *x509.CertPool
 
+	tlsOptions TLSOptions
+
 	ServerTLSCreds *MutableTLSCreds
 	ClientTLSCreds *MutableTLSCreds
 }
 
+// TLSOptions restricts the TLS connections a node makes and accepts.  The zero
+// value allows TLS 1.2 and above, with Go's default cipher suites.
+type TLSOptions struct {
+	// MinVersion is the lowest TLS version allowed.  It can't be lower than
+	// TLS 1.2.
+	MinVersion uint16
+	// CipherSuites, if not empty, are the only cipher suites allowed for
+	// TLS 1.2.  TLS 1.3 cipher suites are not configurable.
+	CipherSuites []uint16
+}
+
+func (o TLSOptions) validate() error {
+	if o.MinVersion != 0 && o.MinVersion < tls.VersionTLS12 {
+		return errors.Errorf("minimum TLS version %#04x is lower than TLS 1.2", o.MinVersion)
+	}
+	return nil
+}
+
+// apply restricts the TLS config according to the options.
+func (o TLSOptions) apply(config *tls.Config) *tls.Config {
+	if o.MinVersion > config.MinVersion {
+		config.MinVersion = o.MinVersion
+	}
+	if len(o.CipherSuites) > 0 {
+		config.CipherSuites = append([]uint16(nil), o.CipherSuites...)
+	}
+	return config
+}
+
 // CertificateUpdate represents a change in the underlying TLS configuration being returned by
 // a certificate renewal event.
 type CertificateUpdate struct {
@@ -140,6 +175,21 @@ func (s *SecurityConfig) UpdateRootCA(rootCA *RootCA, externalCARootPool *x509.C
 	return s.updateTLSCredentials(clientTLSConfig.Certificates)
 }
 
+// SetTLSOptions restricts the TLS connections made and accepted with this security config's credentials,
+// including credentials loaded after certificate renewals and root rotations.
+func (s *SecurityConfig) SetTLSOptions(opts TLSOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tlsOptions = opts
+	clientTLSConfig := s.ClientTLSCreds.Config()
+	return s.updateTLSCredentials(clientTLSConfig.Certificates)
+}
+
 // updateTLSCredentials updates the client, server, and TLS credentials on a security config.  This function expects
 // something else to have taken out a lock on the SecurityConfig.
 func (s *SecurityConfig) updateTLSCredentials(certificates []tls.Certificate) error {
@@ -153,17 +203,20 @@ func (s *SecurityConfig) updateTLSCredentials(certificates []tls.Certificate) er
 		return errors.Wrap(err, "failed to create a new server config using the new root CA")
 	}
 
+	s.tlsOptions.apply(clientConfig)
+	s.tlsOptions.apply(serverConfig)
+
 	if err := s.ClientTLSCreds.loadNewTLSConfig(clientConfig); err != nil {
 		return errors.Wrap(err, "failed to update the client credentials")
 	}
 
 	// Update the external CA to use the new client TLS
 	// config using a copy without a serverName specified.
-	s.externalCA.UpdateTLSConfig(&tls.Config{
+	s.externalCA.UpdateTLSConfig(s.tlsOptions.apply(&tls.Config{
 		Certificates: certificates,
 		RootCAs:      s.externalCAClientRootPool,
 		MinVersion:   tls.VersionTLS12,
-	})
+	}))
 
 	if err := s.ServerTLSCreds.loadNewTLSConfig(serverConfig); err != nil {
 		return errors.Wrap(err, "failed to update the server TLS credentials")
@@ -180,6 +233,14 @@ func SigningPolicy(certExpiry time.Duration) *cfconfig.Signing {
 	if certExpiry < MinNodeCertExpiration {
 		certExpiry = DefaultNodeCertExpiration
 	}
+	// DefaultNodeCertExpiration may have been overridden with something shorter
+	if certExpiry < MinNodeCertExpiration {
+		certExpiry = MinNodeCertExpiration
+	}
+	if MaxNodeCertExpiration > 0 && certExpiry > MaxNodeCertExpiration {
+		log.L.Warnf("node certificate expiration %v is longer than the maximum of %v, using the maximum instead", certExpiry, MaxNodeCertExpiration)
+		certExpiry = MaxNodeCertExpiration
+	}
 
 	// Add the backdate
 	certExpiry = certExpiry + CertBackdate
@@ -212,8 +273,9 @@ func NewConfigPaths(baseCertDir string) *SecurityConfigPaths {
 			Cert: filepath.Join(baseCertDir, nodeTLSCertFilename),
 			Key:  filepath.Join(baseCertDir, nodeTLSKeyFilename)},
 		RootCA: CertPaths{
-			Cert: filepath.Join(baseCertDir, rootCACertFilename),
-			Key:  filepath.Join(baseCertDir, rootCAKeyFilename)},
+			Cert:          filepath.Join(baseCertDir, rootCACertFilename),
+			Key:           filepath.Join(baseCertDir, rootCAKeyFilename),
+			Intermediates: filepath.Join(baseCertDir, rootCAIntermediatesFilename)},
 	}
 }
 
@@ -350,6 +412,25 @@ type CertificateRequestConfig struct {
 	// where the local node is running a manager, but is in the process of
 	// being demoted.
 	ForceRemote bool
+	// SubmitRetries is how many more times the CSR is submitted, to another
+	// manager if there is one, if submitting it fails for a reason that may
+	// go away, such as the manager being unavailable. This is separate from
+	// waiting for a submitted CSR to be issued.
+	SubmitRetries int
+	// SubmitDeadline, if not zero, limits the time spent submitting the
+	// CSR, including retries.
+	SubmitDeadline time.Duration
+	// TLSOptions restricts the TLS connections made and accepted using the
+	// new credentials.
+	TLSOptions TLSOptions
+	// IncludeRoot specifies that the certificate chain returned by
+	// GetRemoteSignedCertificate ends with the root that anchors it.  By
+	// default the chain only contains the leaf and its intermediates.
+	IncludeRoot bool
+	// RequestedExpiry, if not zero, asks the CA for a certificate that is
+	// valid for this long rather than for the cluster's node certificate
+	// expiry.  The CA only honors it if it is shorter.
+	RequestedExpiry time.Duration
 }
 
 // CreateSecurityConfig creates a new key and cert for this node, either locally
@@ -357,6 +438,10 @@ type CertificateRequestConfig struct {
 func (rootCA RootCA) CreateSecurityConfig(ctx context.Context, krw *KeyReadWriter, config CertificateRequestConfig) (*SecurityConfig, error) {
 	ctx = log.WithModule(ctx, "tls")
 
+	if err := config.TLSOptions.validate(); err != nil {
+		return nil, err
+	}
+
 	// Create a new random ID for this certificate
 	cn := identity.NewID()
 	org := identity.NewID()
@@ -370,6 +455,9 @@ func (rootCA RootCA) CreateSecurityConfig(ctx context.Context, krw *KeyReadWrite
 		tlsKeyPair, err = rootCA.RequestAndSaveNewCertificates(ctx, krw, config)
 		if err != nil {
 			log.G(ctx).WithError(err).Error("failed to request save new certificate")
+			if ctx.Err() != nil {
+				return nil, errors.Wrap(err, "context done while requesting a certificate from a remote CA")
+			}
 			return nil, err
 		}
 	case nil:
@@ -402,7 +490,11 @@ func (rootCA RootCA) CreateSecurityConfig(ctx context.Context, krw *KeyReadWrite
 		"node.role": clientTLSCreds.Role(),
 	}).Debugf("new node credentials generated: %s", krw.Target())
 
-	return NewSecurityConfig(&rootCA, krw, clientTLSCreds, serverTLSCreds), nil
+	securityConfig := NewSecurityConfig(&rootCA, krw, clientTLSCreds, serverTLSCreds)
+	if err := securityConfig.SetTLSOptions(config.TLSOptions); err != nil {
+		return nil, err
+	}
+	return securityConfig, nil
 }
 
 // RenewTLSConfigNow gets a new TLS cert and key, and updates the security config if provided.  This is similar to
@@ -16,10 +16,13 @@ import (
 	"golang.org/x/net/context"
 
 	cfconfig "github.com/cloudflare/cfssl/config"
+	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/ca"
 	"github.com/docker/swarmkit/ca/testutils"
+	"github.com/docker/swarmkit/connectionbroker"
 	"github.com/docker/swarmkit/ioutils"
 	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/docker/swarmkit/remotes"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -137,6 +140,86 @@ func TestCreateSecurityConfigNoCerts(t *testing.T) {
 	assert.Equal(t, rootCA, *nodeConfig.RootCA())
 }
 
+func TestCreateSecurityConfigHonorsContext(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	// a manager that accepts connections but never answers
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	// without a signer, the certificate has to be requested from the remote CA
+	rootCA := ca.RootCA{Certs: tc.RootCA.Certs, Pool: tc.RootCA.Pool}
+	ctx, cancel := context.WithTimeout(tc.Context, 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = rootCA.CreateSecurityConfig(ctx, ca.NewKeyReadWriter(tc.Paths.Node, nil, nil),
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: connectionbroker.New(remotes.NewRemotes(api.Peer{Addr: l.Addr().String()})),
+		})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "context done")
+	require.True(t, time.Since(start) < 3*time.Second)
+}
+
+// tlsHandshake connects a client with the given config to a server with the given config,
+// and returns the error from the server's side of the handshake.
+func tlsHandshake(serverConfig, clientConfig *tls.Config) error {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() {
+		tls.Client(clientConn, clientConfig).Handshake()
+		clientConn.Close()
+	}()
+	return tls.Server(serverConn, serverConfig).Handshake()
+}
+
+func TestCreateSecurityConfigTLSOptions(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	// TLS versions lower than 1.2 can't be allowed
+	_, err := tc.RootCA.CreateSecurityConfig(tc.Context, ca.NewKeyReadWriter(tc.Paths.Node, nil, nil),
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: tc.ConnBroker,
+			TLSOptions: ca.TLSOptions{MinVersion: tls.VersionTLS11},
+		})
+	require.Error(t, err)
+
+	nodeConfig, err := tc.RootCA.CreateSecurityConfig(tc.Context, ca.NewKeyReadWriter(tc.Paths.Node, nil, nil),
+		ca.CertificateRequestConfig{
+			Token:      tc.WorkerToken,
+			ConnBroker: tc.ConnBroker,
+			TLSOptions: ca.TLSOptions{MinVersion: tls.VersionTLS13},
+		})
+	require.NoError(t, err)
+
+	checkHandshakes := func() {
+		serverConfig := nodeConfig.ServerTLSCreds.Config()
+		clientConfig := nodeConfig.ClientTLSCreds.Config()
+		require.EqualValues(t, tls.VersionTLS13, clientConfig.MinVersion)
+
+		for _, version := range []uint16{tls.VersionTLS11, tls.VersionTLS12} {
+			oldClientConfig := clientConfig.Clone()
+			oldClientConfig.MinVersion = tls.VersionTLS10
+			oldClientConfig.MaxVersion = version
+			require.Error(t, tlsHandshake(serverConfig, oldClientConfig))
+		}
+		require.NoError(t, tlsHandshake(serverConfig, clientConfig))
+	}
+	checkHandshakes()
+
+	// the options still apply after the credentials are reloaded
+	require.NoError(t, nodeConfig.UpdateRootCA(&tc.RootCA, tc.RootCA.Pool))
+	checkHandshakes()
+}
+
 func TestLoadSecurityConfigExpiredCert(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -662,3 +745,44 @@ func TestForceRenewTLSConfig(t *testing.T) {
 		assert.Equal(t, certUpdate.Role, ca.ManagerRole)
 	}
 }
+
+func TestMonitorRootCAExpiry(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	secConfig, err := tc.NewNodeConfig(ca.ManagerRole)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(tc.Context)
+	defer cancel()
+	warnings := ca.MonitorRootCAExpiry(ctx, secConfig, time.Hour, 50*time.Millisecond)
+
+	// the test root is valid for much longer than the threshold
+	select {
+	case warning := <-warnings:
+		t.Fatalf("unexpected warning for a root expiring at %v", warning.NotAfter)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	// switch to a root that expires within the threshold
+	s, err := tc.RootCA.Signer()
+	require.NoError(t, err)
+	now := time.Now()
+	shortLivedRoot := testutils.ReDateCert(t, tc.RootCA.Certs, tc.RootCA.Certs, s.Key, now.Add(-time.Hour), now.Add(10*time.Minute))
+	shortLivedRootCA, err := ca.NewRootCA(shortLivedRoot, shortLivedRoot, s.Key, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	require.NoError(t, secConfig.UpdateRootCA(&shortLivedRootCA, shortLivedRootCA.Pool))
+
+	select {
+	case warning := <-warnings:
+		require.True(t, warning.Remaining > 0)
+		require.True(t, warning.Remaining <= 10*time.Minute)
+		require.Equal(t, now.Add(10*time.Minute).Truncate(time.Second).Unix(), warning.NotAfter.Unix())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no warning for a root that expires within the threshold")
+	}
+
+	cancel()
+	for range warnings {
+	}
+}
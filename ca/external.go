@@ -3,6 +3,7 @@ package ca
 import (
 	"bytes"
 	cryptorand "crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/hex"
@@ -10,12 +11,15 @@ import (
 	"encoding/pem"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/cloudflare/cfssl/api"
 	"github.com/cloudflare/cfssl/config"
 	"github.com/cloudflare/cfssl/csr"
+	"github.com/cloudflare/cfssl/helpers"
 	"github.com/cloudflare/cfssl/signer"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
@@ -26,13 +30,36 @@ import (
 // configured with no URLs to which it can proxy certificate signing requests.
 var ErrNoExternalCAURLs = errors.New("no external CA URLs")
 
+// ExternalCARetryPolicy controls how an ExternalCA retries signing requests
+// that failed in a way that may go away, such as the external CA being
+// overloaded or unavailable.  Requests that the external CA rejected are not
+// retried.
+type ExternalCARetryPolicy struct {
+	// Attempts is the maximum number of times each configured URL is tried.
+	// Values below 1 mean that requests aren't retried.
+	Attempts int
+	// Backoff is how long to wait after every URL failed before retrying
+	// them.  It doubles for each further retry, up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultExternalCARetryPolicy is the retry policy of a new ExternalCA.
+var DefaultExternalCARetryPolicy = ExternalCARetryPolicy{
+	Attempts:   3,
+	Backoff:    100 * time.Millisecond,
+	MaxBackoff: 2 * time.Second,
+}
+
 // ExternalCA is able to make certificate signing requests to one of a list
 // remote CFSSL API endpoints.
 type ExternalCA struct {
-	mu     sync.Mutex
-	rootCA *RootCA
-	urls   []string
-	client *http.Client
+	mu           sync.Mutex
+	rootCA       *RootCA
+	urls         []string
+	client       *http.Client
+	allowedRoots map[string]struct{}
+	retryPolicy  ExternalCARetryPolicy
 }
 
 // NewExternalCA creates a new ExternalCA which uses the given tlsConfig to
@@ -46,6 +73,7 @@ func NewExternalCA(rootCA *RootCA, tlsConfig *tls.Config, urls ...string) *Exter
 				TLSClientConfig: tlsConfig,
 			},
 		},
+		retryPolicy: DefaultExternalCARetryPolicy,
 	}
 }
 
@@ -71,14 +99,46 @@ func (eca *ExternalCA) UpdateURLs(urls ...string) {
 	eca.urls = urls
 }
 
+// UpdateAllowedRootFingerprints pins the root CAs that certificates returned by the
+// external CA are allowed to chain to.  Fingerprints are the hex encoded SHA-256 digest
+// of the DER encoded root certificate, and may be separated by colons.  If no
+// fingerprints are given, any root in the root CA pool is accepted.
+func (eca *ExternalCA) UpdateAllowedRootFingerprints(fingerprints ...string) {
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+
+	if len(fingerprints) == 0 {
+		eca.allowedRoots = nil
+		return
+	}
+	eca.allowedRoots = make(map[string]struct{}, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		eca.allowedRoots[normalizeFingerprint(fingerprint)] = struct{}{}
+	}
+}
+
+// UpdateRetryPolicy updates how signing requests are retried.
+func (eca *ExternalCA) UpdateRetryPolicy(policy ExternalCARetryPolicy) {
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+
+	eca.retryPolicy = policy
+}
+
 // Sign signs a new certificate by proxying the given certificate signing
-// request to an external CFSSL API server.
+// request to an external CFSSL API server.  Each URL is tried in turn.  If
+// any of them fails in a way that may go away, such as a timeout or an HTTP
+// 429 or 503 response, they are retried with backoff according to the retry
+// policy, and the error is recoverable.  Only if every URL rejects the
+// request, such as with an HTTP 400 response, does Sign fail right away.
 func (eca *ExternalCA) Sign(ctx context.Context, req signer.SignRequest) (cert []byte, err error) {
 	// Get the current HTTP client and list of URLs in a small critical
 	// section. We will use these to make certificate signing requests.
 	eca.mu.Lock()
 	urls := eca.urls
 	client := eca.client
+	allowedRoots := eca.allowedRoots
+	retryPolicy := eca.retryPolicy
 	eca.mu.Unlock()
 
 	if len(urls) == 0 {
@@ -90,17 +150,45 @@ func (eca *ExternalCA) Sign(ctx context.Context, req signer.SignRequest) (cert [
 		return nil, errors.Wrap(err, "unable to JSON-encode CFSSL signing request")
 	}
 
-	// Try each configured proxy URL. Return after the first success. If
-	// all fail then the last error will be returned.
-	for _, url := range urls {
-		cert, err = makeExternalSignRequest(ctx, client, url, csrJSON)
-		if err == nil {
-			return append(cert, eca.rootCA.Intermediates...), err
+	backoff := retryPolicy.Backoff
+	for attempt := 1; ; attempt++ {
+		// Try each configured proxy URL. Return after the first success. If
+		// all fail then the last error will be returned, unless one of them
+		// may go away, in which case the last such error is.
+		var retryableErr error
+		for _, url := range urls {
+			cert, err = makeExternalSignRequest(ctx, client, url, csrJSON)
+			if err == nil {
+				cert = append(cert, eca.rootCA.Intermediates...)
+				if err = checkAllowedRoot(cert, eca.rootCA.Pool, allowedRoots); err == nil {
+					if err = checkValidityCap(cert); err == nil {
+						return cert, nil
+					}
+					logrus.Warnf("rejecting certificate from external CA %s: %s", url, err)
+				}
+			} else if _, ok := err.(recoverableErr); ok {
+				retryableErr = err
+			}
+			logrus.Debugf("unable to proxy certificate signing request to %s: %s", url, err)
 		}
-		logrus.Debugf("unable to proxy certificate signing request to %s: %s", url, err)
-	}
 
-	return nil, err
+		if retryableErr == nil {
+			return nil, err
+		}
+		err = retryableErr
+		if attempt >= retryPolicy.Attempts {
+			return nil, err
+		}
+		logrus.Debugf("retrying certificate signing request in %s", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, err
+		}
+		if backoff *= 2; retryPolicy.MaxBackoff > 0 && backoff > retryPolicy.MaxBackoff {
+			backoff = retryPolicy.MaxBackoff
+		}
+	}
 }
 
 // CrossSignRootCA takes a RootCA object, generates a CA CSR, sends a signing request with the CA CSR to the external
@@ -153,6 +241,74 @@ func (eca *ExternalCA) CrossSignRootCA(ctx context.Context, rca RootCA) ([]byte,
 	return eca.Sign(ctx, req)
 }
 
+// checkAllowedRoot verifies that the given certificate chain chains up to one of the
+// roots in the pool whose fingerprint is in allowedRoots.  If allowedRoots is empty,
+// no check is made.
+func checkAllowedRoot(certChain []byte, pool *x509.CertPool, allowedRoots map[string]struct{}) error {
+	if len(allowedRoots) == 0 {
+		return nil
+	}
+
+	certs, err := helpers.ParseCertificatesPEM(certChain)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse certificate returned by external CA")
+	}
+	if len(certs) == 0 {
+		return errors.New("no certificate returned by external CA")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	chains, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return errors.Wrap(err, "certificate returned by external CA does not chain to a trusted root")
+	}
+
+	for _, chain := range chains {
+		root := chain[len(chain)-1]
+		if _, ok := allowedRoots[rootFingerprint(root)]; ok {
+			return nil
+		}
+	}
+	return errors.New("certificate returned by external CA was not issued by an allowed root CA")
+}
+
+func rootFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.Replace(fingerprint, ":", "", -1))
+}
+
+// externalCARejectionErr is returned when an external CA rejected a signing
+// request with an HTTP status which means that retrying it won't help.
+type externalCARejectionErr struct {
+	err error
+}
+
+func (e externalCARejectionErr) Error() string {
+	return e.err.Error()
+}
+
+// retryableStatus returns whether an HTTP status returned by an external CA
+// means that the request may succeed if it is retried later.
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
 func makeExternalSignRequest(ctx context.Context, client *http.Client, url string, csrJSON []byte) (cert []byte, err error) {
 	resp, err := ctxhttp.Post(ctx, client, url, "application/json", bytes.NewReader(csrJSON))
 	if err != nil {
@@ -166,7 +322,11 @@ func makeExternalSignRequest(ctx context.Context, client *http.Client, url strin
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, recoverableErr{err: errors.Errorf("unexpected status code in CSR response: %d - %s", resp.StatusCode, string(body))}
+		err := errors.Errorf("unexpected status code in CSR response: %d - %s", resp.StatusCode, string(body))
+		if retryableStatus(resp.StatusCode) {
+			return nil, recoverableErr{err: err}
+		}
+		return nil, externalCARejectionErr{err: err}
 	}
 
 	var apiResponse api.Response
@@ -2,8 +2,14 @@ package ca_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cfssl/helpers"
 	"github.com/docker/swarmkit/ca"
@@ -77,3 +83,148 @@ func TestExternalCACrossSign(t *testing.T) {
 	_, err = leafCert.Verify(x509.VerifyOptions{Roots: rootCA2.Pool, Intermediates: intermediatePool})
 	require.NoError(t, err)
 }
+
+// Tests that ExternalCA.Sign rejects certificates that chain to a trusted root which
+// is not in the pinned set of root fingerprints
+func TestExternalCASignAllowedRootFingerprints(t *testing.T) {
+	t.Parallel()
+
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	ess, err := testutils.NewExternalSigningServer(tc.RootCA, tc.TempDir)
+	require.NoError(t, err)
+	defer ess.Stop()
+
+	clientCert, err := tc.RootCA.IssueAndSaveNewCertificates(tc.KeyReadWriter, "cn", ca.ManagerRole, tc.Organization)
+	require.NoError(t, err)
+	externalCA := ca.NewExternalCA(&tc.RootCA, &tls.Config{
+		Certificates: []tls.Certificate{*clientCert},
+		RootCAs:      tc.RootCA.Pool,
+	}, ess.URL)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	req := ca.PrepareCSR(csr, "cn", ca.WorkerRole, tc.Organization)
+
+	// nothing pinned, so any root in the pool is fine
+	_, err = externalCA.Sign(context.Background(), req)
+	require.NoError(t, err)
+
+	otherRoot, _, err := testutils.CreateRootCertAndKey("otherRootCN")
+	require.NoError(t, err)
+
+	// the signer's root is trusted by the pool, but isn't pinned
+	externalCA.UpdateAllowedRootFingerprints(fingerprint(t, otherRoot))
+	_, err = externalCA.Sign(context.Background(), req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not issued by an allowed root CA")
+
+	externalCA.UpdateAllowedRootFingerprints(fingerprint(t, otherRoot), fingerprint(t, tc.RootCA.Certs))
+	_, err = externalCA.Sign(context.Background(), req)
+	require.NoError(t, err)
+}
+
+func TestExternalCASignRetries(t *testing.T) {
+	t.Parallel()
+
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	ess, err := testutils.NewExternalSigningServer(tc.RootCA, tc.TempDir)
+	require.NoError(t, err)
+	defer ess.Stop()
+
+	clientCert, err := tc.RootCA.IssueAndSaveNewCertificates(tc.KeyReadWriter, "cn", ca.ManagerRole, tc.Organization)
+	require.NoError(t, err)
+	externalCA := ca.NewExternalCA(&tc.RootCA, &tls.Config{
+		Certificates: []tls.Certificate{*clientCert},
+		RootCAs:      tc.RootCA.Pool,
+	}, ess.URL)
+	externalCA.UpdateRetryPolicy(ca.ExternalCARetryPolicy{Attempts: 3, Backoff: 10 * time.Millisecond})
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	req := ca.PrepareCSR(csr, "cn", ca.WorkerRole, tc.Organization)
+
+	// an unavailable external CA is retried until it signs
+	ess.FailNext(2, http.StatusServiceUnavailable)
+	cert, err := externalCA.Sign(context.Background(), req)
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(tc.RootCA.Pool, cert, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, atomic.LoadUint64(&ess.NumIssued))
+
+	// but only as many times as the policy allows
+	ess.FailNext(3, http.StatusTooManyRequests)
+	_, err = externalCA.Sign(context.Background(), req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "429")
+	require.EqualValues(t, 1, atomic.LoadUint64(&ess.NumIssued))
+
+	// and a rejected request isn't retried at all
+	ess.FailNext(1, http.StatusBadRequest)
+	_, err = externalCA.Sign(context.Background(), req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "400")
+	_, err = externalCA.Sign(context.Background(), req)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, atomic.LoadUint64(&ess.NumIssued))
+}
+
+func TestExternalCASignRejectionTriesOtherURLs(t *testing.T) {
+	t.Parallel()
+
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	rejecting, err := testutils.NewExternalSigningServer(tc.RootCA, tc.TempDir)
+	require.NoError(t, err)
+	defer rejecting.Stop()
+	signing, err := testutils.NewExternalSigningServer(tc.RootCA, tc.TempDir)
+	require.NoError(t, err)
+	defer signing.Stop()
+
+	clientCert, err := tc.RootCA.IssueAndSaveNewCertificates(tc.KeyReadWriter, "cn", ca.ManagerRole, tc.Organization)
+	require.NoError(t, err)
+	externalCA := ca.NewExternalCA(&tc.RootCA, &tls.Config{
+		Certificates: []tls.Certificate{*clientCert},
+		RootCAs:      tc.RootCA.Pool,
+	}, rejecting.URL, signing.URL)
+	externalCA.UpdateRetryPolicy(ca.ExternalCARetryPolicy{Attempts: 2, Backoff: 10 * time.Millisecond})
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	req := ca.PrepareCSR(csr, "cn", ca.WorkerRole, tc.Organization)
+
+	// a request rejected by one external CA is still sent to the others
+	rejecting.FailNext(1, http.StatusBadRequest)
+	_, err = externalCA.Sign(context.Background(), req)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, atomic.LoadUint64(&rejecting.NumIssued))
+	require.EqualValues(t, 1, atomic.LoadUint64(&signing.NumIssued))
+
+	// if another external CA is only unavailable, the request is retried, and fails with its error if it stays so
+	rejecting.FailNext(2, http.StatusBadRequest)
+	signing.FailNext(2, http.StatusServiceUnavailable)
+	_, err = externalCA.Sign(context.Background(), req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "503")
+
+	// only once every external CA rejects the request does it fail right away
+	rejecting.FailNext(1, http.StatusBadRequest)
+	signing.FailNext(1, http.StatusBadRequest)
+	_, err = externalCA.Sign(context.Background(), req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "400")
+	_, err = externalCA.Sign(context.Background(), req)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, atomic.LoadUint64(&rejecting.NumIssued)+atomic.LoadUint64(&signing.NumIssued))
+}
+
+func fingerprint(t *testing.T, certPEM []byte) string {
+	cert, err := helpers.ParseCertificatePEM(certPEM)
+	require.NoError(t, err)
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
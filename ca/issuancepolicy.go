@@ -0,0 +1,217 @@
+package ca
+
+import (
+	cryptorand "crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	cfcsr "github.com/cloudflare/cfssl/csr"
+	"github.com/cloudflare/cfssl/helpers"
+	cfsigner "github.com/cloudflare/cfssl/signer"
+	"github.com/cloudflare/cfssl/signer/local"
+	"github.com/pkg/errors"
+)
+
+// IssuanceRequest is a node certificate that is about to be issued, which an IssuancePolicy can check and change.
+type IssuanceRequest struct {
+	// CSR is the PEM encoded certificate signing request.  Only its public key ends up in the certificate.
+	CSR []byte
+	// CN is the certificate's common name, normally the node ID.
+	CN string
+	// OU is the node's role.
+	OU string
+	// Org is the certificate's organization, normally the cluster ID.
+	Org string
+	// AdditionalOUs are OUs added to the certificate alongside the role.
+	AdditionalOUs []string
+	// Hosts are the certificate's DNS and IP subject alternative names.  They start out as the role and the CN, and
+	// the CA role for managers.
+	Hosts []string
+	// Expiry is how long the certificate is valid for.  Zero means the root CA's configured expiry.
+	Expiry time.Duration
+	// URIs are the certificate's URI subject alternative names.  They start out as the root CA's NodeURIFormat applied
+	// to the subject, if it is set and this is a node certificate.  They are only added to certificates signed
+	// locally.
+	URIs []*url.URL
+}
+
+// SPIFFENodeURIFormat is a RootCA.NodeURIFormat that gives nodes the SPIFFE ID spiffe://<cluster ID>/node/<node ID>.
+const SPIFFENodeURIFormat = "spiffe://%[1]s/node/%[3]s"
+
+// IssuancePolicy decides what node certificates a RootCA issues.  Apply is called with each request before it is
+// signed, and may change it or return an error to refuse it.
+//
+// Policies replace the default checks rather than adding to them, so most policies should call
+// DefaultIssuancePolicy first.  Whatever the policy, the CSR's key must satisfy MinimumKeyStrength and must not be
+// blocked by BlockedKeys.
+type IssuancePolicy interface {
+	Apply(req *IssuanceRequest) error
+}
+
+// DefaultIssuancePolicy is the policy used by a RootCA that doesn't set one.  It requires the additional OUs to be
+// valid, and otherwise issues the request as is.
+type DefaultIssuancePolicy struct{}
+
+// Apply checks the request against the default policy.
+func (DefaultIssuancePolicy) Apply(req *IssuanceRequest) error {
+	return checkAdditionalOUs(req.OU, req.AdditionalOUs)
+}
+
+// newIssuanceRequest returns the request for a certificate with the given subject, before any policy is applied.
+func newIssuanceRequest(csrBytes []byte, cn, ou, org string, additionalOUs ...string) *IssuanceRequest {
+	// All managers get added the subject-alt-name of CA, so they can be
+	// used for cert issuance.
+	hosts := []string{ou, cn}
+	if ou == ManagerRole {
+		hosts = append(hosts, CARole)
+	}
+	return &IssuanceRequest{
+		CSR:           csrBytes,
+		CN:            cn,
+		OU:            ou,
+		Org:           org,
+		AdditionalOUs: additionalOUs,
+		Hosts:         hosts,
+	}
+}
+
+// signRequest converts the request into a CFSSL sign request.
+func (req *IssuanceRequest) signRequest() cfsigner.SignRequest {
+	// The role OU is requested first, but the OUs end up in a single set in
+	// the certificate, so their order isn't preserved.
+	names := []cfcsr.Name{{OU: req.OU, O: req.Org}}
+	for _, additionalOU := range req.AdditionalOUs {
+		names = append(names, cfcsr.Name{OU: additionalOU})
+	}
+
+	return cfsigner.SignRequest{
+		Request: string(req.CSR),
+		// OU is used for Authentication of the node type. The CN has the random
+		// node ID.
+		Subject: &cfsigner.Subject{CN: req.CN, Names: names},
+		// Adding ou as DNS alt name, so clients can connect to ManagerRole and CARole
+		Hosts: req.Hosts,
+	}
+}
+
+// addNodeURI adds the URI given by the root CA's NodeURIFormat, if it is set, to the request.  Service identity
+// certificates don't identify a node, so they don't get one.
+func (rca *RootCA) addNodeURI(req *IssuanceRequest) error {
+	if rca.NodeURIFormat == "" || req.OU == ServiceRole {
+		return nil
+	}
+	uri, err := url.Parse(fmt.Sprintf(rca.NodeURIFormat, req.Org, req.OU, req.CN))
+	if err != nil {
+		return errors.Wrap(err, "invalid node URI")
+	}
+	req.URIs = append(req.URIs, uri)
+	return nil
+}
+
+// issuancePolicy returns the root CA's issuance policy, or the default one if it doesn't have any.
+func (rca *RootCA) issuancePolicy() IssuancePolicy {
+	if rca.IssuancePolicy != nil {
+		return rca.IssuancePolicy
+	}
+	return DefaultIssuancePolicy{}
+}
+
+// applyIssuancePolicy applies the root CA's issuance policy to the request, and then checks the key of the CSR the
+// policy left in it, which no policy can skip.
+func (rca *RootCA) applyIssuancePolicy(req *IssuanceRequest) error {
+	if err := rca.issuancePolicy().Apply(req); err != nil {
+		return err
+	}
+	return checkCSRKeyStrength(req.CSR)
+}
+
+// requestedExpiry returns the expiry to issue a certificate for when its requester asked for requested, which is only
+// honored if it is shorter than the root CA's own expiry, and is raised to MinNodeCertExpiration if needed.  Zero means
+// the root CA's expiry.
+func (rca *RootCA) requestedExpiry(requested time.Duration) time.Duration {
+	if requested <= 0 {
+		return 0
+	}
+	signer, err := rca.Signer()
+	if err != nil {
+		return 0
+	}
+	if requested >= signer.Policy().Default.Expiry-CertBackdate {
+		return 0
+	}
+	if requested < MinNodeCertExpiration {
+		return MinNodeCertExpiration
+	}
+	return requested
+}
+
+// signIssuanceRequest signs a request that the issuance policy has been applied to.
+func (rca *RootCA) signIssuanceRequest(req *IssuanceRequest) ([]byte, error) {
+	cert, err := rca.signIssuanceRequestExpiry(req)
+	if err != nil {
+		return nil, err
+	}
+	return rca.addURIs(cert, req.URIs)
+}
+
+// signIssuanceRequestExpiry signs a request for its expiry, without its URIs.
+func (rca *RootCA) signIssuanceRequestExpiry(req *IssuanceRequest) ([]byte, error) {
+	if req.Expiry == 0 {
+		return rca.signCSR(req.signRequest(), req.CN, req.OU, req.Org, req.AdditionalOUs...)
+	}
+
+	signer, err := rca.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.parsedCert == nil || signer.cryptoSigner == nil {
+		return nil, ErrNoValidSigner
+	}
+	expirySigner, err := local.NewSigner(signer.cryptoSigner, signer.parsedCert, cfsigner.DefaultSigAlgo(signer.cryptoSigner), SigningPolicy(req.Expiry))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create signer")
+	}
+	return rca.signCSRWith(expirySigner, req.signRequest(), req.CN, req.OU, req.Org, req.AdditionalOUs...)
+}
+
+// addURIs signs again the leaf certificate of a chain this root CA issued, with the URIs added to its subject
+// alternative names, since CFSSL can't add URIs.  The chain is returned unchanged if there are no URIs.
+func (rca *RootCA) addURIs(certChain []byte, uris []*url.URL) ([]byte, error) {
+	if len(uris) == 0 {
+		return certChain, nil
+	}
+	signer, err := rca.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.parsedCert == nil || signer.cryptoSigner == nil {
+		return nil, ErrNoValidSigner
+	}
+	leaf, err := helpers.ParseCertificatePEM(certChain)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse issued node certificate")
+	}
+
+	// the re-signed leaf is a different certificate from the same issuer, so it needs its own serial
+	serial, err := cryptorand.Int(cryptorand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate a serial number")
+	}
+	template := *leaf
+	template.SerialNumber = serial
+	template.URIs = append(append([]*url.URL{}, leaf.URIs...), uris...)
+	derBytes, err := x509.CreateCertificate(cryptorand.Reader, &template, signer.parsedCert, leaf.PublicKey, signer.cryptoSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add URIs to node certificate")
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: derBytes,
+	})
+	return append(cert, rca.Intermediates...), nil
+}
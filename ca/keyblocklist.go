@@ -0,0 +1,65 @@
+package ca
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+// KeyBlocklist decides whether a public key is known to be compromised, such as the keys generated by Debian's
+// broken OpenSSL, whatever its strength.  Keys are identified by their fingerprint, as returned by
+// PublicKeyFingerprint.
+type KeyBlocklist interface {
+	Blocked(fingerprint string) bool
+}
+
+// FingerprintBlocklist is a KeyBlocklist that blocks a fixed set of fingerprints.
+type FingerprintBlocklist map[string]struct{}
+
+// NewFingerprintBlocklist returns a blocklist of the given fingerprints, which may be in upper or lower case, and
+// may separate bytes with colons.
+func NewFingerprintBlocklist(fingerprints ...string) FingerprintBlocklist {
+	blocklist := make(FingerprintBlocklist, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		blocklist[normalizeFingerprint(fingerprint)] = struct{}{}
+	}
+	return blocklist
+}
+
+// Blocked returns whether the fingerprint is in the blocklist.
+func (b FingerprintBlocklist) Blocked(fingerprint string) bool {
+	_, ok := b[normalizeFingerprint(fingerprint)]
+	return ok
+}
+
+// BlockedKeys is checked when loading a root CA's signing key and when signing a CSR.  By default no key is blocked.
+var BlockedKeys KeyBlocklist = FingerprintBlocklist{}
+
+// PublicKeyFingerprint returns the hex encoded SHA-256 digest of the DER encoded public key, as it appears in a
+// certificate's SubjectPublicKeyInfo.
+func PublicKeyFingerprint(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to marshal public key")
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// checkKeyBlocklist returns an error if the public key is blocked by BlockedKeys.
+func checkKeyBlocklist(pub crypto.PublicKey) error {
+	if BlockedKeys == nil {
+		return nil
+	}
+	fingerprint, err := PublicKeyFingerprint(pub)
+	if err != nil {
+		return err
+	}
+	if BlockedKeys.Blocked(fingerprint) {
+		return errors.Errorf("blocked key: public key %s is known to be compromised", fingerprint)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package ca
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cfssl/helpers"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// KeyProvider provides the root CA signing key, so that the key can be kept
+// in an external secret store rather than on the manager's disk.
+type KeyProvider interface {
+	// GetRootKey returns the PEM-encoded root CA signing key.
+	GetRootKey(ctx context.Context) ([]byte, error)
+}
+
+// FileKeyProvider is a KeyProvider that reads the root CA signing key from a
+// file on disk.
+type FileKeyProvider struct {
+	Path string
+}
+
+// GetRootKey reads the root CA signing key from disk.  If there is no key
+// file, it returns ErrNoValidSigner.
+func (f FileKeyProvider) GetRootKey(ctx context.Context) ([]byte, error) {
+	key, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return nil, ErrNoValidSigner
+	}
+	return key, err
+}
+
+// lazySigner creates a LocalSigner the first time it is needed.  If creating
+// the signer fails, it will be attempted again the next time it is needed.
+type lazySigner struct {
+	mu     sync.Mutex
+	signer *LocalSigner
+	load   func() (*LocalSigner, error)
+}
+
+func (l *lazySigner) get() (*LocalSigner, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.signer == nil {
+		signer, err := l.load()
+		if err != nil {
+			return nil, err
+		}
+		l.signer = signer
+	}
+	return l.signer, nil
+}
+
+// NewRootCAWithKeyProvider is like NewRootCA, except that the signing key is
+// obtained from the KeyProvider the first time the RootCA's Signer is needed,
+// rather than being passed in.  The root, signing and intermediate
+// certificates are validated immediately; the key is validated once it has
+// been fetched.
+func NewRootCAWithKeyProvider(rootCertBytes, signCertBytes []byte, keyProvider KeyProvider, certExpiry time.Duration, intermediates []byte) (RootCA, error) {
+	rootCA, err := NewRootCA(rootCertBytes, nil, nil, certExpiry, intermediates)
+	if err != nil {
+		return RootCA{}, err
+	}
+	parsedCerts, err := helpers.ParseCertificatesPEM(signCertBytes)
+	if err != nil {
+		return RootCA{}, errors.Wrap(err, "invalid signing CA cert")
+	}
+	if len(parsedCerts) == 0 {
+		return RootCA{}, errors.New("no valid signing CA certificates found")
+	}
+	intermediatePool := x509.NewCertPool()
+	intermediatePool.AppendCertsFromPEM(intermediates)
+	opts := x509.VerifyOptions{
+		Roots:         rootCA.Pool,
+		Intermediates: intermediatePool,
+	}
+	if _, err := parsedCerts[0].Verify(opts); err != nil {
+		return RootCA{}, errors.Wrap(err, "error while validating signing CA certificate against roots and intermediates")
+	}
+
+	rootCA.lazySigner = &lazySigner{
+		load: func() (*LocalSigner, error) {
+			key, err := keyProvider.GetRootKey(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			withSigner, err := NewRootCA(rootCertBytes, signCertBytes, key, certExpiry, intermediates)
+			if err != nil {
+				return nil, err
+			}
+			return withSigner.signer, nil
+		},
+	}
+	return rootCA, nil
+}
+
+// GetLocalRootCAWithKeyProvider is like GetLocalRootCA, except that the signing
+// key is obtained from the KeyProvider when it is first needed, rather than
+// being read from paths.Key.
+func GetLocalRootCAWithKeyProvider(paths CertPaths, keyProvider KeyProvider) (RootCA, error) {
+	cert, signingCert, intermediates, err := readLocalRootCACerts(paths)
+	if err != nil {
+		return RootCA{}, err
+	}
+
+	return NewRootCAWithKeyProvider(cert, signingCert, keyProvider, DefaultNodeCertExpiration, intermediates)
+}
@@ -0,0 +1,113 @@
+package ca_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudflare/cfssl/helpers"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/ca/testutils"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+type stubKeyProvider struct {
+	key   []byte
+	err   error
+	calls int
+}
+
+func (s *stubKeyProvider) GetRootKey(ctx context.Context) ([]byte, error) {
+	s.calls++
+	return s.key, s.err
+}
+
+func TestNewRootCAWithKeyProvider(t *testing.T) {
+	cert, key, err := testutils.CreateRootCertAndKey("rootCN")
+	require.NoError(t, err)
+
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+
+	// only the root certificate is on disk - there is no key file
+	paths := ca.NewConfigPaths(tempBaseDir)
+	require.NoError(t, os.MkdirAll(filepath.Dir(paths.RootCA.Cert), 0755))
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Cert, cert, 0644))
+
+	provider := &stubKeyProvider{err: errors.New("secret store unavailable")}
+	rootCA, err := ca.GetLocalRootCAWithKeyProvider(paths.RootCA, provider)
+	require.NoError(t, err)
+	require.Equal(t, 0, provider.calls)
+
+	// errors from the provider are returned, and the key is fetched again the next time
+	_, err = rootCA.Signer()
+	require.EqualError(t, err, "secret store unavailable")
+
+	provider.key, provider.err = key, nil
+	s, err := rootCA.Signer()
+	require.NoError(t, err)
+	require.Equal(t, key, s.Key)
+	require.Equal(t, 2, provider.calls)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	signed, err := rootCA.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	checkSingleCert(t, signed, "rootCN", "CN", ca.WorkerRole, "ORG")
+
+	// once the signer is created, the provider is not consulted again
+	_, err = rootCA.Signer()
+	require.NoError(t, err)
+	require.Equal(t, 2, provider.calls)
+
+	// a key that doesn't match the signing cert is rejected when it is fetched
+	_, otherKey, err := testutils.CreateRootCertAndKey("otherCN")
+	require.NoError(t, err)
+	rootCA, err = ca.NewRootCAWithKeyProvider(cert, cert, &stubKeyProvider{key: otherKey}, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, err = rootCA.Signer()
+	require.Error(t, err)
+
+	// the file-based provider reports a missing key as no signer
+	rootCA, err = ca.GetLocalRootCAWithKeyProvider(paths.RootCA, ca.FileKeyProvider{Path: paths.RootCA.Key})
+	require.NoError(t, err)
+	_, err = rootCA.Signer()
+	require.Equal(t, ca.ErrNoValidSigner, err)
+
+	require.NoError(t, ioutil.WriteFile(paths.RootCA.Key, key, 0600))
+	_, err = rootCA.Signer()
+	require.NoError(t, err)
+}
+
+func TestGetLocalRootCAWithKeyProviderIntermediates(t *testing.T) {
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+	paths := ca.NewConfigPaths(tempBaseDir)
+
+	rootCA, err := ca.NewRootCA(testutils.ECDSACertChain[2], testutils.ECDSACertChain[1], testutils.ECDSACertChainKeys[1],
+		ca.DefaultNodeCertExpiration, testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	require.NoError(t, ca.SaveRootCA(rootCA, paths.RootCA))
+
+	// the provided key is the intermediate's, so leaves are signed by the intermediate
+	reloaded, err := ca.GetLocalRootCAWithKeyProvider(paths.RootCA, &stubKeyProvider{key: testutils.ECDSACertChainKeys[1]})
+	require.NoError(t, err)
+	require.Equal(t, rootCA.Certs, reloaded.Certs)
+	require.Equal(t, rootCA.Intermediates, reloaded.Intermediates)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	signed, err := reloaded.ParseValidateAndSignCSR(csr, "CN", ca.WorkerRole, "ORG")
+	require.NoError(t, err)
+	parsedCerts, err := ca.ValidateCertChain(reloaded.Pool, signed, false)
+	require.NoError(t, err)
+	require.Len(t, parsedCerts, 2)
+	parsedIntermediate, err := helpers.ParseCertificatePEM(testutils.ECDSACertChain[1])
+	require.NoError(t, err)
+	require.Equal(t, parsedIntermediate.Raw, parsedCerts[1].Raw)
+}
@@ -1,6 +1,7 @@
 package ca
 
 import (
+	"bytes"
 	cryptorand "crypto/rand"
 	"crypto/x509"
 	"encoding/pem"
@@ -94,6 +95,9 @@ func NewKeyReadWriter(paths CertPaths, kek []byte, headersObj PEMKeyHeaders) *Ke
 // keys and modify the headers, and it's easier to have a single canonical key
 // location than two possible key locations.
 func (k *KeyReadWriter) Migrate() error {
+	if k.paths.Combined {
+		return nil // temporary keys were never written in the combined format
+	}
 	tmpPaths := k.genTempPaths()
 	keyBytes, err := ioutil.ReadFile(tmpPaths.Key)
 	if err != nil {
@@ -119,7 +123,7 @@ func (k *KeyReadWriter) Migrate() error {
 func (k *KeyReadWriter) Read() ([]byte, []byte, error) {
 	k.mu.Lock()
 	defer k.mu.Unlock()
-	keyBlock, err := k.readKey()
+	keyBlock, combinedCert, err := k.readKey()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -140,6 +144,13 @@ func (k *KeyReadWriter) Read() ([]byte, []byte, error) {
 	}
 
 	keyBytes := pem.EncodeToMemory(keyBlock)
+	if k.paths.Combined {
+		if _, err := tls.X509KeyPair(combinedCert, keyBytes); err != nil {
+			return nil, nil, err
+		}
+		return combinedCert, keyBytes, nil
+	}
+
 	cert, err := ioutil.ReadFile(k.paths.Cert)
 	// The cert is written to a temporary file first, then the key, and then
 	// the cert gets renamed - so, if interrupted, it's possible to end up with
@@ -182,12 +193,12 @@ func (k *KeyReadWriter) ViewAndRotateKEK(cb func(KEKData, PEMKeyHeaders) (KEKDat
 		return err
 	}
 
-	keyBlock, err := k.readKey()
+	keyBlock, cert, err := k.readKey()
 	if err != nil {
 		return err
 	}
 
-	if err := k.writeKey(keyBlock, updatedKEK, updatedHeaderObj); err != nil {
+	if err := k.writeKey(keyBlock, cert, updatedKEK, updatedHeaderObj); err != nil {
 		return err
 	}
 	return nil
@@ -203,7 +214,7 @@ func (k *KeyReadWriter) ViewAndUpdateHeaders(cb func(PEMKeyHeaders) (PEMKeyHeade
 		return err
 	}
 
-	keyBlock, err := k.readKeyblock()
+	keyBlock, cert, err := k.readKeyblock()
 	if err != nil {
 		return err
 	}
@@ -226,7 +237,7 @@ func (k *KeyReadWriter) ViewAndUpdateHeaders(cb func(PEMKeyHeaders) (PEMKeyHeade
 	headers[versionHeader] = strconv.FormatUint(k.kekData.Version, 10)
 	keyBlock.Headers = headers
 
-	if err = ioutils.AtomicWriteFile(k.paths.Key, pem.EncodeToMemory(keyBlock), keyPerms); err != nil {
+	if err = k.writeKeyFile(keyBlock, cert); err != nil {
 		return err
 	}
 	k.headersObj = pkh
@@ -253,14 +264,6 @@ func (k *KeyReadWriter) Write(certBytes, plaintextKeyBytes []byte, kekData *KEKD
 		return err
 	}
 
-	// Ensure that we will have a keypair on disk at all times by writing the cert to a
-	// temp path first.  This is because we want to have only a single copy of the key
-	// for rotation and header modification.
-	tmpPaths := k.genTempPaths()
-	if err := ioutils.AtomicWriteFile(tmpPaths.Cert, certBytes, certPerms); err != nil {
-		return err
-	}
-
 	keyBlock, _ := pem.Decode(plaintextKeyBytes)
 	if keyBlock == nil {
 		return errors.New("invalid PEM-encoded private key")
@@ -274,10 +277,41 @@ func (k *KeyReadWriter) Write(certBytes, plaintextKeyBytes []byte, kekData *KEKD
 		pkh = k.headersObj.UpdateKEK(k.kekData, *kekData)
 	}
 
-	if err := k.writeKey(keyBlock, *kekData, pkh); err != nil {
+	if k.paths.Combined {
+		// the key and cert are written atomically together, so there is no
+		// need for a temporary cert
+		if err := k.writeKey(keyBlock, certBytes, *kekData, pkh); err != nil {
+			return err
+		}
+		return k.writeChain(certBytes)
+	}
+
+	// Ensure that we will have a keypair on disk at all times by writing the cert to a
+	// temp path first.  This is because we want to have only a single copy of the key
+	// for rotation and header modification.
+	tmpPaths := k.genTempPaths()
+	if err := ioutils.AtomicWriteFile(tmpPaths.Cert, certBytes, certPerms); err != nil {
+		return err
+	}
+	if err := k.writeKey(keyBlock, nil, *kekData, pkh); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPaths.Cert, k.paths.Cert); err != nil {
+		return err
+	}
+	return k.writeChain(certBytes)
+}
+
+// writeChain writes every certificate but the leaf to the chain path, if there is one
+func (k *KeyReadWriter) writeChain(certBytes []byte) error {
+	if k.paths.Chain == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(k.paths.Chain), 0755); err != nil {
 		return err
 	}
-	return os.Rename(tmpPaths.Cert, k.paths.Cert)
+	_, chain := pem.Decode(certBytes)
+	return ioutils.AtomicWriteFile(k.paths.Chain, bytes.TrimLeft(chain, "\n"), certPerms)
 }
 
 func (k *KeyReadWriter) genTempPaths() CertPaths {
@@ -290,45 +324,64 @@ func (k *KeyReadWriter) genTempPaths() CertPaths {
 // Target returns a string representation of this KeyReadWriter, namely where
 // it is writing to
 func (k *KeyReadWriter) Target() string {
+	if k.paths.Combined {
+		return k.paths.Key
+	}
 	return k.paths.Cert
 }
 
-func (k *KeyReadWriter) readKeyblock() (*pem.Block, error) {
+// readKeyblock returns the key pem block and, if the key and cert are
+// combined, the cert that follows it
+func (k *KeyReadWriter) readKeyblock() (*pem.Block, []byte, error) {
 	key, err := ioutil.ReadFile(k.paths.Key)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Decode the PEM private key
-	keyBlock, _ := pem.Decode(key)
+	keyBlock, rest := pem.Decode(key)
 	if keyBlock == nil {
-		return nil, errors.New("invalid PEM-encoded private key")
+		return nil, nil, errors.New("invalid PEM-encoded private key")
 	}
 
-	return keyBlock, nil
+	if !k.paths.Combined {
+		return keyBlock, nil, nil
+	}
+	return keyBlock, bytes.TrimLeft(rest, "\n"), nil
+}
+
+// writeKeyFile writes the key pem block, followed by the cert if the key and
+// cert are combined
+func (k *KeyReadWriter) writeKeyFile(keyBlock *pem.Block, cert []byte) error {
+	contents := pem.EncodeToMemory(keyBlock)
+	if k.paths.Combined {
+		contents = append(contents, cert...)
+	}
+	return ioutils.AtomicWriteFile(k.paths.Key, contents, keyPerms)
 }
 
 // readKey returns the decrypted key pem bytes, and enforces the KEK if applicable
-// (writes it back with the correct encryption if it is not correctly encrypted)
-func (k *KeyReadWriter) readKey() (*pem.Block, error) {
-	keyBlock, err := k.readKeyblock()
+// (writes it back with the correct encryption if it is not correctly encrypted).
+// If the key and cert are combined, it also returns the cert.
+func (k *KeyReadWriter) readKey() (*pem.Block, []byte, error) {
+	keyBlock, cert, err := k.readKeyblock()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if !x509.IsEncryptedPEMBlock(keyBlock) {
-		return keyBlock, nil
+		return keyBlock, cert, nil
 	}
 
 	// If it's encrypted, we can't read without a passphrase (we're assuming
 	// empty passphrases are invalid)
 	if k.kekData.KEK == nil {
-		return nil, ErrInvalidKEK{Wrapped: x509.IncorrectPasswordError}
+		return nil, nil, ErrInvalidKEK{Wrapped: x509.IncorrectPasswordError}
 	}
 
 	derBytes, err := x509.DecryptPEMBlock(keyBlock, k.kekData.KEK)
 	if err != nil {
-		return nil, ErrInvalidKEK{Wrapped: err}
+		return nil, nil, ErrInvalidKEK{Wrapped: err}
 	}
 	// remove encryption PEM headers
 	headers := make(map[string]string)
@@ -338,12 +391,13 @@ func (k *KeyReadWriter) readKey() (*pem.Block, error) {
 		Type:    keyBlock.Type, // the key type doesn't change
 		Bytes:   derBytes,
 		Headers: headers,
-	}, nil
+	}, cert, nil
 }
 
 // writeKey takes an unencrypted keyblock and, if the kek is not nil, encrypts it before
-// writing it to disk.  If the kek is nil, writes it to disk unencrypted.
-func (k *KeyReadWriter) writeKey(keyBlock *pem.Block, kekData KEKData, pkh PEMKeyHeaders) error {
+// writing it to disk.  If the kek is nil, writes it to disk unencrypted.  The cert is
+// only written if the key and cert are combined.
+func (k *KeyReadWriter) writeKey(keyBlock *pem.Block, cert []byte, kekData KEKData, pkh PEMKeyHeaders) error {
 	if kekData.KEK != nil {
 		encryptedPEMBlock, err := x509.EncryptPEMBlock(cryptorand.Reader,
 			keyBlock.Type,
@@ -368,7 +422,7 @@ func (k *KeyReadWriter) writeKey(keyBlock *pem.Block, kekData KEKData, pkh PEMKe
 	}
 	keyBlock.Headers[versionHeader] = strconv.FormatUint(kekData.Version, 10)
 
-	if err := ioutils.AtomicWriteFile(k.paths.Key, pem.EncodeToMemory(keyBlock), keyPerms); err != nil {
+	if err := k.writeKeyFile(keyBlock, cert); err != nil {
 		return err
 	}
 	k.kekData = kekData
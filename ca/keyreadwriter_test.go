@@ -412,3 +412,63 @@ func TestKeyReadWriterMigrate(t *testing.T) {
 	_, _, err = krw.Read()
 	require.NoError(t, err)
 }
+
+// the key and cert can be stored in a single combined PEM file, which is kept
+// private and survives re-encrypting the key and updating its headers
+func TestKeyReadWriterCombined(t *testing.T) {
+	cert, key, err := testutils.CreateRootCertAndKey("cn")
+	require.NoError(t, err)
+
+	tempdir, err := ioutil.TempDir("", "KeyReadWriter")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	path := ca.NewConfigPaths(filepath.Join(tempdir, "subdir"))
+	path.Node.Combined = true
+
+	k := ca.NewKeyReadWriter(path.Node, nil, nil)
+	require.NoError(t, k.Write(cert, key, nil))
+	require.Equal(t, path.Node.Key, k.Target())
+
+	// the key comes first, followed by the cert, all with the key's permissions
+	fileInfo, err := os.Stat(path.Node.Key)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fileInfo.Mode())
+	contents, err := ioutil.ReadFile(path.Node.Key)
+	require.NoError(t, err)
+	keyBlock, rest := pem.Decode(contents)
+	require.NotNil(t, keyBlock)
+	require.Equal(t, "EC PRIVATE KEY", keyBlock.Type)
+	require.Equal(t, cert, rest)
+	_, err = os.Stat(path.Node.Cert)
+	require.True(t, os.IsNotExist(err))
+
+	readCert, readKey, err := ca.NewKeyReadWriter(path.Node, nil, nil).Read()
+	require.NoError(t, err)
+	require.Equal(t, cert, readCert)
+	require.Equal(t, key, readKey)
+
+	// the cert is kept when the key is encrypted and its headers are updated
+	require.NoError(t, k.ViewAndRotateKEK(func(ca.KEKData, ca.PEMKeyHeaders) (ca.KEKData, ca.PEMKeyHeaders, error) {
+		return ca.KEKData{KEK: []byte("kek"), Version: 1}, nil, nil
+	}))
+	require.NoError(t, k.ViewAndUpdateHeaders(func(ca.PEMKeyHeaders) (ca.PEMKeyHeaders, error) {
+		return testHeaders{newHeaders: func(ca.KEKData) (map[string]string, error) {
+			return map[string]string{"updated": "headers"}, nil
+		}}, nil
+	}))
+
+	_, _, err = ca.NewKeyReadWriter(path.Node, nil, nil).Read()
+	require.IsType(t, ca.ErrInvalidKEK{}, err)
+
+	k = ca.NewKeyReadWriter(path.Node, []byte("kek"), testHeaders{setHeaders: func(h map[string]string, _ ca.KEKData) (ca.PEMKeyHeaders, error) {
+		require.Equal(t, map[string]string{"updated": "headers"}, h)
+		return testHeaders{}, nil
+	}})
+	readCert, readKey, err = k.Read()
+	require.NoError(t, err)
+	require.Equal(t, cert, readCert)
+	readKeyBlock, _ := pem.Decode(readKey)
+	require.NotNil(t, readKeyBlock)
+	require.Equal(t, keyBlock.Bytes, readKeyBlock.Bytes)
+}
@@ -0,0 +1,73 @@
+package ca
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cloudflare/cfssl/helpers"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/pkg/errors"
+)
+
+// NodeCertExpiry is the expiry of the certificate of a node in the store.
+type NodeCertExpiry struct {
+	NodeID   string
+	NotAfter time.Time
+	// Err is set if the node's certificate could not be parsed, in which case NotAfter is zero.
+	Err error
+}
+
+// FindExpiringNodeCerts returns the nodes whose certificates expire within the given window from now, sorted by
+// expiry.  Nodes without a certificate, such as nodes whose certificate has not been issued yet, are skipped.
+// Nodes whose certificate could not be parsed are returned with Err set, ahead of the others, rather than
+// failing the whole search.
+func FindExpiringNodeCerts(tx store.ReadTx, window time.Duration) ([]NodeCertExpiry, error) {
+	nodes, err := store.FindNodes(tx, store.All)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(window)
+	var expiring []NodeCertExpiry
+	for _, node := range nodes {
+		if len(node.Certificate.Certificate) == 0 {
+			continue
+		}
+		notAfter, err := NodeCertNotAfter(node)
+		if err != nil {
+			expiring = append(expiring, NodeCertExpiry{NodeID: node.ID, Err: err})
+			continue
+		}
+		if notAfter.Before(deadline) {
+			expiring = append(expiring, NodeCertExpiry{NodeID: node.ID, NotAfter: notAfter})
+		}
+	}
+
+	sort.Stable(nodeCertsByExpiry(expiring))
+	return expiring, nil
+}
+
+type nodeCertsByExpiry []NodeCertExpiry
+
+func (n nodeCertsByExpiry) Len() int {
+	return len(n)
+}
+func (n nodeCertsByExpiry) Swap(i, j int) {
+	n[i], n[j] = n[j], n[i]
+}
+func (n nodeCertsByExpiry) Less(i, j int) bool {
+	return n[i].NotAfter.Before(n[j].NotAfter)
+}
+
+// NodeCertNotAfter returns the expiry of the leaf certificate stored for a node.
+func NodeCertNotAfter(node *api.Node) (time.Time, error) {
+	certs, err := helpers.ParseCertificatesPEM(node.Certificate.Certificate)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "could not parse certificate of node %s", node.ID)
+	}
+	if len(certs) == 0 {
+		return time.Time{}, errors.Errorf("no certificate found for node %s", node.ID)
+	}
+	return certs[0].NotAfter, nil
+}
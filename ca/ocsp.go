@@ -0,0 +1,282 @@
+package ca
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OCSPStatus is the status of a certificate as reported in an OCSP response.
+type OCSPStatus int
+
+const (
+	// OCSPGood means the certificate has not been revoked
+	OCSPGood OCSPStatus = iota
+	// OCSPRevoked means the certificate has been revoked
+	OCSPRevoked
+	// OCSPUnknown means the responder does not know about the certificate
+	OCSPUnknown
+)
+
+// OCSPResponse is the parsed contents of a single-certificate OCSP response.
+type OCSPResponse struct {
+	SerialNumber *big.Int
+	Status       OCSPStatus
+	ThisUpdate   time.Time
+	NextUpdate   time.Time
+	// RevokedAt is only set if Status is OCSPRevoked
+	RevokedAt time.Time
+}
+
+var (
+	oidOCSPBasicResponse = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+	oidSHA1              = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256WithRSA     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidECDSAWithSHA256   = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+)
+
+// The following types mirror the ASN.1 structures defined in RFC 6960.
+
+type ocspResponseASN1 struct {
+	Status   asn1.Enumerated
+	Response ocspResponseBytes `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseBytes struct {
+	ResponseType asn1.ObjectIdentifier
+	Response     []byte
+}
+
+type ocspBasicResponse struct {
+	TBSResponseData    asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certificates       []asn1.RawValue `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseData struct {
+	Raw            asn1.RawContent
+	Version        int           `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID asn1.RawValue `asn1:"explicit,tag:2"`
+	ProducedAt     time.Time     `asn1:"generalized"`
+	Responses      []ocspSingleResponse
+}
+
+type ocspCertID struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	NameHash      []byte
+	IssuerKeyHash []byte
+	SerialNumber  *big.Int
+}
+
+type ocspRevokedInfo struct {
+	RevocationTime time.Time `asn1:"generalized"`
+}
+
+type ocspSingleResponse struct {
+	CertID     ocspCertID
+	Good       asn1.Flag       `asn1:"tag:0,optional"`
+	Revoked    ocspRevokedInfo `asn1:"tag:1,optional"`
+	Unknown    asn1.Flag       `asn1:"tag:2,optional"`
+	ThisUpdate time.Time       `asn1:"generalized"`
+	NextUpdate time.Time       `asn1:"generalized,explicit,tag:0,optional"`
+}
+
+// GenerateOCSPResponse produces a DER encoded OCSP response, signed by this root CA's signer, stating
+// that the certificate with the given serial number has the given status.  The response is valid from
+// now until nextUpdate.  revokedAt is when the certificate was revoked, and is only used, and required,
+// if the status is OCSPRevoked.  This requires a signer, so it can only be used by a CA that is able to
+// issue certificates.
+func (rca *RootCA) GenerateOCSPResponse(certSerial *big.Int, status OCSPStatus, revokedAt, nextUpdate time.Time) ([]byte, error) {
+	if certSerial == nil {
+		return nil, errors.New("no certificate serial number provided")
+	}
+	signer, err := rca.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.parsedCert == nil || signer.cryptoSigner == nil {
+		return nil, ErrNoValidSigner
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if !nextUpdate.IsZero() && !nextUpdate.After(now) {
+		return nil, errors.New("next update time must be in the future")
+	}
+
+	certID, keyHash, err := ocspIssuerCertID(signer.parsedCert, certSerial)
+	if err != nil {
+		return nil, err
+	}
+
+	single := ocspSingleResponse{
+		CertID:     certID,
+		ThisUpdate: now,
+		NextUpdate: nextUpdate.UTC(),
+	}
+	switch status {
+	case OCSPGood:
+		single.Good = true
+	case OCSPRevoked:
+		if revokedAt.IsZero() {
+			return nil, errors.New("no revocation time provided for a revoked certificate")
+		}
+		if revokedAt.After(now) {
+			return nil, errors.New("revocation time must not be in the future")
+		}
+		single.Revoked = ocspRevokedInfo{RevocationTime: revokedAt.UTC()}
+	case OCSPUnknown:
+		single.Unknown = true
+	default:
+		return nil, errors.Errorf("invalid OCSP status %d", status)
+	}
+
+	responderID, err := asn1.Marshal(keyHash)
+	if err != nil {
+		return nil, err
+	}
+	tbs, err := asn1.Marshal(ocspResponseData{
+		RawResponderID: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, IsCompound: true, Bytes: responderID},
+		ProducedAt:     now,
+		Responses:      []ocspSingleResponse{single},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sigAlg asn1.ObjectIdentifier
+	switch signer.cryptoSigner.Public().(type) {
+	case *ecdsa.PublicKey:
+		sigAlg = oidECDSAWithSHA256
+	case *rsa.PublicKey:
+		sigAlg = oidSHA256WithRSA
+	default:
+		return nil, errors.New("unsupported signing key type")
+	}
+	digest := sha256Digest(tbs)
+	signature, err := signer.cryptoSigner.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign OCSP response")
+	}
+
+	basic, err := asn1.Marshal(ocspBasicResponse{
+		TBSResponseData:    asn1.RawValue{FullBytes: tbs},
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: sigAlg},
+		Signature:          asn1.BitString{Bytes: signature, BitLength: 8 * len(signature)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(ocspResponseASN1{
+		Status: 0, // successful
+		Response: ocspResponseBytes{
+			ResponseType: oidOCSPBasicResponse,
+			Response:     basic,
+		},
+	})
+}
+
+// ParseOCSPResponse parses a DER encoded OCSP response for a single certificate, as generated by
+// GenerateOCSPResponse, and verifies that it was signed by the given issuer.
+func ParseOCSPResponse(der []byte, issuer *x509.Certificate) (*OCSPResponse, error) {
+	var resp ocspResponseASN1
+	if rest, err := asn1.Unmarshal(der, &resp); err != nil {
+		return nil, errors.Wrap(err, "malformed OCSP response")
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data in OCSP response")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Errorf("OCSP response status %d is not successful", resp.Status)
+	}
+	if !resp.Response.ResponseType.Equal(oidOCSPBasicResponse) {
+		return nil, errors.New("unsupported OCSP response type")
+	}
+
+	var basic ocspBasicResponse
+	if _, err := asn1.Unmarshal(resp.Response.Response, &basic); err != nil {
+		return nil, errors.Wrap(err, "malformed OCSP basic response")
+	}
+	var data ocspResponseData
+	if _, err := asn1.Unmarshal(basic.TBSResponseData.FullBytes, &data); err != nil {
+		return nil, errors.Wrap(err, "malformed OCSP response data")
+	}
+	if len(data.Responses) != 1 {
+		return nil, errors.Errorf("expected a single OCSP response, got %d", len(data.Responses))
+	}
+
+	var sigAlg x509.SignatureAlgorithm
+	switch {
+	case basic.SignatureAlgorithm.Algorithm.Equal(oidECDSAWithSHA256):
+		sigAlg = x509.ECDSAWithSHA256
+	case basic.SignatureAlgorithm.Algorithm.Equal(oidSHA256WithRSA):
+		sigAlg = x509.SHA256WithRSA
+	default:
+		return nil, errors.New("unsupported OCSP signature algorithm")
+	}
+	if err := issuer.CheckSignature(sigAlg, basic.TBSResponseData.FullBytes, basic.Signature.RightAlign()); err != nil {
+		return nil, errors.Wrap(err, "invalid OCSP response signature")
+	}
+
+	single := data.Responses[0]
+	expected, _, err := ocspIssuerCertID(issuer, single.CertID.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(expected.NameHash, single.CertID.NameHash) || !bytes.Equal(expected.IssuerKeyHash, single.CertID.IssuerKeyHash) {
+		return nil, errors.New("OCSP response is for a certificate from a different issuer")
+	}
+
+	parsed := &OCSPResponse{
+		SerialNumber: single.CertID.SerialNumber,
+		ThisUpdate:   single.ThisUpdate,
+		NextUpdate:   single.NextUpdate,
+	}
+	switch {
+	case bool(single.Good):
+		parsed.Status = OCSPGood
+	case bool(single.Unknown):
+		parsed.Status = OCSPUnknown
+	default:
+		parsed.Status = OCSPRevoked
+		parsed.RevokedAt = single.Revoked.RevocationTime
+	}
+	return parsed, nil
+}
+
+// ocspIssuerCertID returns the OCSP CertID identifying the certificate with the given serial number
+// issued by the issuer, as well as the hash of the issuer's public key.
+func ocspIssuerCertID(issuer *x509.Certificate, serial *big.Int) (ocspCertID, []byte, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return ocspCertID{}, nil, errors.Wrap(err, "could not parse issuer public key")
+	}
+	nameHash := sha1.Sum(issuer.RawSubject)
+	keyHash := sha1.Sum(spki.PublicKey.RightAlign())
+
+	return ocspCertID{
+		HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+		NameHash:      nameHash[:],
+		IssuerKeyHash: keyHash[:],
+		SerialNumber:  serial,
+	}, keyHash[:], nil
+}
+
+func sha256Digest(data []byte) []byte {
+	h := crypto.SHA256.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
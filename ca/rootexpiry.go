@@ -0,0 +1,106 @@
+package ca
+
+import (
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/cloudflare/cfssl/helpers"
+	"github.com/docker/swarmkit/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+)
+
+// DefaultRootCAExpiryWarningThreshold is how long before the root CA certificate expires managers start warning
+// about it, unless configured otherwise.
+const DefaultRootCAExpiryWarningThreshold = 30 * 24 * time.Hour
+
+var (
+	rootCARemainingGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "swarm",
+		Subsystem: "ca",
+		Name:      "root_ca_remaining_seconds",
+		Help:      "Number of seconds until the root CA certificate expires.",
+	})
+	rootCAExpiryWarningsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "swarm",
+		Subsystem: "ca",
+		Name:      "root_ca_expiry_warnings_total",
+		Help:      "Number of times the root CA certificate was found to expire within the warning threshold.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(rootCARemainingGauge)
+	prometheus.MustRegister(rootCAExpiryWarningsCounter)
+}
+
+// RootCAExpiryWarning is sent by MonitorRootCAExpiry when the root CA certificate expires within the threshold.
+type RootCAExpiryWarning struct {
+	// NotAfter is when the root CA certificate expires
+	NotAfter time.Time
+	// Remaining is how long the root CA certificate was still valid for when it was checked, which is negative
+	// if it has already expired
+	Remaining time.Duration
+}
+
+// MonitorRootCAExpiry checks how long the security config's root CA certificate remains valid, right away and then
+// every interval until ctx is done.  Unlike the node certificate, the root CA certificate is never renewed
+// automatically, so when it expires within the threshold, a warning is logged and sent on the returned channel, which
+// is closed when ctx is done.  The time remaining is also exported as a metric.  If the root CA contains more than one
+// certificate, the one that expires first is checked.
+func MonitorRootCAExpiry(ctx context.Context, s *SecurityConfig, threshold, interval time.Duration) <-chan RootCAExpiryWarning {
+	warnings := make(chan RootCAExpiryWarning)
+
+	go func() {
+		defer close(warnings)
+		ctx = log.WithModule(ctx, "tls")
+		for {
+			notAfter, err := rootCANotAfter(s.RootCA())
+			if err != nil {
+				log.G(ctx).WithError(err).Error("failed to read the expiration of the root CA certificate")
+			} else {
+				remaining := notAfter.Sub(time.Now())
+				rootCARemainingGauge.Set(remaining.Seconds())
+				if remaining < threshold {
+					rootCAExpiryWarningsCounter.Inc()
+					log.G(ctx).WithFields(logrus.Fields{
+						"expiration": notAfter,
+					}).Warnf("the root CA certificate expires in %v, and must be rotated before then", remaining)
+
+					select {
+					case warnings <- RootCAExpiryWarning{NotAfter: notAfter, Remaining: remaining}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return warnings
+}
+
+// rootCANotAfter returns the earliest expiration of the certificates in a root CA.
+func rootCANotAfter(rootCA *RootCA) (time.Time, error) {
+	certs, err := helpers.ParseCertificatesPEM(rootCA.Certs)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "could not parse the root CA certificate")
+	}
+	if len(certs) == 0 {
+		return time.Time{}, errors.New("no root CA certificate found")
+	}
+	notAfter := certs[0].NotAfter
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	return notAfter, nil
+}
@@ -0,0 +1,115 @@
+package ca
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// RootRotator coordinates a root rotation for a manager that signs certificates.  Certificates are always signed
+// with a single, fully installed root CA: a rotation is started by atomically switching to a transitional root CA,
+// which signs with the new root's key through a copy of the new root certificate cross-signed by the old root, so
+// that the certificates it issues are trusted by nodes that have either root.  Once the new root has been
+// distributed to every node, the rotation is completed by atomically switching to the new root CA.
+type RootRotator struct {
+	mu sync.RWMutex
+	// active is the root CA that certificates are signed with
+	active *RootCA
+	// pending is the root CA being rotated to, if a rotation is in progress
+	pending *RootCA
+	// securityConfig, if set, is updated whenever the active root CA changes
+	securityConfig *SecurityConfig
+}
+
+// NewRootRotator returns a RootRotator that signs with current.  If securityConfig is not nil, its root CA is kept
+// in sync with the active one, so that the CA server signs with it and TLS connections trust it.
+func NewRootRotator(current RootCA, securityConfig *SecurityConfig) *RootRotator {
+	return &RootRotator{
+		active:         &current,
+		securityConfig: securityConfig,
+	}
+}
+
+// RootCA returns the root CA that certificates are currently signed with.
+func (r *RootRotator) RootCA() *RootCA {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.active
+}
+
+// Rotating returns whether a rotation has been started but not completed.
+func (r *RootRotator) Rotating() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pending != nil
+}
+
+// ParseValidateAndSignCSR signs a CSR like RootCA.ParseValidateAndSignCSR, with the active root CA.  A rotation
+// waits for signatures in progress, so that no certificate is signed by a root CA that is being replaced.
+func (r *RootRotator) ParseValidateAndSignCSR(csrBytes []byte, cn, ou, org string, additionalOUs ...string) ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.active.ParseValidateAndSignCSR(csrBytes, cn, ou, org, additionalOUs...)
+}
+
+// Start starts rotating to newRoot, which must be able to sign.  From then on, certificates are signed with the new
+// root's key, and both roots are trusted.
+func (r *RootRotator) Start(newRoot RootCA) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pending != nil {
+		return errors.New("a root rotation is already in progress")
+	}
+	newSigner, err := newRoot.Signer()
+	if err != nil {
+		return errors.Wrap(err, "the new root CA cannot sign")
+	}
+	crossSigned, err := r.active.CrossSignCACertificate(newSigner.Cert)
+	if err != nil {
+		return errors.Wrap(err, "unable to cross-sign the new root CA certificate")
+	}
+
+	var bundle []byte
+	bundle = append(bundle, r.active.Certs...)
+	bundle = append(bundle, newRoot.Certs...)
+	expiry := newSigner.Policy().Default.Expiry - CertBackdate
+	transitional, err := NewRootCA(bundle, crossSigned, newSigner.Key, expiry, crossSigned)
+	if err != nil {
+		return errors.Wrap(err, "unable to create the transitional root CA")
+	}
+	transitional.IssuancePolicy = newRoot.IssuancePolicy
+
+	if err := r.activate(&transitional); err != nil {
+		return err
+	}
+	r.pending = &newRoot
+	return nil
+}
+
+// Complete finishes the rotation, once every node trusts the new root.  From then on, certificates are signed by
+// the new root directly, and only it is trusted.
+func (r *RootRotator) Complete() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pending == nil {
+		return errors.New("no root rotation is in progress")
+	}
+	if err := r.activate(r.pending); err != nil {
+		return err
+	}
+	r.pending = nil
+	return nil
+}
+
+// activate makes rootCA the active root CA.  It expects the rotator to be locked.
+func (r *RootRotator) activate(rootCA *RootCA) error {
+	if r.securityConfig != nil {
+		if err := r.securityConfig.UpdateRootCA(rootCA, rootCA.Pool); err != nil {
+			return errors.Wrap(err, "unable to update the security config's root CA")
+		}
+	}
+	r.active = rootCA
+	return nil
+}
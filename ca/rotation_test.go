@@ -0,0 +1,122 @@
+package ca_test
+
+import (
+	"crypto/x509"
+	"sync"
+	"testing"
+
+	"github.com/docker/swarmkit/ca"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootRotator(t *testing.T) {
+	oldRoot, err := ca.CreateRootCA("oldRoot")
+	require.NoError(t, err)
+	newRoot, err := ca.CreateRootCA("newRoot")
+	require.NoError(t, err)
+
+	rotator := ca.NewRootRotator(oldRoot, nil)
+	require.False(t, rotator.Rotating())
+	require.Error(t, rotator.Complete())
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// before the rotation, certificates are signed by the old root
+	cert, err := rotator.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(oldRoot.Pool, cert, false)
+	require.NoError(t, err)
+
+	require.NoError(t, rotator.Start(newRoot))
+	require.True(t, rotator.Rotating())
+	require.Error(t, rotator.Start(newRoot))
+
+	// certificates are issued concurrently with the rotation completing
+	var (
+		mu     sync.Mutex
+		issued [][]byte
+		wg     sync.WaitGroup
+	)
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				cert, err := rotator.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+				if err != nil {
+					errs <- err
+					return
+				}
+				mu.Lock()
+				issued = append(issued, cert)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// while rotating, certificates are trusted by nodes with either root
+	cert, err = rotator.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(oldRoot.Pool, cert, false)
+	require.NoError(t, err)
+
+	require.NoError(t, rotator.Complete())
+	require.False(t, rotator.Rotating())
+	require.Equal(t, newRoot.Certs, rotator.RootCA().Certs)
+
+	// once the rotation completes, certificates are signed by the new root directly
+	cert, err = rotator.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	parsed, err := ca.ValidateCertChain(newRoot.Pool, cert, false)
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// every certificate issued since the rotation started is trusted by the final bundle
+	require.Len(t, issued, 20)
+	for _, cert := range issued {
+		_, err := ca.ValidateCertChain(rotator.RootCA().Pool, cert, false)
+		require.NoError(t, err)
+	}
+}
+
+func TestRootRotatorSameSubject(t *testing.T) {
+	// roots created with the default CN, as every cluster's is, share their subject
+	oldRoot, err := ca.CreateRootCA(ca.DefaultRootCN)
+	require.NoError(t, err)
+	newRoot, err := ca.CreateRootCA(ca.DefaultRootCN)
+	require.NoError(t, err)
+
+	rotator := ca.NewRootRotator(oldRoot, nil)
+	require.NoError(t, rotator.Start(newRoot))
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	cert, err := rotator.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	for _, pool := range []*x509.CertPool{oldRoot.Pool, newRoot.Pool, rotator.RootCA().Pool} {
+		_, err = ca.ValidateCertChain(pool, cert, false)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, rotator.Complete())
+	cert, err = rotator.ParseValidateAndSignCSR(csr, "cn", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	_, err = ca.ValidateCertChain(newRoot.Pool, cert, false)
+	require.NoError(t, err)
+
+	// the same roots without the cross-signed intermediate linking them are still ambiguous
+	newSigner, err := newRoot.Signer()
+	require.NoError(t, err)
+	_, err = ca.NewRootCA(append(append([]byte{}, oldRoot.Certs...), newRoot.Certs...), newRoot.Certs, newSigner.Key,
+		ca.DefaultNodeCertExpiration, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "multiple roots with subject")
+}
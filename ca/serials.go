@@ -0,0 +1,109 @@
+package ca
+
+import (
+	"sort"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	gogotypes "github.com/gogo/protobuf/types"
+)
+
+// DefaultIssuedSerialRetention is how long after they expire PruneIssuedSerials keeps the serials of node
+// certificates by default, so that recently expired certificates can still be identified.
+const DefaultIssuedSerialRetention = 7 * 24 * time.Hour
+
+// IssuedSerial is a node certificate issued by the CA, as recorded in the node's certificate history.
+type IssuedSerial struct {
+	NodeID       string
+	SerialNumber string
+	NotAfter     time.Time
+}
+
+// IssuedSerials returns the certificates recorded in the certificate history of every node, ordered by expiry.
+// Certificates whose expiry wasn't recorded have a zero NotAfter, so they come first.
+func (s *Server) IssuedSerials() ([]IssuedSerial, error) {
+	var (
+		nodes []*api.Node
+		err   error
+	)
+	s.store.View(func(tx store.ReadTx) {
+		nodes, err = store.FindNodes(tx, store.All)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var serials []IssuedSerial
+	for _, node := range nodes {
+		for _, issuance := range node.CertificateHistory {
+			serial := IssuedSerial{
+				NodeID:       node.ID,
+				SerialNumber: issuance.SerialNumber,
+			}
+			if issuance.NotAfter != nil {
+				if notAfter, err := gogotypes.TimestampFromProto(issuance.NotAfter); err == nil {
+					serial.NotAfter = notAfter
+				}
+			}
+			serials = append(serials, serial)
+		}
+	}
+	sort.Stable(serialsByExpiry(serials))
+	return serials, nil
+}
+
+type serialsByExpiry []IssuedSerial
+
+func (s serialsByExpiry) Len() int {
+	return len(s)
+}
+func (s serialsByExpiry) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+func (s serialsByExpiry) Less(i, j int) bool {
+	return s[i].NotAfter.Before(s[j].NotAfter)
+}
+
+// PruneIssuedSerials removes from the nodes' certificate history the certificates that expired more than retention
+// before now, and returns how many were removed.  Certificates whose expiry wasn't recorded are kept.
+func (s *Server) PruneIssuedSerials(now time.Time, retention time.Duration) (int, error) {
+	cutoff := now.Add(-retention)
+	expired := func(issuance *api.CertificateIssuance) bool {
+		if issuance.NotAfter == nil {
+			return false
+		}
+		notAfter, err := gogotypes.TimestampFromProto(issuance.NotAfter)
+		return err == nil && notAfter.Before(cutoff)
+	}
+
+	pruned := 0
+	err := s.store.Update(func(tx store.Tx) error {
+		nodes, err := store.FindNodes(tx, store.All)
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			var kept []*api.CertificateIssuance
+			for _, issuance := range node.CertificateHistory {
+				if !expired(issuance) {
+					kept = append(kept, issuance)
+				}
+			}
+			if len(kept) == len(node.CertificateHistory) {
+				continue
+			}
+			removed := len(node.CertificateHistory) - len(kept)
+			node.CertificateHistory = kept
+			if err := store.UpdateNode(tx, node); err != nil {
+				return err
+			}
+			pruned += removed
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
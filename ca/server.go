@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/cloudflare/cfssl/helpers"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/api/equality"
 	"github.com/docker/swarmkit/identity"
@@ -23,6 +24,10 @@ import (
 
 const (
 	defaultReconciliationRetryInterval = 10 * time.Second
+
+	// maxCertificateHistory is the number of issued certificates that are
+	// remembered for each node.
+	maxCertificateHistory = 10
 )
 
 // APISecurityConfigUpdater knows how to update a SecurityConfig from an api.Cluster object
@@ -46,6 +51,16 @@ type Server struct {
 	joinTokens                  *api.JoinTokens
 	reconciliationRetryInterval time.Duration
 
+	// orgJoinTokens holds the join tokens for organizations other than the
+	// cluster's own that this server also signs certificates for. They are
+	// indexed by organization.
+	orgJoinTokens map[string]api.JoinTokens
+
+	// managerSANs are the subject alternative names from the cluster's CA
+	// config which are added to every manager certificate this server
+	// signs.
+	managerSANs []string
+
 	// pending is a map of nodes with pending certificates issuance or
 	// renewal. They are indexed by node ID.
 	pending map[string]*api.Node
@@ -87,6 +102,37 @@ func (s *Server) SetReconciliationRetryInterval(reconciliationRetryInterval time
 	s.reconciliationRetryInterval = reconciliationRetryInterval
 }
 
+// SetOrganizationJoinTokens allows this server to issue and renew certificates for an
+// organization other than the cluster's own.  Nodes joining with one of the given tokens
+// get certificates for that organization, and nodes from different organizations can't
+// renew each other's certificates.  Passing nil tokens stops serving the organization.
+// Any external CAs must also be willing to sign for the organization.
+func (s *Server) SetOrganizationJoinTokens(org string, tokens *api.JoinTokens) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tokens == nil {
+		delete(s.orgJoinTokens, org)
+		return
+	}
+	if s.orgJoinTokens == nil {
+		s.orgJoinTokens = make(map[string]api.JoinTokens)
+	}
+	s.orgJoinTokens[org] = *tokens.Copy()
+}
+
+// organizations returns the cluster's organization followed by any additional
+// organizations this server signs for.
+func (s *Server) organizations() []string {
+	orgs := []string{s.securityConfig.ClientTLSCreds.Organization()}
+	s.mu.Lock()
+	for org := range s.orgJoinTokens {
+		orgs = append(orgs, org)
+	}
+	s.mu.Unlock()
+	return orgs
+}
+
 // GetUnlockKey is responsible for returning the current unlock key used for encrypting TLS private keys and
 // other at rest data.  Access to this RPC call should only be allowed via mutual TLS from managers.
 func (s *Server) GetUnlockKey(ctx context.Context, request *api.GetUnlockKeyRequest) (*api.GetUnlockKeyResponse, error) {
@@ -233,33 +279,45 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 	if localNodeInfo != nil {
 		nodeInfo, ok := localNodeInfo.(RemoteNodeInfo)
 		if ok && nodeInfo.NodeID != "" {
-			return s.issueRenewCertificate(ctx, nodeInfo.NodeID, request.CSR)
+			return s.issueRenewCertificate(ctx, nodeInfo.NodeID, request.CSR, request.RequestedExpiry)
 		}
 	}
 
+	clusterOrg := s.securityConfig.ClientTLSCreds.Organization()
+	orgs := s.organizations()
+
 	// If the remote node is a worker (either forwarded by a manager, or calling directly),
 	// issue a renew worker certificate entry with the correct ID
-	nodeID, err := AuthorizeForwardedRoleAndOrg(ctx, []string{WorkerRole}, []string{ManagerRole}, s.securityConfig.ClientTLSCreds.Organization(), blacklistedCerts)
+	nodeID, nodeOrg, err := AuthorizeForwardedRoleAndOrgs(ctx, []string{WorkerRole}, []string{ManagerRole}, clusterOrg, orgs, blacklistedCerts)
 	if err == nil {
-		return s.issueRenewCertificate(ctx, nodeID, request.CSR)
+		return s.issueRenewCertificateForOrg(ctx, nodeID, nodeOrg, request.CSR, request.RequestedExpiry)
 	}
 
 	// If the remote node is a manager (either forwarded by another manager, or calling directly),
 	// issue a renew certificate entry with the correct ID
-	nodeID, err = AuthorizeForwardedRoleAndOrg(ctx, []string{ManagerRole}, []string{ManagerRole}, s.securityConfig.ClientTLSCreds.Organization(), blacklistedCerts)
+	nodeID, nodeOrg, err = AuthorizeForwardedRoleAndOrgs(ctx, []string{ManagerRole}, []string{ManagerRole}, clusterOrg, orgs, blacklistedCerts)
 	if err == nil {
-		return s.issueRenewCertificate(ctx, nodeID, request.CSR)
+		return s.issueRenewCertificateForOrg(ctx, nodeID, nodeOrg, request.CSR, request.RequestedExpiry)
 	}
 
 	// The remote node didn't successfully present a valid MTLS certificate, let's issue a
 	// certificate with a new random ID
 	role := api.NodeRole(-1)
+	org := clusterOrg
 
 	s.mu.Lock()
 	if subtle.ConstantTimeCompare([]byte(s.joinTokens.Manager), []byte(request.Token)) == 1 {
 		role = api.NodeRoleManager
 	} else if subtle.ConstantTimeCompare([]byte(s.joinTokens.Worker), []byte(request.Token)) == 1 {
 		role = api.NodeRoleWorker
+	} else {
+		for tokenOrg, tokens := range s.orgJoinTokens {
+			if subtle.ConstantTimeCompare([]byte(tokens.Manager), []byte(request.Token)) == 1 {
+				role, org = api.NodeRoleManager, tokenOrg
+			} else if subtle.ConstantTimeCompare([]byte(tokens.Worker), []byte(request.Token)) == 1 {
+				role, org = api.NodeRoleWorker, tokenOrg
+			}
+		}
 	}
 	s.mu.Unlock()
 
@@ -279,9 +337,11 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 				Role: role,
 				ID:   nodeID,
 				Certificate: api.Certificate{
-					CSR:  request.CSR,
-					CN:   nodeID,
-					Role: role,
+					CSR:             request.CSR,
+					CN:              nodeID,
+					Role:            role,
+					Organization:    certificateOrganization(org, clusterOrg),
+					RequestedExpiry: request.RequestedExpiry,
 					Status: api.IssuanceStatus{
 						State: api.IssuanceStatePending,
 					},
@@ -324,11 +384,18 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 
 // issueRenewCertificate receives a nodeID and a CSR and modifies the node's certificate entry with the new CSR
 // and changes the state to RENEW, so it can be picked up and signed by the signing reconciliation loop
-func (s *Server) issueRenewCertificate(ctx context.Context, nodeID string, csr []byte) (*api.IssueNodeCertificateResponse, error) {
+func (s *Server) issueRenewCertificate(ctx context.Context, nodeID string, csr []byte, requestedExpiry *gogotypes.Duration) (*api.IssueNodeCertificateResponse, error) {
+	return s.issueRenewCertificateForOrg(ctx, nodeID, s.securityConfig.ClientTLSCreds.Organization(), csr, requestedExpiry)
+}
+
+// issueRenewCertificateForOrg is like issueRenewCertificate, but also makes sure that the node's
+// certificate was issued for the organization the renewal request was authorized for.
+func (s *Server) issueRenewCertificateForOrg(ctx context.Context, nodeID, org string, csr []byte, requestedExpiry *gogotypes.Duration) (*api.IssueNodeCertificateResponse, error) {
 	var (
 		cert api.Certificate
 		node *api.Node
 	)
+	clusterOrg := s.securityConfig.ClientTLSCreds.Organization()
 	err := s.store.Update(func(tx store.Tx) error {
 		// Attempt to retrieve the node with nodeID
 		node = store.GetNode(tx, nodeID)
@@ -341,11 +408,18 @@ func (s *Server) issueRenewCertificate(ctx context.Context, nodeID string, csr [
 			return grpc.Errorf(codes.NotFound, "node %s not found when attempting to renew certificate", nodeID)
 		}
 
+		// A node can't move to another organization by renewing its certificate
+		if certificateOrganization(org, clusterOrg) != node.Certificate.Organization {
+			return grpc.Errorf(codes.PermissionDenied, "Permission denied: node %s does not belong to organization %s", nodeID, org)
+		}
+
 		// Create a new Certificate entry for this node with the new CSR and a RENEW state
 		cert = api.Certificate{
-			CSR:  csr,
-			CN:   node.ID,
-			Role: node.Role,
+			CSR:             csr,
+			CN:              node.ID,
+			Role:            node.Role,
+			Organization:    node.Certificate.Organization,
+			RequestedExpiry: requestedExpiry,
 			Status: api.IssuanceStatus{
 				State: api.IssuanceStateRenew,
 			},
@@ -370,16 +444,36 @@ func (s *Server) issueRenewCertificate(ctx context.Context, nodeID string, csr [
 	}, nil
 }
 
+// certificateOrganization returns the value of api.Certificate.Organization for a
+// certificate issued for org, which is empty for the cluster's own organization.
+func certificateOrganization(org, clusterOrg string) string {
+	if org == clusterOrg {
+		return ""
+	}
+	return org
+}
+
 // GetRootCACertificate returns the certificate of the Root CA. It is used as a convenience for distributing
 // the root of trust for the swarm. Clients should be using the CA hash to verify if they weren't target to
-// a MiTM. If they fail to do so, node bootstrap works with TOFU semantics.
+// a MiTM. If they fail to do so, node bootstrap works with TOFU semantics.  Clients that already have the
+// current certificate, as identified by the ETag of a previous response, are told so instead of being sent it again.
 func (s *Server) GetRootCACertificate(ctx context.Context, request *api.GetRootCACertificateRequest) (*api.GetRootCACertificateResponse, error) {
 	log.G(ctx).WithFields(logrus.Fields{
 		"method": "GetRootCACertificate",
 	})
 
+	rootCA := s.securityConfig.RootCA()
+	etag := rootCA.Digest.String()
+	if request.IfNoneMatch != "" && request.IfNoneMatch == etag {
+		return &api.GetRootCACertificateResponse{
+			ETag:        etag,
+			NotModified: true,
+		}, nil
+	}
+
 	return &api.GetRootCACertificateResponse{
-		Certificate: s.securityConfig.RootCA().Certs,
+		Certificate: rootCA.Certs,
+		ETag:        etag,
 	}, nil
 }
 
@@ -463,8 +557,9 @@ func (s *Server) Run(ctx context.Context) error {
 				s.evaluateAndSignNodeCert(ctx, v.Node)
 			case api.EventUpdateNode:
 				// If this certificate is already at a final state
-				// no need to evaluate and sign it.
-				if !isFinalState(v.Node.Certificate.Status) {
+				// no need to evaluate and sign it, unless a rotation
+				// was forced.
+				if !isFinalState(v.Node.Certificate.Status) || rotationForced(v.Node) {
 					s.evaluateAndSignNodeCert(ctx, v.Node)
 				}
 			}
@@ -538,6 +633,7 @@ func (s *Server) isRunning() bool {
 func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 	s.mu.Lock()
 	s.joinTokens = cluster.RootCA.JoinTokens.Copy()
+	s.managerSANs = append([]string(nil), cluster.Spec.CAConfig.ManagerSANs...)
 	s.mu.Unlock()
 
 	s.secConfigMu.Lock()
@@ -644,6 +740,12 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 
 // evaluateAndSignNodeCert implements the logic of which certificates to sign
 func (s *Server) evaluateAndSignNodeCert(ctx context.Context, node *api.Node) error {
+	// If an operator has asked for the node's certificate to be rotated,
+	// let the node know first. The resulting node update is evaluated again.
+	if rotationForced(node) {
+		return s.forceCertificateRotation(ctx, node.ID)
+	}
+
 	// If the desired membership and actual state are in sync, there's
 	// nothing to do.
 	certState := node.Certificate.Status.State
@@ -666,6 +768,41 @@ func (s *Server) evaluateAndSignNodeCert(ctx context.Context, node *api.Node) er
 	return nil
 }
 
+// rotationForced returns true if the node's spec asks for a certificate rotation that the CA hasn't
+// acted on yet.
+func rotationForced(node *api.Node) bool {
+	return node.Spec.ForceCertificateRotation != node.LastForcedCertificateRotation
+}
+
+// forceCertificateRotation records that the node's forced certificate rotation has been acted on, and
+// if its certificate has been issued, marks it for rotation.  The node is told about it through its
+// dispatcher session, and renews its certificate right away.
+func (s *Server) forceCertificateRotation(ctx context.Context, nodeID string) error {
+	err := s.store.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, nodeID)
+		if node == nil {
+			return errors.Errorf("node %s not found", nodeID)
+		}
+		if !rotationForced(node) {
+			return nil
+		}
+		node.LastForcedCertificateRotation = node.Spec.ForceCertificateRotation
+		// Any other certificate is either about to be replaced anyway, or
+		// failed to be issued.
+		if node.Certificate.Status.State == api.IssuanceStateIssued {
+			node.Certificate.Status.State = api.IssuanceStateRotate
+		}
+		return store.UpdateNode(tx, node)
+	})
+	if err != nil {
+		log.G(ctx).WithFields(logrus.Fields{
+			"node.id": nodeID,
+			"method":  "(*Server).forceCertificateRotation",
+		}).WithError(err).Errorf("failed to force certificate rotation")
+	}
+	return err
+}
+
 // signNodeCert does the bulk of the work for signing a certificate
 func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 	rootCA := s.securityConfig.RootCA()
@@ -692,12 +829,47 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 		ou     = role
 		org    = s.securityConfig.ClientTLSCreds.Organization()
 	)
+	if node.Certificate.Organization != "" {
+		org = node.Certificate.Organization
+	}
+
+	// Managers also get the cluster's configured SANs, whatever their CSR
+	// requested, so that they don't each need to know the name they are
+	// reached under.
+	req := newIssuanceRequest(rawCSR, cn, ou, org)
+	if err := rootCA.addNodeURI(req); err != nil {
+		log.G(ctx).WithFields(logrus.Fields{
+			"node.id": node.ID,
+			"method":  "(*Server).signNodeCert",
+		}).WithError(err).Warn("not adding node URI")
+	}
+	if ou == ManagerRole {
+		s.mu.Lock()
+		req.Hosts = append(req.Hosts, s.managerSANs...)
+		s.mu.Unlock()
+	}
+	// A requested expiry is only honored when signing locally, since
+	// external CAs apply their own policy.
+	if node.Certificate.RequestedExpiry != nil {
+		if requested, err := gogotypes.DurationFromProto(node.Certificate.RequestedExpiry); err == nil {
+			req.Expiry = rootCA.requestedExpiry(requested)
+		}
+	}
 
 	// Try using the external CA first.
-	cert, err := externalCA.Sign(ctx, PrepareCSR(rawCSR, cn, ou, org))
-	if err == ErrNoExternalCAURLs {
-		// No external CA servers configured. Try using the local CA.
-		cert, err = rootCA.ParseValidateAndSignCSR(rawCSR, cn, ou, org)
+	var cert []byte
+	err = rootCA.applyIssuancePolicy(req)
+	if err == nil {
+		cert, err = externalCA.Sign(ctx, req.signRequest())
+		switch err {
+		case ErrNoExternalCAURLs:
+			// No external CA servers configured. Try using the local CA.
+			cert, err = rootCA.signIssuanceRequest(req)
+		case nil:
+			// We don't control the external CA's policy, so make sure it didn't
+			// copy any extra subject fields from the CSR.
+			err = checkIssuedSubject(cert, req.CN, req.OU, req.Org, req.AdditionalOUs...)
+		}
 	}
 
 	if err != nil {
@@ -743,6 +915,8 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 		return errors.New("failed to sign CSR")
 	}
 
+	issuance := newCertificateIssuance(cert)
+
 	// We were able to successfully sign the new CSR. Let's try to update the nodeStore
 	for {
 		err = s.store.Update(func(tx store.Tx) error {
@@ -750,6 +924,12 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 			node.Certificate.Status = api.IssuanceStatus{
 				State: api.IssuanceStateIssued,
 			}
+			if issuance != nil {
+				node.CertificateHistory = append(node.CertificateHistory, issuance)
+				if len(node.CertificateHistory) > maxCertificateHistory {
+					node.CertificateHistory = node.CertificateHistory[len(node.CertificateHistory)-maxCertificateHistory:]
+				}
+			}
 
 			err := store.UpdateNode(tx, node)
 			if err != nil {
@@ -782,6 +962,30 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 	return nil
 }
 
+// newCertificateIssuance returns the history entry for a newly issued certificate chain,
+// or nil if the certificate can't be parsed.
+func newCertificateIssuance(certChain []byte) *api.CertificateIssuance {
+	certs, err := helpers.ParseCertificatesPEM(certChain)
+	if err != nil || len(certs) == 0 {
+		return nil
+	}
+	cert := certs[0]
+	issuedAt, err := gogotypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil
+	}
+	notAfter, err := gogotypes.TimestampProto(cert.NotAfter)
+	if err != nil {
+		return nil
+	}
+	return &api.CertificateIssuance{
+		SerialNumber: cert.SerialNumber.Text(16),
+		Issuer:       cert.Issuer.String(),
+		IssuedAt:     issuedAt,
+		NotAfter:     notAfter,
+	}
+}
+
 // reconcileNodeCertificates is a helper method that calls evaluateAndSignNodeCert on all the
 // nodes.
 func (s *Server) reconcileNodeCertificates(ctx context.Context, nodes []*api.Node) error {
@@ -2,8 +2,10 @@ package ca_test
 
 import (
 	"bytes"
+	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/docker/swarmkit/ca/testutils"
 	raftutils "github.com/docker/swarmkit/manager/state/raft/testutils"
 	"github.com/docker/swarmkit/manager/state/store"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -31,6 +34,15 @@ func TestGetRootCACertificate(t *testing.T) {
 	resp, err := tc.CAClients[0].GetRootCACertificate(context.Background(), &api.GetRootCACertificateRequest{})
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Certificate)
+	assert.NotEmpty(t, resp.ETag)
+	assert.False(t, resp.NotModified)
+
+	// the certificate isn't sent again to a client which already has it
+	notModifiedResp, err := tc.CAClients[0].GetRootCACertificate(context.Background(), &api.GetRootCACertificateRequest{IfNoneMatch: resp.ETag})
+	assert.NoError(t, err)
+	assert.Empty(t, notModifiedResp.Certificate)
+	assert.Equal(t, resp.ETag, notModifiedResp.ETag)
+	assert.True(t, notModifiedResp.NotModified)
 }
 
 func TestRestartRootCA(t *testing.T) {
@@ -115,6 +127,65 @@ func TestForceRotationIsNoop(t *testing.T) {
 	assert.Equal(t, api.NodeRoleWorker, statusNewResponse.Certificate.Role)
 }
 
+func TestForceCertificateRotation(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodeConfig, err := tc.WriteNewNodeConfig(ca.WorkerRole)
+	require.NoError(t, err)
+	nodeID := nodeConfig.ClientTLSCreds.NodeID()
+	oldCert, _, err := nodeConfig.KeyReader().Read()
+	require.NoError(t, err)
+
+	// The node's certificate is nowhere near expiring, so it would only be
+	// renewed if the renewal is forced.
+	renew := make(chan struct{})
+	updates := ca.RenewTLSConfig(ctx, nodeConfig, tc.ConnBroker, renew)
+
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, nodeID)
+		require.NotNil(t, node)
+		node.Spec.ForceCertificateRotation++
+		return store.UpdateNode(tx, node)
+	}))
+
+	// The CA marks the certificate for rotation, which is what the node
+	// reacts to by forcing a renewal
+	require.NoError(t, raftutils.PollFuncWithTimeout(nil, func() error {
+		var node *api.Node
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			node = store.GetNode(tx, nodeID)
+		})
+		if node.Certificate.Status.State != api.IssuanceStateRotate {
+			return fmt.Errorf("certificate is in state %s", node.Certificate.Status.State)
+		}
+		require.EqualValues(t, 1, node.LastForcedCertificateRotation)
+		return nil
+	}, 5*time.Second))
+	renew <- struct{}{}
+
+	select {
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "the forced renewal timed out")
+	case certUpdate := <-updates:
+		require.NoError(t, certUpdate.Err)
+		require.Equal(t, ca.WorkerRole, certUpdate.Role)
+	}
+	newCert, _, err := nodeConfig.KeyReader().Read()
+	require.NoError(t, err)
+	require.NotEqual(t, oldCert, newCert)
+
+	var node *api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node = store.GetNode(tx, nodeID)
+	})
+	require.Equal(t, api.IssuanceStateIssued, node.Certificate.Status.State)
+	require.EqualValues(t, 1, node.LastForcedCertificateRotation)
+}
+
 func TestIssueNodeCertificateBrokenCA(t *testing.T) {
 	if !testutils.External {
 		t.Skip("test only applicable for external CA configuration")
@@ -210,6 +281,49 @@ func TestIssueNodeCertificateManagerRenewal(t *testing.T) {
 	assert.Equal(t, role, statusResponse.Certificate.Role)
 }
 
+func TestIssueNodeCertificateManagerSANs(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	var cluster *api.Cluster
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster = store.GetCluster(tx, tc.Organization)
+		cluster.Spec.CAConfig.ManagerSANs = []string{"managers.example.com", "10.0.0.1"}
+		return store.UpdateCluster(tx, cluster)
+	}))
+	require.NoError(t, tc.CAServer.UpdateRootCA(tc.Context, cluster))
+
+	issue := func(client api.NodeCAClient, role api.NodeRole) *x509.Certificate {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueResponse, err := client.IssueNodeCertificate(context.Background(),
+			&api.IssueNodeCertificateRequest{CSR: csr, Role: role})
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := client.NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+
+		certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+		require.NoError(t, err)
+		return certs[0]
+	}
+
+	// the configured SANs are added to manager certificates, even though
+	// the CSR didn't request them
+	managerCert := issue(tc.NodeCAClients[2], api.NodeRoleManager)
+	require.Contains(t, managerCert.DNSNames, "managers.example.com")
+	require.Contains(t, managerCert.DNSNames, ca.ManagerRole)
+	require.Len(t, managerCert.IPAddresses, 1)
+	require.Equal(t, "10.0.0.1", managerCert.IPAddresses[0].String())
+
+	// but not to worker certificates
+	workerCert := issue(tc.NodeCAClients[1], api.NodeRoleWorker)
+	require.NotContains(t, workerCert.DNSNames, "managers.example.com")
+	require.Empty(t, workerCert.IPAddresses)
+}
+
 func TestIssueNodeCertificateWorkerFromDifferentOrgRenewal(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -224,6 +338,229 @@ func TestIssueNodeCertificateWorkerFromDifferentOrgRenewal(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestIssueNodeCertificateMultipleOrganizations(t *testing.T) {
+	if testutils.External {
+		return // the test external signer only signs for the organization of its client
+	}
+
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	otherOrg := "swarm-test-tenant-2"
+	otherWorkerToken := ca.GenerateJoinToken(&tc.RootCA)
+	tc.CAServer.SetOrganizationJoinTokens(otherOrg, &api.JoinTokens{
+		Worker:  otherWorkerToken,
+		Manager: ca.GenerateJoinToken(&tc.RootCA),
+	})
+
+	issue := func(dir string, config ca.CertificateRequestConfig) (*tls.Certificate, *x509.Certificate) {
+		krw := ca.NewKeyReadWriter(ca.NewConfigPaths(filepath.Join(tc.TempDir, dir)).Node, nil, nil)
+		config.ConnBroker = tc.ConnBroker
+		cert, err := tc.RootCA.RequestAndSaveNewCertificates(tc.Context, krw, config)
+		require.NoError(t, err)
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return cert, parsed
+	}
+
+	// both organizations get certificates from the same root, each with its own organization
+	_, clusterLeaf := issue("cluster", ca.CertificateRequestConfig{Token: tc.WorkerToken})
+	require.Equal(t, []string{tc.Organization}, clusterLeaf.Subject.Organization)
+
+	otherCert, otherLeaf := issue("other", ca.CertificateRequestConfig{Token: otherWorkerToken})
+	require.Equal(t, []string{otherOrg}, otherLeaf.Subject.Organization)
+	require.Equal(t, []string{ca.WorkerRole}, otherLeaf.Subject.OrganizationalUnit)
+
+	var otherNode *api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		otherNode = store.GetNode(tx, otherLeaf.Subject.CommonName)
+	})
+	require.NotNil(t, otherNode)
+	require.Equal(t, otherOrg, otherNode.Certificate.Organization)
+
+	// renewals stay in the node's organization
+	otherCreds, err := tc.RootCA.NewClientTLSCredentials(otherCert, ca.ManagerRole)
+	require.NoError(t, err)
+	_, renewedLeaf := issue("other", ca.CertificateRequestConfig{Credentials: otherCreds})
+	require.Equal(t, otherLeaf.Subject.CommonName, renewedLeaf.Subject.CommonName)
+	require.Equal(t, []string{otherOrg}, renewedLeaf.Subject.Organization)
+
+	// once the organization is no longer served, its certificates can't be used to renew
+	tc.CAServer.SetOrganizationJoinTokens(otherOrg, nil)
+	_, err = tc.RootCA.RequestAndSaveNewCertificates(tc.Context,
+		ca.NewKeyReadWriter(ca.NewConfigPaths(filepath.Join(tc.TempDir, "other")).Node, nil, nil),
+		ca.CertificateRequestConfig{Credentials: otherCreds, ConnBroker: tc.ConnBroker})
+	require.Error(t, err)
+	require.True(t, ca.IsTerminalError(err))
+
+	// and neither can the other organization's join token
+	_, err = tc.RootCA.RequestAndSaveNewCertificates(tc.Context,
+		ca.NewKeyReadWriter(ca.NewConfigPaths(filepath.Join(tc.TempDir, "other2")).Node, nil, nil),
+		ca.CertificateRequestConfig{Token: otherWorkerToken, ConnBroker: tc.ConnBroker})
+	require.Error(t, err)
+}
+
+func TestIssueNodeCertificateRecordsHistory(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	krw := ca.NewKeyReadWriter(ca.NewConfigPaths(filepath.Join(tc.TempDir, "history")).Node, nil, nil)
+	cert, err := tc.RootCA.RequestAndSaveNewCertificates(tc.Context, krw,
+		ca.CertificateRequestConfig{Token: tc.WorkerToken, ConnBroker: tc.ConnBroker})
+	require.NoError(t, err)
+	creds, err := tc.RootCA.NewClientTLSCredentials(cert, ca.ManagerRole)
+	require.NoError(t, err)
+	_, err = tc.RootCA.RequestAndSaveNewCertificates(tc.Context, krw,
+		ca.CertificateRequestConfig{Credentials: creds, ConnBroker: tc.ConnBroker})
+	require.NoError(t, err)
+
+	var node *api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node = store.GetNode(tx, creds.NodeID())
+	})
+	require.NotNil(t, node)
+	require.Len(t, node.CertificateHistory, 2)
+
+	first, second := node.CertificateHistory[0], node.CertificateHistory[1]
+	require.NotEqual(t, first.SerialNumber, second.SerialNumber)
+	require.NotNil(t, first.IssuedAt)
+	require.NotNil(t, second.NotAfter)
+
+	parsed, err := helpers.ParseCertificatePEM(node.Certificate.Certificate)
+	require.NoError(t, err)
+	require.Equal(t, parsed.SerialNumber.Text(16), second.SerialNumber)
+	require.Equal(t, parsed.Issuer.String(), second.Issuer)
+}
+
+func TestWatchCertificateAudit(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	ctx, cancel := context.WithCancel(tc.Context)
+	defer cancel()
+	stream, err := tc.CAClients[0].WatchCertificateAudit(ctx, &api.WatchCertificateAuditRequest{})
+	require.NoError(t, err)
+	events := make(chan *api.CertificateAuditEvent, 10)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				close(events)
+				return
+			}
+			events <- event
+		}
+	}()
+
+	// issuing a certificate is reported.  The server may not be watching yet when
+	// the stream is opened, so certificates are issued until one of them is.
+	leaves := make(map[string]*x509.Certificate)
+	var event *api.CertificateAuditEvent
+	for i := 0; event == nil; i++ {
+		require.True(t, i < 10, "no certificate issuance was reported")
+		krw := ca.NewKeyReadWriter(ca.NewConfigPaths(filepath.Join(tc.TempDir, fmt.Sprintf("audit%d", i))).Node, nil, nil)
+		cert, err := tc.RootCA.RequestAndSaveNewCertificates(tc.Context, krw,
+			ca.CertificateRequestConfig{Token: tc.WorkerToken, ConnBroker: tc.ConnBroker})
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		leaves[leaf.Subject.CommonName] = leaf
+
+		select {
+		case event = <-events:
+			require.NotNil(t, event)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+	require.Equal(t, api.CertificateAuditActionIssued, event.Action)
+	leaf, ok := leaves[event.NodeID]
+	require.True(t, ok)
+	nodeID := event.NodeID
+	require.Equal(t, api.NodeRoleWorker, event.Role)
+	require.NotNil(t, event.Issuance)
+	require.Equal(t, leaf.SerialNumber.Text(16), event.Issuance.SerialNumber)
+
+	// and so is revoking the node's certificates
+	expiry, err := gogotypes.TimestampProto(leaf.NotAfter)
+	require.NoError(t, err)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		cluster.BlacklistedCertificates = map[string]*api.BlacklistedCertificate{nodeID: {Expiry: expiry}}
+		return store.UpdateCluster(tx, cluster)
+	}))
+
+	// issuances that were reported late may come first
+	for event.Action != api.CertificateAuditActionRevoked {
+		select {
+		case event = <-events:
+			require.NotNil(t, event)
+		case <-time.After(5 * time.Second):
+			t.Fatal("revoking the certificate was not reported")
+		}
+	}
+	require.Equal(t, nodeID, event.NodeID)
+	require.Equal(t, expiry, event.RevokedUntil)
+}
+
+func TestPruneIssuedSerials(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	now := time.Now()
+	issuance := func(serial string, notAfter time.Time) *api.CertificateIssuance {
+		ts, err := gogotypes.TimestampProto(notAfter)
+		require.NoError(t, err)
+		return &api.CertificateIssuance{SerialNumber: serial, NotAfter: ts}
+	}
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		require.NoError(t, store.CreateNode(tx, &api.Node{
+			ID:          "node1",
+			Certificate: api.Certificate{Status: api.IssuanceStatus{State: api.IssuanceStateIssued}},
+			CertificateHistory: []*api.CertificateIssuance{
+				issuance("long-expired", now.Add(-30*24*time.Hour)),
+				issuance("recently-expired", now.Add(-time.Hour)),
+				issuance("valid", now.Add(24*time.Hour)),
+			},
+		}))
+		require.NoError(t, store.CreateNode(tx, &api.Node{
+			ID:          "node2",
+			Certificate: api.Certificate{Status: api.IssuanceStatus{State: api.IssuanceStateIssued}},
+			CertificateHistory: []*api.CertificateIssuance{
+				issuance("expired", now.Add(-10*24*time.Hour)),
+				{SerialNumber: "no-expiry"},
+				issuance("later", now.Add(30*24*time.Hour)),
+			},
+		}))
+		return nil
+	}))
+
+	serialsOf := func() []string {
+		issued, err := tc.CAServer.IssuedSerials()
+		require.NoError(t, err)
+		var serials []string
+		for _, issuedSerial := range issued {
+			if issuedSerial.NodeID == "node1" || issuedSerial.NodeID == "node2" {
+				serials = append(serials, issuedSerial.SerialNumber)
+			}
+		}
+		return serials
+	}
+	// serials are listed by expiry
+	require.Equal(t, []string{"no-expiry", "long-expired", "expired", "recently-expired", "valid", "later"}, serialsOf())
+
+	// only the serials that expired more than the retention ago are pruned
+	pruned, err := tc.CAServer.PruneIssuedSerials(now, 7*24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	require.Equal(t, []string{"no-expiry", "recently-expired", "valid", "later"}, serialsOf())
+
+	// later on, more of them are
+	pruned, err = tc.CAServer.PruneIssuedSerials(now.Add(10*24*time.Hour), 7*24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	require.Equal(t, []string{"no-expiry", "later"}, serialsOf())
+}
+
 func TestNodeCertificateRenewalsDoNotRequireToken(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -0,0 +1,41 @@
+package ca
+
+import (
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// IssueServiceIdentityCertificate signs a CSR for a service identity, rather than for a node, so that tasks of the
+// service can authenticate to each other with mutual TLS.  The certificate has the service ID as its CN and
+// ServiceRole as its only OU, so it can never be mistaken for a node certificate.  It returns the PEM encoded
+// certificate chain.
+func (rca *RootCA) IssueServiceIdentityCertificate(csrBytes []byte, serviceID, org string) ([]byte, error) {
+	if serviceID == "" {
+		return nil, errors.New("no service ID provided")
+	}
+	return rca.ParseValidateAndSignCSR(csrBytes, serviceID, ServiceRole, org)
+}
+
+// ServiceIdentity returns the ID of the service that a certificate issued by IssueServiceIdentityCertificate
+// identifies.  It does not validate the certificate; see ValidateServiceIdentityCertChain.
+func ServiceIdentity(cert *x509.Certificate) (string, error) {
+	ous := cert.Subject.OrganizationalUnit
+	if len(ous) != 1 || ous[0] != ServiceRole {
+		return "", errors.Errorf("certificate is not a service identity certificate: OUs are %v", ous)
+	}
+	if cert.Subject.CommonName == "" {
+		return "", errors.New("service identity certificate has no service ID")
+	}
+	return cert.Subject.CommonName, nil
+}
+
+// ValidateServiceIdentityCertChain validates a PEM encoded certificate chain like ValidateCertChain, and returns
+// the ID of the service identified by its leaf certificate.
+func ValidateServiceIdentityCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool) (string, error) {
+	chain, err := ValidateCertChain(rootPool, certs, allowExpired)
+	if err != nil {
+		return "", err
+	}
+	return ServiceIdentity(chain[0])
+}
@@ -306,8 +306,8 @@ func genSecurityConfig(s *store.MemoryStore, rootCA ca.RootCA, krw *ca.KeyReadWr
 		return nil, err
 	}
 
-	// Append the root CA Key to the certificate, to create a valid chain
-	certChain := append(cert, rootCA.Certs...)
+	// Append the intermediates and the root CA Key to the certificate, to create a valid chain
+	certChain := append(append(cert, rootCA.Intermediates...), rootCA.Certs...)
 
 	// If we were instructed to persist the files
 	if tmpDir != "" {
@@ -343,9 +343,10 @@ func genSecurityConfig(s *store.MemoryStore, rootCA ca.RootCA, krw *ca.KeyReadWr
 
 	if nonSigningRoot {
 		rootCA = ca.RootCA{
-			Certs:  rootCA.Certs,
-			Digest: rootCA.Digest,
-			Pool:   rootCA.Pool,
+			Certs:         rootCA.Certs,
+			Intermediates: rootCA.Intermediates,
+			Digest:        rootCA.Digest,
+			Pool:          rootCA.Pool,
 		}
 	}
 
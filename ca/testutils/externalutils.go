@@ -118,6 +118,15 @@ func (ess *ExternalSigningServer) Deflake() {
 	atomic.StoreUint32(&ess.flaky, 0)
 }
 
+// FailNext makes the signing server respond to the next count requests with
+// the given HTTP status code, without signing anything.
+func (ess *ExternalSigningServer) FailNext(count, statusCode int) {
+	ess.handler.mu.Lock()
+	defer ess.handler.mu.Unlock()
+	ess.handler.failNext = count
+	ess.handler.failStatus = statusCode
+}
+
 // EnableCASigning updates the root CA signer to be able to sign CAs
 func (ess *ExternalSigningServer) EnableCASigning() error {
 	ess.handler.mu.Lock()
@@ -162,6 +171,8 @@ type signHandler struct {
 	flaky      *uint32
 	leafSigner *ca.LocalSigner
 	caSigner   signer.Signer
+	failNext   int
+	failStatus int
 }
 
 func (h *signHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -169,6 +180,16 @@ func (h *signHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 
+	h.mu.Lock()
+	if h.failNext > 0 {
+		h.failNext--
+		status := h.failStatus
+		h.mu.Unlock()
+		w.WriteHeader(status)
+		return
+	}
+	h.mu.Unlock()
+
 	// Check client authentication via mutual TLS.
 	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
 		cfsslErr := cfsslerrors.New(cfsslerrors.APIClientError, cfsslerrors.AuthenticationFailure)
@@ -206,8 +206,27 @@ func GetAndValidateCertificateSubject(certs []tls.Certificate) (pkix.Name, error
 			return pkix.Name{}, errors.New("no valid subject names found for TLS configuration")
 		}
 
-		return x509Cert.Subject, nil
+		subject := x509Cert.Subject
+		subject.OrganizationalUnit = roleFirst(subject.OrganizationalUnit)
+		return subject, nil
 	}
 
 	return pkix.Name{}, errors.New("no valid certificates found for TLS configuration")
 }
+
+// roleFirst returns the OUs with the node's role moved to the front.  Certificates can carry additional OUs
+// besides the role, and since the OUs are encoded as a single set, their order in the certificate isn't
+// necessarily the order they were issued in.
+func roleFirst(ous []string) []string {
+	for i, ou := range ous {
+		switch ou {
+		case ManagerRole, WorkerRole:
+			if i == 0 {
+				return ous
+			}
+			reordered := append([]string{ou}, ous[:i]...)
+			return append(reordered, ous[i+1:]...)
+		}
+	}
+	return ous
+}
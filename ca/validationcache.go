@@ -0,0 +1,116 @@
+package ca
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"sync"
+	"time"
+)
+
+// ValidationCache remembers the certificate chains that ValidateCertChain recently found to be valid, so that
+// validating the same chain against the same root pool again, for instance on every mutual TLS connection from a
+// node, doesn't repeat the parsing, signature checks and chain building.  Only successful validations are
+// remembered, and only until a certificate in the chain or the root it chains up to expires, so the results are
+// always the same as those of ValidateCertChain.  Since adding certificates to a root pool can't make a valid chain
+// invalid, pools may still be added to after being used with the cache.  A nil ValidationCache doesn't cache
+// anything.
+type ValidationCache struct {
+	mu         sync.Mutex
+	maxAge     time.Duration
+	maxEntries int
+	entries    map[validationCacheKey]validationCacheEntry
+}
+
+type validationCacheKey struct {
+	// Keeping a reference to the pool also means that a pool can't be freed, and another one allocated at the same
+	// address, while it has cache entries.
+	pool         *x509.CertPool
+	certsDigest  [sha256.Size]byte
+	allowExpired bool
+}
+
+type validationCacheEntry struct {
+	certs   []*x509.Certificate
+	expires time.Time
+}
+
+// NewValidationCache returns a cache that remembers up to maxEntries successfully validated chains, each for up to
+// maxAge.
+func NewValidationCache(maxAge time.Duration, maxEntries int) *ValidationCache {
+	return &ValidationCache{
+		maxAge:     maxAge,
+		maxEntries: maxEntries,
+		entries:    make(map[validationCacheKey]validationCacheEntry),
+	}
+}
+
+// ValidateCertChain validates the chain like the package level ValidateCertChain, unless the same chain was
+// recently found to be valid against the same root pool.
+func (c *ValidationCache) ValidateCertChain(rootPool *x509.CertPool, certs []byte, allowExpired bool) ([]*x509.Certificate, error) {
+	if c == nil || c.maxEntries <= 0 {
+		return ValidateCertChain(rootPool, certs, allowExpired)
+	}
+
+	key := validationCacheKey{
+		pool:         rootPool,
+		certsDigest:  sha256.Sum256(certs),
+		allowExpired: allowExpired,
+	}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return append([]*x509.Certificate(nil), entry.certs...), nil
+	}
+
+	parsedCerts, chains, err := validateCertChain(rootPool, certs, allowExpired, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	expires := now.Add(c.maxAge)
+	if !allowExpired {
+		// the chain stops being valid as soon as any of the certificates, or every root it chains up to, expires
+		var rootExpiry time.Time
+		for _, chain := range chains {
+			if root := chain[len(chain)-1]; root.NotAfter.After(rootExpiry) {
+				rootExpiry = root.NotAfter
+			}
+		}
+		if rootExpiry.Before(expires) {
+			expires = rootExpiry
+		}
+		for _, cert := range parsedCerts {
+			if cert.NotAfter.Before(expires) {
+				expires = cert.NotAfter
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = validationCacheEntry{certs: parsedCerts, expires: expires}
+
+	return append([]*x509.Certificate(nil), parsedCerts...), nil
+}
+
+// evict makes room for a new entry by removing the expired entries, or an arbitrary one if none have expired.  It
+// expects the cache to be locked.
+func (c *ValidationCache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	for key := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
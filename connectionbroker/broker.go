@@ -52,10 +52,37 @@ func (b *Broker) Select(dialOpts ...grpc.DialOption) (*Conn, error) {
 	return b.SelectRemote(dialOpts...)
 }
 
+// SelectExcluding is like Select, but if a remote manager is chosen, it
+// avoids the managers with the given addresses or node IDs, unless there are
+// no others. This can be used to try another manager after one failed.
+func (b *Broker) SelectExcluding(excludes []string, dialOpts ...grpc.DialOption) (*Conn, error) {
+	b.mu.Lock()
+	localConn := b.localConn
+	b.mu.Unlock()
+
+	if localConn != nil {
+		return &Conn{
+			ClientConn: localConn,
+			isLocal:    true,
+		}, nil
+	}
+
+	return b.SelectRemoteExcluding(excludes, dialOpts...)
+}
+
 // SelectRemote chooses a manager from the remotes, and returns a TCP
 // connection.
 func (b *Broker) SelectRemote(dialOpts ...grpc.DialOption) (*Conn, error) {
-	peer, err := b.remotes.Select()
+	return b.SelectRemoteExcluding(nil, dialOpts...)
+}
+
+// SelectRemoteExcluding is like SelectRemote, but avoids the managers with
+// the given addresses or node IDs, unless there are no others.
+func (b *Broker) SelectRemoteExcluding(excludes []string, dialOpts ...grpc.DialOption) (*Conn, error) {
+	peer, err := b.remotes.Select(excludes...)
+	if err != nil && len(excludes) > 0 {
+		peer, err = b.remotes.Select()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +118,12 @@ type Conn struct {
 	peer    api.Peer
 }
 
+// Peer returns the manager that a remote connection is to. It is empty for a
+// local connection.
+func (c *Conn) Peer() api.Peer {
+	return c.peer
+}
+
 // Close closes the client connection if it is a remote connection. It also
 // records a positive experience with the remote peer if success is true,
 // otherwise it records a negative experience. If a local connection is in use,
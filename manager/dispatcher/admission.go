@@ -0,0 +1,79 @@
+package dispatcher
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// maxSessionAdmissionDelay is the longest a new session is delayed, whatever
+// the window.  Agents give up on a session if they don't receive its first
+// message within 5 seconds, so it has to stay well below that.
+const maxSessionAdmissionDelay = 2 * time.Second
+
+// sessionAdmission spreads new sessions out over a window of time when too
+// many of them are opened at once, for instance when every agent reconnects
+// after a manager restart.
+type sessionAdmission struct {
+	mu        sync.Mutex
+	window    time.Duration
+	threshold int
+	// opens holds the nodes that opened sessions during the last window, and
+	// when they first did, oldest first
+	opens []sessionOpen
+	rand  *rand.Rand
+}
+
+type sessionOpen struct {
+	nodeID string
+	at     time.Time
+}
+
+func newSessionAdmission(window time.Duration, threshold int) *sessionAdmission {
+	return &sessionAdmission{
+		window:    window,
+		threshold: threshold,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Delay records a session being opened by a node at now, and returns how long
+// it should wait before being served. Sessions are served immediately until
+// more than threshold nodes have opened them during the last window, after
+// which they are delayed by a random amount of time within the window, up to
+// maxSessionAdmissionDelay. A node retrying its session is only counted once.
+func (a *sessionAdmission) Delay(nodeID string, now time.Time) time.Duration {
+	if a.window <= 0 {
+		return 0
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cutoff := now.Add(-a.window)
+	expired := 0
+	for expired < len(a.opens) && !a.opens[expired].at.After(cutoff) {
+		expired++
+	}
+	a.opens = a.opens[expired:]
+
+	seen := false
+	for _, open := range a.opens {
+		if open.nodeID == nodeID {
+			seen = true
+			break
+		}
+	}
+	if !seen {
+		a.opens = append(a.opens, sessionOpen{nodeID: nodeID, at: now})
+	}
+
+	if len(a.opens) <= a.threshold {
+		return 0
+	}
+	spread := a.window
+	if spread > maxSessionAdmissionDelay {
+		spread = maxSessionAdmissionDelay
+	}
+	return time.Duration(a.rand.Int63n(int64(spread)))
+}
@@ -0,0 +1,75 @@
+package dispatcher
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// agentSessionTimeout is how long agents wait for the first message of a
+// session, dispatcherRPCTimeout in the agent package.
+const agentSessionTimeout = 5 * time.Second
+
+func TestSessionAdmission(t *testing.T) {
+	window := 100 * time.Millisecond
+	threshold := 10
+	a := newSessionAdmission(window, threshold)
+
+	now := time.Now()
+	delays := make(map[time.Duration]struct{})
+	for i := 0; i < 100; i++ {
+		delay := a.Delay(fmt.Sprintf("node%d", i), now)
+		if i < threshold {
+			if delay != 0 {
+				t.Fatalf("session %d was delayed below the threshold: %v", i, delay)
+			}
+			continue
+		}
+		if delay < 0 || delay >= window {
+			t.Fatalf("session %d was delayed outside of the window: %v", i, delay)
+		}
+		delays[delay] = struct{}{}
+	}
+	if len(delays) < 2 {
+		t.Fatalf("sessions over the threshold were not staggered: %v", delays)
+	}
+
+	// once the window has passed, sessions are served immediately again
+	if delay := a.Delay("node0", now.Add(window)); delay != 0 {
+		t.Fatalf("session was delayed after the window passed: %v", delay)
+	}
+
+	// a node retrying its session is only counted once
+	a = newSessionAdmission(window, threshold)
+	for i := 0; i < 100; i++ {
+		if delay := a.Delay("node", now); delay != 0 {
+			t.Fatalf("retry %d of a single node's session was delayed: %v", i, delay)
+		}
+	}
+
+	// a zero window disables admission control
+	a = newSessionAdmission(0, 0)
+	for i := 0; i < 100; i++ {
+		if delay := a.Delay(fmt.Sprintf("node%d", i), now); delay != 0 {
+			t.Fatalf("session was delayed with admission control disabled: %v", delay)
+		}
+	}
+}
+
+func TestSessionAdmissionDelayBelowAgentTimeout(t *testing.T) {
+	if maxSessionAdmissionDelay > agentSessionTimeout/2 {
+		t.Fatalf("sessions may be delayed by %v, too close to the agents' timeout of %v", maxSessionAdmissionDelay, agentSessionTimeout)
+	}
+
+	// whatever the window, delays are capped
+	a := newSessionAdmission(time.Hour, 0)
+	now := time.Now()
+	for i := 0; i < 1000; i++ {
+		if delay := a.Delay(fmt.Sprintf("node%d", i), now); delay >= maxSessionAdmissionDelay {
+			t.Fatalf("session %d was delayed by %v, more than %v", i, delay, maxSessionAdmissionDelay)
+		}
+	}
+	if delay := DefaultConfig().SessionAdmissionWindow; delay > maxSessionAdmissionDelay {
+		t.Fatalf("the default window of %v is longer than the maximum delay of %v", delay, maxSessionAdmissionDelay)
+	}
+}
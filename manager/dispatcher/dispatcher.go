@@ -2,13 +2,17 @@ package dispatcher
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/transport"
 
 	"github.com/Sirupsen/logrus"
@@ -16,6 +20,7 @@ import (
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/api/equality"
 	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/identity"
 	"github.com/docker/swarmkit/log"
 	"github.com/docker/swarmkit/manager/state"
 	"github.com/docker/swarmkit/manager/state/store"
@@ -34,6 +39,35 @@ const (
 	defaultGracePeriodMultiplier = 3
 	defaultRateLimitPeriod       = 8 * time.Second
 
+	// defaultSessionKeepalivePeriod is how often a session message is
+	// resent to an agent when nothing about its session has changed.
+	defaultSessionKeepalivePeriod = 5 * time.Second
+	// defaultSessionAdmissionWindow and defaultSessionAdmissionThreshold
+	// mean that once more than 100 nodes open sessions within 2 seconds,
+	// further ones are spread out over 2 seconds.
+	defaultSessionAdmissionWindow    = 2 * time.Second
+	defaultSessionAdmissionThreshold = 100
+	// defaultManagerUpdateDebounce is how long the list of managers has to
+	// stay the same before it is sent to agents.
+	defaultManagerUpdateDebounce = 500 * time.Millisecond
+	// maxManagerUpdateDebounces is how many times ManagerUpdateDebounce a
+	// list of managers that keeps changing can be held back for.
+	maxManagerUpdateDebounces = 5
+	// defaultMaxStreamsPerNode leaves plenty of room for the Session and
+	// Tasks or Assignments streams a well-behaved agent keeps open.
+	defaultMaxStreamsPerNode = 10
+	// defaultPreviousSessionGrace is long enough for task status updates
+	// sent just before a node registered again to arrive.
+	defaultPreviousSessionGrace = 5 * time.Second
+	// defaultTaskSendTimeout is long enough for an agent that is busy, but
+	// still reading its streams, to receive a large set of tasks.
+	defaultTaskSendTimeout = time.Minute
+	// defaultQuarantineWindow and defaultQuarantineBackoff mean that, once
+	// quarantine is enabled, a node that keeps failing its heartbeats
+	// within 10 minutes is kept out for 30 seconds at first.
+	defaultQuarantineWindow  = 10 * time.Minute
+	defaultQuarantineBackoff = 30 * time.Second
+
 	// maxBatchItems is the threshold of queued writes that should
 	// trigger an actual transaction to commit them to the shared store.
 	maxBatchItems = 10000
@@ -63,31 +97,247 @@ var (
 	// ErrSessionInvalid returned when the session in use is no longer valid.
 	// The node should re-register and start a new session.
 	ErrSessionInvalid = errors.New("session invalid")
+	// ErrNodeExpired returned, with codes.Aborted, when a node heartbeats
+	// after its heartbeat grace elapsed and it was marked down. The node
+	// should re-register right away, before its tasks are orphaned.
+	ErrNodeExpired = errors.New("node heartbeat expired")
 	// ErrNodeNotFound returned when the Node doesn't exist in raft.
 	ErrNodeNotFound = errors.New("node not found")
+	// ErrTooManyStreams returned, with codes.ResourceExhausted, when a node
+	// already has MaxStreamsPerNode streams open with its session.
+	ErrTooManyStreams = errors.New("too many streams open for node")
 )
 
 // Config is configuration for Dispatcher. For default you should use
 // DefaultConfig.
+//
+// The fields of a Config that wasn't obtained from DefaultConfig, and that
+// are left at zero, are replaced by their defaults when the dispatcher is
+// created, so that a partial configuration doesn't disable heartbeats or
+// rate limiting by accident. To set a field to zero where that is
+// meaningful, such as to disable rate limiting, start from DefaultConfig
+// and set it there.
 type Config struct {
 	HeartbeatPeriod  time.Duration
 	HeartbeatEpsilon time.Duration
+	// HeartbeatEpsilonFraction, if positive, is used instead of
+	// HeartbeatEpsilon to randomize heartbeat periods by up to this
+	// fraction of the current period, so that the randomization scales
+	// when the period changes. It must be less than 1.
+	HeartbeatEpsilonFraction float64
+	// MaxHeartbeatPeriod, if longer than HeartbeatPeriod, is the longest
+	// heartbeat period given to agents that advertise support for longer
+	// periods. Other agents always get HeartbeatPeriod.
+	MaxHeartbeatPeriod time.Duration
 	// RateLimitPeriod specifies how often node with same ID can try to register
 	// new session.
 	RateLimitPeriod       time.Duration
 	GracePeriodMultiplier int
+	// SessionKeepalivePeriod specifies how often a session message is sent
+	// to an agent when there are no membership or configuration changes to
+	// send. Zero disables keepalive messages.
+	SessionKeepalivePeriod time.Duration
+	// SessionAdmissionWindow is the window of time over which new sessions
+	// are spread out, once more than SessionAdmissionThreshold nodes have
+	// opened them within it. This avoids serving every agent at once when
+	// they all reconnect after a manager restart. Sessions are never delayed
+	// by more than 2 seconds, so that agents don't time out waiting for
+	// them. Zero disables it.
+	SessionAdmissionWindow    time.Duration
+	SessionAdmissionThreshold int
+	// ManagerUpdateDebounce is how long the list of managers has to stay
+	// the same before it is sent to agents, so that they don't thrash
+	// between managers while the membership changes rapidly, for example
+	// during an election. A list that never settles is still sent after
+	// maxManagerUpdateDebounces times this. Zero sends every change right
+	// away.
+	ManagerUpdateDebounce time.Duration
+	// MaxStreamsPerNode, if positive, is how many Session, Tasks and
+	// Assignments streams a node may have open at once with its session.
+	// Further streams are rejected, so that a misbehaving agent can't
+	// multiply the load it puts on the dispatcher.
+	MaxStreamsPerNode int
+	// TaskSendTimeout, if positive, is how long sending a message on a
+	// Tasks or Assignments stream may take. A send blocks when the agent
+	// stops reading the stream, so once it times out the stream is closed,
+	// rather than being kept open forever by a stuck agent. Zero disables
+	// it.
+	TaskSendTimeout time.Duration
+	// NewSessionID generates the IDs of new sessions. It defaults to
+	// identity.NewID, and may be replaced to get predictable session IDs
+	// in tests.
+	NewSessionID func() string
+	// CertRenewalThreshold, if positive, is how long before a node's
+	// certificate expires the dispatcher asks the agent, through its
+	// session, to renew it. This allows running short-lived certificates
+	// without relying on the agents' own renewal timers.
+	CertRenewalThreshold time.Duration
+	// PreviousSessionGrace is how long after a node registers again its
+	// previous session is still accepted for UpdateTaskStatus, so that
+	// status updates which were in flight are not lost. The previous
+	// session can't be used for anything else. Zero disables it.
+	PreviousSessionGrace time.Duration
+	// MinAgentVersion, if set, is the oldest agent version, as
+	// MAJOR.MINOR.PATCH, that is allowed to open a session. Older agents,
+	// and agents that don't report their version, are refused with
+	// codes.FailedPrecondition, since they must be upgraded before they
+	// can join.
+	MinAgentVersion string
+	// QuarantineThreshold, if positive, is how many heartbeat timeouts
+	// within QuarantineWindow make a node quarantined. A quarantined node
+	// can't register again for QuarantineBackoff, doubled each further
+	// time it is quarantined, and is refused with codes.Unavailable so
+	// that it retries later. This keeps flapping nodes from churning the
+	// store. Zero disables quarantine.
+	QuarantineThreshold int
+	QuarantineWindow    time.Duration
+	QuarantineBackoff   time.Duration
+	// OnNodeDown, if set, is called with the ID of each node after its
+	// status has been set to DOWN in the store, for example because of a
+	// heartbeat timeout. It is called without any dispatcher locks held, so
+	// it may call back into the dispatcher or the store.
+	OnNodeDown func(nodeID string)
+	// PersistSessions makes the dispatcher record each node's session ID in
+	// the store, so that after a leadership change, the new leader adopts
+	// the sessions of nodes that are not down, instead of making every node
+	// register again. Heartbeats are not recorded; an adopted session is
+	// given the same grace period as a node in the unknown state.
+	PersistSessions bool
+	// MaxNodes, if positive, is the number of registered nodes at which
+	// Health reports the dispatcher as being at capacity, so that load
+	// balancers can direct other nodes elsewhere. Nodes are still allowed
+	// to register beyond it.
+	MaxNodes int
+
+	// fromDefaults is set by DefaultConfig, so that its zero fields are
+	// known to have been set on purpose.
+	fromDefaults bool
 }
 
 // DefaultConfig returns default config for Dispatcher.
 func DefaultConfig() *Config {
 	return &Config{
-		HeartbeatPeriod:       DefaultHeartBeatPeriod,
-		HeartbeatEpsilon:      defaultHeartBeatEpsilon,
-		RateLimitPeriod:       defaultRateLimitPeriod,
-		GracePeriodMultiplier: defaultGracePeriodMultiplier,
+		HeartbeatPeriod:           DefaultHeartBeatPeriod,
+		HeartbeatEpsilon:          defaultHeartBeatEpsilon,
+		RateLimitPeriod:           defaultRateLimitPeriod,
+		GracePeriodMultiplier:     defaultGracePeriodMultiplier,
+		SessionKeepalivePeriod:    defaultSessionKeepalivePeriod,
+		SessionAdmissionWindow:    defaultSessionAdmissionWindow,
+		SessionAdmissionThreshold: defaultSessionAdmissionThreshold,
+		ManagerUpdateDebounce:     defaultManagerUpdateDebounce,
+		MaxStreamsPerNode:         defaultMaxStreamsPerNode,
+		PreviousSessionGrace:      defaultPreviousSessionGrace,
+		TaskSendTimeout:           defaultTaskSendTimeout,
+		QuarantineWindow:          defaultQuarantineWindow,
+		QuarantineBackoff:         defaultQuarantineBackoff,
+		fromDefaults:              true,
 	}
 }
 
+// mergeDefaults returns a copy of c in which the fields that DefaultConfig
+// sets, and that are zero in c, are set to their defaults.
+func mergeDefaults(c *Config) *Config {
+	defaults := DefaultConfig()
+	merged := *c
+	if merged.HeartbeatPeriod == 0 {
+		merged.HeartbeatPeriod = defaults.HeartbeatPeriod
+	}
+	if merged.HeartbeatEpsilon == 0 {
+		merged.HeartbeatEpsilon = defaults.HeartbeatEpsilon
+	}
+	if merged.RateLimitPeriod == 0 {
+		merged.RateLimitPeriod = defaults.RateLimitPeriod
+	}
+	if merged.GracePeriodMultiplier == 0 {
+		merged.GracePeriodMultiplier = defaults.GracePeriodMultiplier
+	}
+	if merged.SessionKeepalivePeriod == 0 {
+		merged.SessionKeepalivePeriod = defaults.SessionKeepalivePeriod
+	}
+	if merged.SessionAdmissionWindow == 0 {
+		merged.SessionAdmissionWindow = defaults.SessionAdmissionWindow
+	}
+	if merged.SessionAdmissionThreshold == 0 {
+		merged.SessionAdmissionThreshold = defaults.SessionAdmissionThreshold
+	}
+	if merged.ManagerUpdateDebounce == 0 {
+		merged.ManagerUpdateDebounce = defaults.ManagerUpdateDebounce
+	}
+	if merged.MaxStreamsPerNode == 0 {
+		merged.MaxStreamsPerNode = defaults.MaxStreamsPerNode
+	}
+	if merged.PreviousSessionGrace == 0 {
+		merged.PreviousSessionGrace = defaults.PreviousSessionGrace
+	}
+	if merged.TaskSendTimeout == 0 {
+		merged.TaskSendTimeout = defaults.TaskSendTimeout
+	}
+	if merged.QuarantineWindow == 0 {
+		merged.QuarantineWindow = defaults.QuarantineWindow
+	}
+	if merged.QuarantineBackoff == 0 {
+		merged.QuarantineBackoff = defaults.QuarantineBackoff
+	}
+	merged.fromDefaults = true
+	return &merged
+}
+
+// normalizeConfig returns a copy of c, merged over the defaults if it wasn't
+// obtained from DefaultConfig, in which a non-positive heartbeat period or
+// grace period multiplier, or a negative heartbeat epsilon, is replaced by
+// its default, since any of them would make the grace timer expire right
+// away and mark every node down. A zero epsilon is valid and
+// means that heartbeat periods aren't randomized. An epsilon fraction
+// outside of [0, 1) is ignored, in favor of the absolute epsilon. A missing
+// session ID generator is replaced by identity.NewID, an invalid minimum
+// agent version is ignored, and a non-positive quarantine window or backoff
+// is replaced by its default.
+func normalizeConfig(c *Config) *Config {
+	defaults := DefaultConfig()
+	if !c.fromDefaults {
+		c = mergeDefaults(c)
+	}
+	normalized := *c
+	if normalized.HeartbeatPeriod <= 0 {
+		log.L.Warnf("dispatcher heartbeat period %s is invalid, using the default of %s", normalized.HeartbeatPeriod, defaults.HeartbeatPeriod)
+		normalized.HeartbeatPeriod = defaults.HeartbeatPeriod
+	}
+	if normalized.HeartbeatEpsilon < 0 {
+		log.L.Warnf("dispatcher heartbeat epsilon %s is invalid, using the default of %s", normalized.HeartbeatEpsilon, defaults.HeartbeatEpsilon)
+		normalized.HeartbeatEpsilon = defaults.HeartbeatEpsilon
+	}
+	if normalized.HeartbeatEpsilonFraction < 0 || normalized.HeartbeatEpsilonFraction >= 1 {
+		log.L.Warnf("dispatcher heartbeat epsilon fraction %v is invalid, using the heartbeat epsilon of %s instead", normalized.HeartbeatEpsilonFraction, normalized.HeartbeatEpsilon)
+		normalized.HeartbeatEpsilonFraction = 0
+	}
+	if normalized.GracePeriodMultiplier <= 0 {
+		log.L.Warnf("dispatcher grace period multiplier %d is invalid, using the default of %d", normalized.GracePeriodMultiplier, defaults.GracePeriodMultiplier)
+		normalized.GracePeriodMultiplier = defaults.GracePeriodMultiplier
+	}
+	if normalized.NewSessionID == nil {
+		normalized.NewSessionID = identity.NewID
+	}
+	if normalized.MinAgentVersion != "" {
+		if _, err := parseAgentVersion(normalized.MinAgentVersion); err != nil {
+			log.L.WithError(err).Warn("dispatcher minimum agent version is invalid, allowing agents of any version")
+			normalized.MinAgentVersion = ""
+		}
+	}
+	if normalized.QuarantineWindow <= 0 {
+		if normalized.QuarantineThreshold > 0 {
+			log.L.Warnf("dispatcher quarantine window %s is invalid, using the default of %s", normalized.QuarantineWindow, defaults.QuarantineWindow)
+		}
+		normalized.QuarantineWindow = defaults.QuarantineWindow
+	}
+	if normalized.QuarantineBackoff <= 0 {
+		if normalized.QuarantineThreshold > 0 {
+			log.L.Warnf("dispatcher quarantine backoff %s is invalid, using the default of %s", normalized.QuarantineBackoff, defaults.QuarantineBackoff)
+		}
+		normalized.QuarantineBackoff = defaults.QuarantineBackoff
+	}
+	return &normalized
+}
+
 // Cluster is interface which represent raft cluster. manager/state/raft.Node
 // is implements it. This interface needed only for easier unit-testing.
 type Cluster interface {
@@ -96,11 +346,15 @@ type Cluster interface {
 	MemoryStore() *store.MemoryStore
 }
 
-// nodeUpdate provides a new status and/or description to apply to a node
-// object.
+// nodeUpdate provides a new status, description and/or metadata to apply to
+// a node object.
 type nodeUpdate struct {
 	status      *api.NodeStatus
 	description *api.NodeDescription
+	metadata    map[string]string
+	// sessionID is recorded along with the status. It is empty unless
+	// sessions are persisted and the node is ready.
+	sessionID string
 }
 
 // Dispatcher is responsible for dispatching tasks and tracking agent health.
@@ -117,6 +371,10 @@ type Dispatcher struct {
 	cluster              Cluster
 	ctx                  context.Context
 	cancel               context.CancelFunc
+	// shuttingDown is set by PrepareStop to tell agents to reconnect
+	// elsewhere on their next heartbeat. It is accessed atomically, so
+	// that heartbeats never have to take mu.
+	shuttingDown int32
 
 	taskUpdates     map[string]*api.TaskStatus // indexed by task ID
 	taskUpdatesLock sync.Mutex
@@ -126,6 +384,15 @@ type Dispatcher struct {
 
 	downNodes *nodeStore
 
+	admission *sessionAdmission
+
+	quarantine *quarantine
+
+	// watchTasks is used by the Tasks stream to get a node's tasks and
+	// watch for changes to them. It is always watchNodeTasks, except in
+	// tests which need to control the watch.
+	watchTasks func(nodeID string) (map[string]*api.Task, chan events.Event, func(), error)
+
 	processUpdatesTrigger chan struct{}
 
 	// for waiting for the next task/node batch update
@@ -134,10 +401,14 @@ type Dispatcher struct {
 }
 
 // New returns Dispatcher with cluster interface(usually raft.Node).
+// Invalid heartbeat settings in c are replaced by their defaults.
 func New(cluster Cluster, c *Config) *Dispatcher {
+	c = normalizeConfig(c)
 	d := &Dispatcher{
-		nodes:                 newNodeStore(c.HeartbeatPeriod, c.HeartbeatEpsilon, c.GracePeriodMultiplier, c.RateLimitPeriod),
-		downNodes:             newNodeStore(defaultNodeDownPeriod, 0, 1, 0),
+		nodes:                 newNodeStore(c.HeartbeatPeriod, c.HeartbeatEpsilon, c.HeartbeatEpsilonFraction, c.GracePeriodMultiplier, c.RateLimitPeriod),
+		downNodes:             newNodeStore(defaultNodeDownPeriod, 0, 0, 1, 0),
+		admission:             newSessionAdmission(c.SessionAdmissionWindow, c.SessionAdmissionThreshold),
+		quarantine:            newQuarantine(c.QuarantineThreshold, c.QuarantineWindow, c.QuarantineBackoff),
 		store:                 cluster.MemoryStore(),
 		cluster:               cluster,
 		taskUpdates:           make(map[string]*api.TaskStatus),
@@ -146,7 +417,10 @@ func New(cluster Cluster, c *Config) *Dispatcher {
 		config:                c,
 	}
 
+	d.nodes.maxPeriod = c.MaxHeartbeatPeriod
+	d.nodes.previousSessionGrace = c.PreviousSessionGrace
 	d.processUpdatesCond = sync.NewCond(&d.processUpdatesLock)
+	d.watchTasks = d.watchNodeTasks
 
 	return d
 }
@@ -206,12 +480,15 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 		d.mu.Unlock()
 		return err
 	}
+	atomic.StoreInt32(&d.shuttingDown, 0)
 	// set queues here to guarantee that Close will close them
 	d.mgrQueue = watch.NewQueue()
 	d.keyMgrQueue = watch.NewQueue()
 
 	peerWatcher, peerCancel := d.cluster.SubscribePeers()
 	defer peerCancel()
+	nodeRemovals, nodeRemovalsCancel := state.Watch(d.store.WatchQueue(), api.EventDeleteNode{})
+	defer nodeRemovalsCancel()
 	d.lastSeenManagers = getWeightedPeers(d.cluster)
 
 	defer cancel()
@@ -238,10 +515,44 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 	batchTimer := time.NewTimer(maxBatchInterval)
 	defer batchTimer.Stop()
 
+	// the latest list of managers, until it has been the same for
+	// ManagerUpdateDebounce or the oldest unsent change is due
+	var (
+		pendingPeers  []*api.Peer
+		debounceTimer *time.Timer
+		debounced     <-chan time.Time
+		debounceDue   time.Time
+	)
+	defer func() {
+		if debounceTimer != nil {
+			debounceTimer.Stop()
+		}
+	}()
+
 	for {
 		select {
 		case ev := <-peerWatcher:
-			publishManagers(ev.([]*api.Peer))
+			if d.config.ManagerUpdateDebounce <= 0 {
+				publishManagers(ev.([]*api.Peer))
+				break
+			}
+			pendingPeers = ev.([]*api.Peer)
+			if debounced == nil {
+				debounceDue = time.Now().Add(maxManagerUpdateDebounces * d.config.ManagerUpdateDebounce)
+			}
+			delay := d.config.ManagerUpdateDebounce
+			if untilDue := time.Until(debounceDue); untilDue < delay {
+				delay = untilDue
+			}
+			if debounceTimer != nil {
+				debounceTimer.Stop()
+			}
+			debounceTimer = time.NewTimer(delay)
+			debounced = debounceTimer.C
+		case <-debounced:
+			publishManagers(pendingPeers)
+			pendingPeers = nil
+			debounced = nil
 		case <-d.processUpdatesTrigger:
 			d.processUpdates(ctx)
 			batchTimer.Reset(maxBatchInterval)
@@ -257,12 +568,19 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 				if heartbeatPeriod != d.config.HeartbeatPeriod {
 					// only call d.nodes.updatePeriod when heartbeatPeriod changes
 					d.config.HeartbeatPeriod = heartbeatPeriod
-					d.nodes.updatePeriod(d.config.HeartbeatPeriod, d.config.HeartbeatEpsilon, d.config.GracePeriodMultiplier)
+					d.nodes.updatePeriod(d.config.HeartbeatPeriod, d.config.HeartbeatEpsilon, d.config.HeartbeatEpsilonFraction, d.config.GracePeriodMultiplier)
 				}
 			}
 			d.networkBootstrapKeys = cluster.Cluster.NetworkBootstrapKeys
 			d.mu.Unlock()
 			d.keyMgrQueue.Publish(cluster.Cluster.NetworkBootstrapKeys)
+		case v := <-nodeRemovals:
+			// a node that was removed from the cluster must not be served
+			// anymore, even if it still holds a session
+			nodeID := v.(api.EventDeleteNode).Node.ID
+			if rn := d.nodes.Remove(nodeID, api.SessionMessage_DisconnectReasonNodeRemoved); rn != nil {
+				log.G(ctx).WithField("node.id", nodeID).Debug("node removed, terminating its session")
+			}
 		case <-ctx.Done():
 			return nil
 		}
@@ -295,6 +613,25 @@ func (d *Dispatcher) Stop() error {
 	return nil
 }
 
+// PrepareStop marks the dispatcher as shutting down. From then on, every
+// heartbeat response asks the agent to reconnect to another manager, so
+// agents can move away before Stop is called.
+func (d *Dispatcher) PrepareStop() {
+	atomic.StoreInt32(&d.shuttingDown, 1)
+}
+
+func (d *Dispatcher) isShuttingDown() bool {
+	return atomic.LoadInt32(&d.shuttingDown) != 0
+}
+
+// Drain marks the dispatcher as shutting down like PrepareStop, and also
+// closes all open sessions right away, so agents move to other managers
+// without waiting for their next heartbeat.
+func (d *Dispatcher) Drain() {
+	d.PrepareStop()
+	d.nodes.DisconnectAll(api.SessionMessage_DisconnectReasonDrain)
+}
+
 func (d *Dispatcher) isRunningLocked() (context.Context, error) {
 	d.mu.Lock()
 	if !d.isRunning() {
@@ -339,11 +676,24 @@ func (d *Dispatcher) markNodesUnknown(ctx context.Context) error {
 					return nil
 				}
 
+				nodeID := node.ID
+
+				// adopt the node's session, so that it can keep using it
+				// instead of registering again
+				if d.config.PersistSessions && node.SessionID != "" {
+					d.nodes.Adopt(node, node.SessionID, func() {
+						log := log.WithField("node", nodeID)
+						log.Debug("heartbeat expiration for adopted session")
+						if err := d.markNodeNotReady(nodeID, api.NodeStatus_DOWN, "heartbeat failure"); err != nil {
+							log.WithError(err).Error("failed deregistering node after heartbeat expiration for adopted session")
+						}
+					})
+					return nil
+				}
+
 				node.Status.State = api.NodeStatus_UNKNOWN
 				node.Status.Message = `Node moved to "unknown" state due to leadership change in cluster`
 
-				nodeID := node.ID
-
 				expireFunc := func() {
 					log := log.WithField("node", nodeID)
 					log.Debug("heartbeat expiration for unknown node")
@@ -380,18 +730,136 @@ func (d *Dispatcher) isRunning() bool {
 	return true
 }
 
+// sessionHeartbeatRange returns the range of heartbeat periods advertised in
+// a session request.
+func sessionHeartbeatRange(r *api.SessionRequest) (heartbeatRange, error) {
+	hbRange := heartbeatRange{min: r.MinHeartbeatPeriod, max: r.MaxHeartbeatPeriod}
+	if hbRange.min < 0 || hbRange.max < 0 || (hbRange.max > 0 && hbRange.min > hbRange.max) {
+		return heartbeatRange{}, grpc.Errorf(codes.InvalidArgument, "invalid heartbeat period range %v-%v", hbRange.min, hbRange.max)
+	}
+	return hbRange, nil
+}
+
+// checkAgentVersion returns an error if the agent that sent a session request
+// is older than the minimum version allowed.
+func (d *Dispatcher) checkAgentVersion(r *api.SessionRequest) error {
+	if d.config.MinAgentVersion == "" {
+		return nil
+	}
+	// the minimum was validated by normalizeConfig
+	minVersion, _ := parseAgentVersion(d.config.MinAgentVersion)
+	if r.AgentVersion == "" {
+		return grpc.Errorf(codes.FailedPrecondition, "agent did not report its version, and agents older than %s are not supported: upgrade the agent", d.config.MinAgentVersion)
+	}
+	version, err := parseAgentVersion(r.AgentVersion)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if version.less(minVersion) {
+		return grpc.Errorf(codes.FailedPrecondition, "agent version %s is older than the minimum supported version %s: upgrade the agent", r.AgentVersion, d.config.MinAgentVersion)
+	}
+	return nil
+}
+
+// HealthStatus is the state of a dispatcher, as reported by Health.
+type HealthStatus struct {
+	// Running is true if the dispatcher is running, which it only does
+	// while its manager is the leader.
+	Running bool
+	// StoreReady is true if the cluster object can be read from the store.
+	StoreReady bool
+	// Nodes is the number of registered nodes.
+	Nodes int
+	// AtCapacity is true if Nodes has reached Config.MaxNodes.
+	AtCapacity bool
+}
+
+// Healthy returns true if the dispatcher can accept more nodes.
+func (s HealthStatus) Healthy() bool {
+	return s.Running && s.StoreReady && !s.AtCapacity
+}
+
+// Health returns the state of the dispatcher, for instance for load balancer
+// health checks. It is cheap enough to be called often: the node registry is
+// only read locked long enough to count the nodes, and the store is only read.
+func (d *Dispatcher) Health() HealthStatus {
+	var status HealthStatus
+	d.mu.Lock()
+	status.Running = d.isRunning()
+	d.mu.Unlock()
+	if !status.Running {
+		return status
+	}
+
+	d.store.View(func(readTx store.ReadTx) {
+		clusters, err := store.FindClusters(readTx, store.ByName(store.DefaultClusterName))
+		status.StoreReady = err == nil && len(clusters) == 1
+	})
+	status.Nodes = d.nodes.Len()
+	status.AtCapacity = d.config.MaxNodes > 0 && status.Nodes >= d.config.MaxNodes
+	return status
+}
+
+// CapacityStatus is how many nodes a dispatcher serves compared to how many
+// it is configured for, as reported by Capacity.
+type CapacityStatus struct {
+	// Nodes is the number of registered nodes.
+	Nodes int
+	// MaxNodes is Config.MaxNodes, or zero if the capacity is unlimited.
+	MaxNodes int
+	// Headroom is how many more nodes can register before the dispatcher
+	// is at capacity. It is zero once MaxNodes is reached, and -1 if the
+	// capacity is unlimited.
+	Headroom int
+	// Utilization is Nodes as a fraction of MaxNodes, which may exceed 1
+	// since nodes are still allowed to register beyond it. It is zero if
+	// the capacity is unlimited.
+	Utilization float64
+}
+
+// Capacity returns how many nodes the dispatcher serves and how many more
+// it has room for, so that an external controller can add managers before
+// the dispatchers are saturated. Like Health, it is cheap enough to be
+// called often. A dispatcher that isn't running serves no nodes.
+func (d *Dispatcher) Capacity() CapacityStatus {
+	status := CapacityStatus{MaxNodes: d.config.MaxNodes, Headroom: -1}
+	if status.MaxNodes < 0 {
+		status.MaxNodes = 0
+	}
+	d.mu.Lock()
+	running := d.isRunning()
+	d.mu.Unlock()
+	if running {
+		status.Nodes = d.nodes.Len()
+	}
+	if status.MaxNodes > 0 {
+		status.Headroom = status.MaxNodes - status.Nodes
+		if status.Headroom < 0 {
+			status.Headroom = 0
+		}
+		status.Utilization = float64(status.Nodes) / float64(status.MaxNodes)
+	}
+	return status
+}
+
 // markNodeReady updates the description of a node, updates its address, and sets status to READY
 // this is used during registration when a new node description is provided
 // and during node updates when the node description changes
-func (d *Dispatcher) markNodeReady(ctx context.Context, nodeID string, description *api.NodeDescription, addr string) error {
-	d.nodeUpdatesLock.Lock()
-	d.nodeUpdates[nodeID] = nodeUpdate{
+func (d *Dispatcher) markNodeReady(ctx context.Context, nodeID string, description *api.NodeDescription, metadata map[string]string, addr, sessionID string) error {
+	update := nodeUpdate{
 		status: &api.NodeStatus{
 			State: api.NodeStatus_READY,
 			Addr:  addr,
 		},
 		description: description,
+		metadata:    metadata,
 	}
+	if d.config.PersistSessions {
+		update.sessionID = sessionID
+	}
+
+	d.nodeUpdatesLock.Lock()
+	d.nodeUpdates[nodeID] = update
 	numUpdates := len(d.nodeUpdates)
 	d.nodeUpdatesLock.Unlock()
 
@@ -421,6 +889,67 @@ func (d *Dispatcher) markNodeReady(ctx context.Context, nodeID string, descripti
 	return nil
 }
 
+// requestIDKey is the gRPC metadata key under which a caller may send an ID
+// for its request, to be logged along with it.
+const requestIDKey = "request_id"
+
+// nodeLogger returns a logger for a request made by a node, with the node's
+// ID, its session ID if it is known, the manager that forwarded the request,
+// if any, and the request ID the caller sent, if any, so that the log lines
+// for one agent can be correlated across calls.
+func nodeLogger(ctx context.Context, nodeInfo ca.RemoteNodeInfo, sessionID, method string) *logrus.Entry {
+	fields := logrus.Fields{
+		"node.id": nodeInfo.NodeID,
+		"method":  method,
+	}
+	if sessionID != "" {
+		fields["node.session"] = sessionID
+	}
+	if nodeInfo.ForwardedBy != nil {
+		fields["forwarder.id"] = nodeInfo.ForwardedBy.NodeID
+	}
+	if md, ok := metadata.FromContext(ctx); ok && len(md[requestIDKey]) != 0 {
+		fields["request.id"] = md[requestIDKey][0]
+	}
+	return log.G(ctx).WithFields(fields)
+}
+
+// sendWithTimeout calls send, which sends a message on a stream, and returns
+// a DeadlineExceeded error if it doesn't complete within timeout, or waits for
+// it indefinitely if timeout isn't positive. The send keeps running after a
+// timeout, until the caller returns from the stream handler, which closes the
+// stream and makes the send fail.
+func sendWithTimeout(timeout time.Duration, send func() error) error {
+	if timeout <= 0 {
+		return send()
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- send()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-errCh:
+		return err
+	case <-timer.C:
+		return grpc.Errorf(codes.DeadlineExceeded, "sending to the node timed out after %s", timeout)
+	}
+}
+
+// checkNodeID returns a PermissionDenied error if a request made for the node
+// claimedID comes from a node whose certificate was issued for another ID, so
+// that a node cannot act on behalf of another one. Requests that don't claim
+// a node ID are made for the node in the certificate.
+func checkNodeID(nodeInfo ca.RemoteNodeInfo, claimedID string) error {
+	if claimedID != "" && claimedID != nodeInfo.NodeID {
+		return grpc.Errorf(codes.PermissionDenied, "request for node %s was made with the certificate of node %s", claimedID, nodeInfo.NodeID)
+	}
+	return nil
+}
+
 // gets the node IP from the context of a grpc call
 func nodeIPFromContext(ctx context.Context) (string, error) {
 	nodeInfo, err := ca.RemoteNode(ctx)
@@ -434,8 +963,25 @@ func nodeIPFromContext(ctx context.Context) (string, error) {
 	return addr, nil
 }
 
+// nodeAddr returns the address to record for a node: the address it
+// advertises, or otherwise the IP address it connects from, which may not be
+// reachable by other nodes.
+func nodeAddr(ctx context.Context, advertiseAddr string) (string, error) {
+	if advertiseAddr != "" {
+		if net.ParseIP(advertiseAddr) == nil {
+			return "", grpc.Errorf(codes.InvalidArgument, "advertised address %q is not an IP address", advertiseAddr)
+		}
+		return advertiseAddr, nil
+	}
+	addr, err := nodeIPFromContext(ctx)
+	if err != nil {
+		log.G(ctx).Debug(err.Error())
+	}
+	return addr, nil
+}
+
 // register is used for registration of node with particular dispatcher.
-func (d *Dispatcher) register(ctx context.Context, nodeID string, description *api.NodeDescription) (string, error) {
+func (d *Dispatcher) register(ctx context.Context, nodeID string, description *api.NodeDescription, metadata map[string]string, advertiseAddr string, hbRange heartbeatRange) (string, error) {
 	// prevent register until we're ready to accept it
 	dctx, err := d.isRunningLocked()
 	if err != nil {
@@ -455,23 +1001,37 @@ func (d *Dispatcher) register(ctx context.Context, nodeID string, description *a
 		return "", ErrNodeNotFound
 	}
 
-	addr, err := nodeIPFromContext(ctx)
+	addr, err := nodeAddr(ctx, advertiseAddr)
 	if err != nil {
-		log.G(ctx).Debug(err.Error())
+		return "", err
+	}
+
+	if remaining := d.quarantine.Remaining(nodeID, time.Now()); remaining > 0 {
+		return "", grpc.Errorf(codes.Unavailable, "node %s is quarantined after repeatedly failing heartbeats, retry in %s", nodeID, remaining)
 	}
 
-	if err := d.markNodeReady(dctx, nodeID, description, addr); err != nil {
+	sessionID := d.config.NewSessionID()
+	if err := d.markNodeReady(dctx, nodeID, description, metadata, addr, sessionID); err != nil {
 		return "", err
 	}
 
+	log := log.G(ctx).WithFields(logrus.Fields{
+		"node.id":      nodeID,
+		"node.session": sessionID,
+		"method":       "(*Dispatcher).register",
+	})
+	log.Debug("node registered")
+
 	expireFunc := func() {
-		log.G(ctx).Debugf("heartbeat expiration")
+		log.Debugf("heartbeat expiration")
+		d.quarantine.RecordTimeout(nodeID, time.Now())
 		if err := d.markNodeNotReady(nodeID, api.NodeStatus_DOWN, "heartbeat failure"); err != nil {
-			log.G(ctx).WithError(err).Errorf("failed deregistering node after heartbeat expiration")
+			log.WithError(err).Errorf("failed deregistering node after heartbeat expiration")
 		}
 	}
 
-	rn := d.nodes.Add(node, expireFunc)
+	rn := d.nodes.AddWithSession(node, sessionID, expireFunc)
+	d.nodes.SetHeartbeatRange(rn, hbRange)
 
 	// NOTE(stevvooe): We need be a little careful with re-registration. The
 	// current implementation just matches the node id and then gives away the
@@ -492,47 +1052,92 @@ func (d *Dispatcher) UpdateTaskStatus(ctx context.Context, r *api.UpdateTaskStat
 	if err != nil {
 		return nil, err
 	}
-	nodeID := nodeInfo.NodeID
-	fields := logrus.Fields{
-		"node.id":      nodeID,
-		"node.session": r.SessionID,
-		"method":       "(*Dispatcher).UpdateTaskStatus",
-	}
-	if nodeInfo.ForwardedBy != nil {
-		fields["forwarder.id"] = nodeInfo.ForwardedBy.NodeID
+	if err := checkNodeID(nodeInfo, r.NodeID); err != nil {
+		return nil, err
 	}
-	log := log.G(ctx).WithFields(fields)
+	nodeID := nodeInfo.NodeID
+	log := nodeLogger(ctx, nodeInfo, r.SessionID, "(*Dispatcher).UpdateTaskStatus")
 
 	dctx, err := d.isRunningLocked()
 	if err != nil {
 		return nil, err
 	}
 
-	if _, err := d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
+	if err := d.enqueueTaskUpdates(dctx, log, nodeID, r); err != nil {
 		return nil, err
 	}
+	return nil, nil
+}
 
-	// Validate task updates
-	for _, u := range r.Updates {
-		if u.Status == nil {
-			log.WithField("task.id", u.TaskID).Warn("task report has nil status")
-			continue
-		}
+// UpdateTaskStatusStream is like UpdateTaskStatus, but receives any number
+// of status updates over a single stream. Updates are queued like those of
+// UpdateTaskStatus, so that updates to the same task are coalesced and they
+// are all written in as few store transactions as possible.
+func (d *Dispatcher) UpdateTaskStatusStream(stream api.Dispatcher_UpdateTaskStatusStreamServer) error {
+	ctx := stream.Context()
+	nodeInfo, err := ca.RemoteNode(ctx)
+	if err != nil {
+		return err
+	}
+	nodeID := nodeInfo.NodeID
+	log := nodeLogger(ctx, nodeInfo, "", "(*Dispatcher).UpdateTaskStatusStream")
 
-		var t *api.Task
-		d.store.View(func(tx store.ReadTx) {
-			t = store.GetTask(tx, u.TaskID)
-		})
-		if t == nil {
-			log.WithField("task.id", u.TaskID).Warn("cannot find target task in store")
-			continue
+	dctx, err := d.isRunningLocked()
+	if err != nil {
+		return err
+	}
+
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&api.UpdateTaskStatusResponse{})
+		}
+		if err != nil {
+			return err
 		}
+		if err := checkNodeID(nodeInfo, r.NodeID); err != nil {
+			return err
+		}
+		// the session is checked again for every batch of updates, so
+		// that a node can't keep using a stream after its session ends
+		if err := d.enqueueTaskUpdates(dctx, log.WithField("node.session", r.SessionID), nodeID, r); err != nil {
+			return err
+		}
+	}
+}
 
-		if t.NodeID != nodeID {
-			err := grpc.Errorf(codes.PermissionDenied, "cannot update a task not assigned this node")
-			log.WithField("task.id", u.TaskID).Error(err)
-			return nil, err
+// enqueueTaskUpdates checks that the session of the node that sent the task
+// updates in r is valid and that the updated tasks are assigned to it, and
+// queues the updates to be written to the store.
+func (d *Dispatcher) enqueueTaskUpdates(dctx context.Context, log *logrus.Entry, nodeID string, r *api.UpdateTaskStatusRequest) error {
+	if _, err := d.nodes.GetWithPreviousSession(nodeID, r.SessionID); err != nil {
+		return err
+	}
+
+	// Validate task updates
+	var validateErr error
+	d.store.View(func(tx store.ReadTx) {
+		for _, u := range r.Updates {
+			if u.Status == nil {
+				log.WithField("task.id", u.TaskID).Warn("task report has nil status")
+				continue
+			}
+
+			t := store.GetTask(tx, u.TaskID)
+			if t == nil {
+				log.WithField("task.id", u.TaskID).Warn("cannot find target task in store")
+				continue
+			}
+
+			if t.NodeID != nodeID {
+				validateErr = grpc.Errorf(codes.PermissionDenied, "cannot update a task not assigned this node")
+				log.WithField("task.id", u.TaskID).Error(validateErr)
+				return
+			}
 		}
+	})
+	if validateErr != nil {
+		return validateErr
 	}
 
 	d.taskUpdatesLock.Lock()
@@ -553,7 +1158,7 @@ func (d *Dispatcher) UpdateTaskStatus(ctx context.Context, r *api.UpdateTaskStat
 		case <-dctx.Done():
 		}
 	}
-	return nil, nil
+	return nil
 }
 
 func (d *Dispatcher) processUpdates(ctx context.Context) {
@@ -583,6 +1188,7 @@ func (d *Dispatcher) processUpdates(ctx context.Context) {
 		"method": "(*Dispatcher).processUpdates",
 	})
 
+	var downNodeIDs []string
 	_, err := d.store.Batch(func(batch *store.Batch) error {
 		for taskID, status := range taskUpdates {
 			err := batch.Update(func(tx store.Tx) error {
@@ -600,6 +1206,14 @@ func (d *Dispatcher) processUpdates(ctx context.Context) {
 					return nil
 				}
 
+				// A task in a terminal state can only move further
+				// forward, so that a delayed report can't overwrite
+				// its final status.
+				if task.Status.State >= api.TaskStateCompleted && task.Status.State >= status.State {
+					logger.Info("task is already in a terminal state, ignoring status update")
+					return nil
+				}
+
 				if task.Status.State > status.State {
 					logger.Debug("task status invalid transition")
 					return nil
@@ -633,16 +1247,23 @@ func (d *Dispatcher) processUpdates(ctx context.Context) {
 					if nodeUpdate.status.Addr != "" {
 						node.Status.Addr = nodeUpdate.status.Addr
 					}
+					node.SessionID = nodeUpdate.sessionID
 				}
 				if nodeUpdate.description != nil {
 					node.Description = nodeUpdate.description
 				}
+				if nodeUpdate.metadata != nil {
+					node.Metadata = nodeUpdate.metadata
+				}
 
 				if err := store.UpdateNode(tx, node); err != nil {
 					logger.WithError(err).Error("failed to update node status")
 					return nil
 				}
 				logger.Debug("node status updated")
+				if nodeUpdate.status != nil && nodeUpdate.status.State == api.NodeStatus_DOWN {
+					downNodeIDs = append(downNodeIDs, nodeID)
+				}
 				return nil
 			})
 			if err != nil {
@@ -657,6 +1278,12 @@ func (d *Dispatcher) processUpdates(ctx context.Context) {
 	}
 
 	d.processUpdatesCond.Broadcast()
+
+	if d.config.OnNodeDown != nil {
+		for _, nodeID := range downNodeIDs {
+			d.config.OnNodeDown(nodeID)
+		}
+	}
 }
 
 // Tasks is a stream of tasks state for node. Each message contains full list
@@ -667,6 +1294,9 @@ func (d *Dispatcher) Tasks(r *api.TasksRequest, stream api.Dispatcher_TasksServe
 	if err != nil {
 		return err
 	}
+	if err := checkNodeID(nodeInfo, r.NodeID); err != nil {
+		return err
+	}
 	nodeID := nodeInfo.NodeID
 
 	dctx, err := d.isRunningLocked()
@@ -674,61 +1304,77 @@ func (d *Dispatcher) Tasks(r *api.TasksRequest, stream api.Dispatcher_TasksServe
 		return err
 	}
 
-	fields := logrus.Fields{
-		"node.id":      nodeID,
-		"node.session": r.SessionID,
-		"method":       "(*Dispatcher).Tasks",
-	}
-	if nodeInfo.ForwardedBy != nil {
-		fields["forwarder.id"] = nodeInfo.ForwardedBy.NodeID
+	log := nodeLogger(stream.Context(), nodeInfo, r.SessionID, "(*Dispatcher).Tasks")
+	log.Debugf("")
+
+	var desiredStates map[api.TaskState]struct{}
+	if len(r.DesiredStates) > 0 {
+		desiredStates = make(map[api.TaskState]struct{}, len(r.DesiredStates))
+		for _, state := range r.DesiredStates {
+			desiredStates[state] = struct{}{}
+		}
 	}
-	log.G(stream.Context()).WithFields(fields).Debugf("")
 
-	if _, err = d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
+	rn, err := d.nodes.GetWithSession(nodeID, r.SessionID)
+	if err != nil {
 		return err
 	}
-
-	tasksMap := make(map[string]*api.Task)
-	nodeTasks, cancel, err := store.ViewAndWatch(
-		d.store,
-		func(readTx store.ReadTx) error {
-			tasks, err := store.FindTasks(readTx, store.ByNodeID(nodeID))
-			if err != nil {
-				return err
-			}
-			for _, t := range tasks {
-				tasksMap[t.ID] = t
-			}
-			return nil
-		},
-		api.EventCreateTask{Task: &api.Task{NodeID: nodeID},
-			Checks: []api.TaskCheckFunc{state.TaskCheckNodeID}},
-		api.EventUpdateTask{Task: &api.Task{NodeID: nodeID},
-			Checks: []api.TaskCheckFunc{state.TaskCheckNodeID}},
-		api.EventDeleteTask{Task: &api.Task{NodeID: nodeID},
-			Checks: []api.TaskCheckFunc{state.TaskCheckNodeID}},
-	)
+	closeStream, err := rn.openStream(d.config.MaxStreamsPerNode)
 	if err != nil {
 		return err
 	}
-	defer cancel()
+	defer closeStream()
 
+	tasksMap, nodeTasks, cancel, err := d.watchTasks(nodeID)
+	if err != nil {
+		return tasksReadError(err)
+	}
+	defer func() {
+		// cancel is nil if resyncing after a dropped watch failed
+		if cancel != nil {
+			cancel()
+		}
+	}()
+
+	var previousVersion uint64
 	for {
 		if _, err := d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
 			return err
 		}
 
-		var tasks []*api.Task
+		var (
+			tasks        []*api.Task
+			storeVersion uint64
+		)
 		for _, t := range tasksMap {
-			// dispatcher only sends tasks that have been assigned to a node
-			if t != nil && t.Status.State >= api.TaskStateAssigned {
-				tasks = append(tasks, t)
+			if t == nil {
+				continue
+			}
+			// dispatcher only sends tasks that have been assigned to a node,
+			// and that have one of the requested desired states, if any
+			if t.Status.State >= api.TaskStateAssigned {
+				if _, ok := desiredStates[t.DesiredState]; desiredStates == nil || ok {
+					tasks = append(tasks, t)
+				}
+			}
+			if t.Meta.Version.Index > storeVersion {
+				storeVersion = t.Meta.Version.Index
 			}
 		}
 
-		if err := stream.Send(&api.TasksMessage{Tasks: tasks}); err != nil {
+		version := rn.nextTasksVersion(storeVersion)
+		msg := &api.TasksMessage{
+			Tasks:           tasks,
+			Version:         version,
+			PreviousVersion: previousVersion,
+		}
+		if err := sendWithTimeout(d.config.TaskSendTimeout, func() error { return stream.Send(msg) }); err != nil {
+			if grpc.Code(err) == codes.DeadlineExceeded {
+				log.Warn("closing tasks stream, the node is not receiving its tasks")
+			}
 			return err
 		}
+		previousVersion = version
 
 		// bursty events should be processed in batches and sent out snapshot
 		var (
@@ -740,7 +1386,21 @@ func (d *Dispatcher) Tasks(r *api.TasksRequest, stream api.Dispatcher_TasksServe
 	batchingLoop:
 		for modificationCnt < modificationBatchLimit {
 			select {
-			case event := <-nodeTasks:
+			case event, ok := <-nodeTasks:
+				if !ok {
+					// The watch was dropped, so changes may have been
+					// missed. Watch queues are unbounded, so this only
+					// happens when the watch is cancelled or the store is
+					// closed. Rebuild the node's tasks from the store,
+					// and send them all.
+					log.Warn("tasks watch was dropped, resyncing tasks from the store")
+					cancel()
+					tasksMap, nodeTasks, cancel, err = d.watchTasks(nodeID)
+					if err != nil {
+						return tasksReadError(err)
+					}
+					break batchingLoop
+				}
 				switch v := event.(type) {
 				case api.EventCreateTask:
 					tasksMap[v.Task.ID] = v.Task
@@ -774,6 +1434,8 @@ func (d *Dispatcher) Tasks(r *api.TasksRequest, stream api.Dispatcher_TasksServe
 				return stream.Context().Err()
 			case <-dctx.Done():
 				return dctx.Err()
+			case <-rn.Invalidated:
+				return grpc.Errorf(codes.InvalidArgument, "%s", ErrSessionInvalid)
 			}
 		}
 
@@ -783,6 +1445,86 @@ func (d *Dispatcher) Tasks(r *api.TasksRequest, stream api.Dispatcher_TasksServe
 	}
 }
 
+// watchNodeTasks returns the tasks currently on the node, and a watch for
+// changes to them from that point on.
+func (d *Dispatcher) watchNodeTasks(nodeID string) (map[string]*api.Task, chan events.Event, func(), error) {
+	tasksMap := make(map[string]*api.Task)
+	nodeTasks, cancel, err := store.ViewAndWatch(
+		d.store,
+		func(readTx store.ReadTx) error {
+			// don't serve a node that was removed from the cluster
+			if store.GetNode(readTx, nodeID) == nil {
+				return ErrNodeNotFound
+			}
+			tasks, err := store.FindTasks(readTx, store.ByNodeID(nodeID))
+			if err != nil {
+				return err
+			}
+			for _, t := range tasks {
+				tasksMap[t.ID] = t
+			}
+			return nil
+		},
+		api.EventCreateTask{Task: &api.Task{NodeID: nodeID},
+			Checks: []api.TaskCheckFunc{state.TaskCheckNodeID}},
+		api.EventUpdateTask{Task: &api.Task{NodeID: nodeID},
+			Checks: []api.TaskCheckFunc{state.TaskCheckNodeID}},
+		api.EventDeleteTask{Task: &api.Task{NodeID: nodeID},
+			Checks: []api.TaskCheckFunc{state.TaskCheckNodeID}},
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return tasksMap, nodeTasks, cancel, nil
+}
+
+// tasksReadError returns the error to end a Tasks or Assignments stream with
+// when the node's tasks could not be read from the store. Unless the node was
+// removed, the error is likely transient, so the agent is told to try again
+// rather than being sent an incomplete set of tasks that it would act on.
+func tasksReadError(err error) error {
+	if err == ErrNodeNotFound {
+		return err
+	}
+	return grpc.Errorf(codes.Unavailable, "failed to read the node's tasks from the store: %v", err)
+}
+
+// TasksForNode returns the tasks that the dispatcher would currently send to
+// the node on its Tasks stream. It is a read-only snapshot, meant for
+// comparing what the managers think a node is running with what the node
+// reports. The node must be registered with this dispatcher.
+func (d *Dispatcher) TasksForNode(nodeID string) ([]*api.Task, error) {
+	rn, err := d.nodes.Get(nodeID)
+	if err != nil {
+		return nil, err
+	}
+	// nodes in the unknown state are only waiting to reconnect, and have no
+	// session to send tasks on
+	rn.mu.Lock()
+	sessionID := rn.SessionID
+	rn.mu.Unlock()
+	if sessionID == "" {
+		return nil, grpc.Errorf(codes.NotFound, "%s", ErrNodeNotRegistered)
+	}
+
+	var tasks []*api.Task
+	d.store.View(func(readTx store.ReadTx) {
+		tasks, err = store.FindTasks(readTx, store.ByNodeID(nodeID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var assigned []*api.Task
+	for _, t := range tasks {
+		// dispatcher only sends tasks that have been assigned to a node
+		if t.Status.State >= api.TaskStateAssigned {
+			assigned = append(assigned, t)
+		}
+	}
+	return assigned, nil
+}
+
 // Assignments is a stream of assignments for a node. Each message contains
 // either full list of tasks and secrets for the node, or an incremental update.
 func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatcher_AssignmentsServer) error {
@@ -790,6 +1532,9 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 	if err != nil {
 		return err
 	}
+	if err := checkNodeID(nodeInfo, r.NodeID); err != nil {
+		return err
+	}
 	nodeID := nodeInfo.NodeID
 
 	dctx, err := d.isRunningLocked()
@@ -797,20 +1542,18 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 		return err
 	}
 
-	fields := logrus.Fields{
-		"node.id":      nodeID,
-		"node.session": r.SessionID,
-		"method":       "(*Dispatcher).Assignments",
-	}
-	if nodeInfo.ForwardedBy != nil {
-		fields["forwarder.id"] = nodeInfo.ForwardedBy.NodeID
-	}
-	log := log.G(stream.Context()).WithFields(fields)
+	log := nodeLogger(stream.Context(), nodeInfo, r.SessionID, "(*Dispatcher).Assignments")
 	log.Debugf("")
 
-	if _, err = d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
+	rn, err := d.nodes.GetWithSession(nodeID, r.SessionID)
+	if err != nil {
 		return err
 	}
+	closeStream, err := rn.openStream(d.config.MaxStreamsPerNode)
+	if err != nil {
+		return err
+	}
+	defer closeStream()
 
 	var (
 		sequence  int64
@@ -827,7 +1570,10 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 		appliesTo = msg.ResultsIn
 		msg.Type = assignmentType
 
-		if err := stream.Send(&msg); err != nil {
+		if err := sendWithTimeout(d.config.TaskSendTimeout, func() error { return stream.Send(&msg) }); err != nil {
+			if grpc.Code(err) == codes.DeadlineExceeded {
+				log.Warn("closing assignments stream, the node is not receiving its assignments")
+			}
 			return err
 		}
 		return nil
@@ -871,6 +1617,10 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 	nodeTasks, cancel, err := store.ViewAndWatch(
 		d.store,
 		func(readTx store.ReadTx) error {
+			// don't serve a node that was removed from the cluster
+			if store.GetNode(readTx, nodeID) == nil {
+				return ErrNodeNotFound
+			}
 			tasks, err := store.FindTasks(readTx, store.ByNodeID(nodeID))
 			if err != nil {
 				return err
@@ -894,6 +1644,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 						},
 					},
 					Action: api.AssignmentChange_AssignmentActionUpdate,
+					Reason: taskChangeReason(readTx, t, false),
 				}
 				initial.Changes = append(initial.Changes, taskChange)
 				// Only send secrets down if these tasks are in < RUNNING
@@ -923,7 +1674,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 		api.EventDeleteSecret{},
 	)
 	if err != nil {
-		return err
+		return tasksReadError(err)
 	}
 	defer cancel()
 
@@ -945,7 +1696,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 			batchingTimeout <-chan time.Time
 			updateTasks     = make(map[string]*api.Task)
 			updateSecrets   = make(map[string]*api.Secret)
-			removeTasks     = make(map[string]struct{})
+			removeTasks     = make(map[string]*api.Task)
 			removeSecrets   = make(map[string]struct{})
 		)
 
@@ -1043,7 +1794,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 						continue
 					}
 
-					removeTasks[v.Task.ID] = struct{}{}
+					removeTasks[v.Task.ID] = v.Task
 
 					delete(tasksMap, v.Task.ID)
 
@@ -1076,6 +1827,8 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 				return stream.Context().Err()
 			case <-dctx.Done():
 				return dctx.Err()
+			case <-rn.Invalidated:
+				return grpc.Errorf(codes.InvalidArgument, "%s", ErrSessionInvalid)
 			}
 		}
 
@@ -1084,6 +1837,16 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 		}
 
 		if modificationCnt > 0 {
+			reasons := make(map[string]string, len(updateTasks)+len(removeTasks))
+			d.store.View(func(readTx store.ReadTx) {
+				for id, task := range updateTasks {
+					reasons[id] = taskChangeReason(readTx, task, false)
+				}
+				for id, task := range removeTasks {
+					reasons[id] = taskChangeReason(readTx, task, true)
+				}
+			})
+
 			for id, task := range updateTasks {
 				if _, ok := removeTasks[id]; !ok {
 					taskChange := &api.AssignmentChange{
@@ -1093,6 +1856,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 							},
 						},
 						Action: api.AssignmentChange_AssignmentActionUpdate,
+						Reason: reasons[id],
 					}
 
 					update.Changes = append(update.Changes, taskChange)
@@ -1126,6 +1890,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 						},
 					},
 					Action: api.AssignmentChange_AssignmentActionRemove,
+					Reason: reasons[id],
 				}
 
 				update.Changes = append(update.Changes, taskChange)
@@ -1156,6 +1921,36 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 	}
 }
 
+// taskChangeReason explains why a task assignment is sent to an agent, or
+// removed from it if removed is set, based on the state of the task, its node
+// and its service.
+func taskChangeReason(readTx store.ReadTx, t *api.Task, removed bool) string {
+	var service *api.Service
+	if t.ServiceID != "" {
+		service = store.GetService(readTx, t.ServiceID)
+		if service == nil {
+			return "service removed"
+		}
+	}
+	if removed {
+		return "task removed"
+	}
+
+	if t.DesiredState > api.TaskStateRunning {
+		if node := store.GetNode(readTx, t.NodeID); node != nil && node.Spec.Availability == api.NodeAvailabilityDrain {
+			return "node drained"
+		}
+		if service != nil && service.SpecVersion != nil && t.SpecVersion != nil && t.SpecVersion.Index < service.SpecVersion.Index {
+			return "service updated"
+		}
+		return fmt.Sprintf("desired state changed to %s", strings.ToLower(t.DesiredState.String()))
+	}
+	if t.Status.State == api.TaskStateAssigned {
+		return "scheduled"
+	}
+	return t.Status.Message
+}
+
 func (d *Dispatcher) moveTasksToOrphaned(nodeID string) error {
 	_, err := d.store.Batch(func(batch *store.Batch) error {
 		var (
@@ -1223,9 +2018,17 @@ func (d *Dispatcher) markNodeNotReady(id string, state api.NodeStatus_State, mes
 		return err
 	}
 
+	log := log.G(dctx).WithFields(logrus.Fields{
+		"node.id":     id,
+		"node.state":  state,
+		"node.reason": message,
+		"method":      "(*Dispatcher).markNodeNotReady",
+	})
+	log.Debug("node down")
+
 	expireFunc := func() {
 		if err := d.moveTasksToOrphaned(id); err != nil {
-			log.G(dctx).WithError(err).Error(`failed to move all tasks to "ORPHANED" state`)
+			log.WithError(err).Error(`failed to move all tasks to "ORPHANED" state`)
 		}
 
 		d.downNodes.Delete(id)
@@ -1239,10 +2042,11 @@ func (d *Dispatcher) markNodeNotReady(id string, state api.NodeStatus_State, mes
 	}
 
 	d.nodeUpdatesLock.Lock()
-	// pluck the description out of nodeUpdates. this protects against a case
-	// where a node is marked ready and a description is added, but then the
-	// node is immediately marked not ready. this preserves that description
-	d.nodeUpdates[id] = nodeUpdate{status: status, description: d.nodeUpdates[id].description}
+	// pluck the description and metadata out of nodeUpdates. this protects
+	// against a case where a node is marked ready and a description is added,
+	// but then the node is immediately marked not ready. this preserves that
+	// description
+	d.nodeUpdates[id] = nodeUpdate{status: status, description: d.nodeUpdates[id].description, metadata: d.nodeUpdates[id].metadata}
 	numUpdates := len(d.nodeUpdates)
 	d.nodeUpdatesLock.Unlock()
 
@@ -1268,9 +2072,24 @@ func (d *Dispatcher) Heartbeat(ctx context.Context, r *api.HeartbeatRequest) (*a
 	if err != nil {
 		return nil, err
 	}
+	if err := checkNodeID(nodeInfo, r.NodeID); err != nil {
+		return nil, err
+	}
 
 	period, err := d.nodes.Heartbeat(nodeInfo.NodeID, r.SessionID)
-	return &api.HeartbeatResponse{Period: period}, err
+	if err != nil {
+		nodeLogger(ctx, nodeInfo, r.SessionID, "(*Dispatcher).Heartbeat").WithError(err).Debug("heartbeat rejected")
+		if grpc.Code(err) == codes.NotFound {
+			// a node which was marked down can still recover its tasks
+			// by registering again, so tell it how long it has left
+			if remaining, ok := d.downNodes.RemainingGrace(nodeInfo.NodeID); ok {
+				return nil, grpc.Errorf(codes.Aborted, "%s, re-register within %s", ErrNodeExpired.Error(), remaining)
+			}
+		}
+		return nil, err
+	}
+
+	return &api.HeartbeatResponse{Period: period, Reconnect: d.isShuttingDown()}, nil
 }
 
 func (d *Dispatcher) getManagers() []*api.WeightedPeer {
@@ -1295,6 +2114,9 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 	if err != nil {
 		return err
 	}
+	if err := checkNodeID(nodeInfo, r.NodeID); err != nil {
+		return err
+	}
 	nodeID := nodeInfo.NodeID
 
 	dctx, err := d.isRunningLocked()
@@ -1302,35 +2124,51 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 		return err
 	}
 
+	hbRange, err := sessionHeartbeatRange(r)
+	if err != nil {
+		return err
+	}
+	if err := d.checkAgentVersion(r); err != nil {
+		return err
+	}
+
 	var sessionID string
-	if _, err := d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
+	if rn, err := d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
+		// stagger new sessions if too many are being opened at once
+		if delay := d.admission.Delay(nodeID, time.Now()); delay > 0 {
+			nodeLogger(ctx, nodeInfo, r.SessionID, "(*Dispatcher).Session").Debugf("delaying new session by %v", delay)
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-dctx.Done():
+				timer.Stop()
+				return dctx.Err()
+			}
+		}
+
 		// register the node.
-		sessionID, err = d.register(ctx, nodeID, r.Description)
+		sessionID, err = d.register(ctx, nodeID, r.Description, r.Metadata, r.AdvertiseAddr, hbRange)
 		if err != nil {
 			return err
 		}
 	} else {
 		sessionID = r.SessionID
 		// get the node IP addr
-		addr, err := nodeIPFromContext(stream.Context())
+		addr, err := nodeAddr(stream.Context(), r.AdvertiseAddr)
 		if err != nil {
-			log.G(ctx).Debugf(err.Error())
+			return err
 		}
 		// update the node description
-		if err := d.markNodeReady(dctx, nodeID, r.Description, addr); err != nil {
+		if err := d.markNodeReady(dctx, nodeID, r.Description, r.Metadata, addr, sessionID); err != nil {
 			return err
 		}
+		d.nodes.SetHeartbeatRange(rn, hbRange)
 	}
 
-	fields := logrus.Fields{
-		"node.id":      nodeID,
-		"node.session": sessionID,
-		"method":       "(*Dispatcher).Session",
-	}
-	if nodeInfo.ForwardedBy != nil {
-		fields["forwarder.id"] = nodeInfo.ForwardedBy.NodeID
-	}
-	log := log.G(ctx).WithFields(fields)
+	log := nodeLogger(ctx, nodeInfo, sessionID, "(*Dispatcher).Session")
 
 	var nodeObj *api.Node
 	nodeUpdates, cancel, err := store.ViewAndWatch(d.store, func(readTx store.ReadTx) error {
@@ -1347,9 +2185,15 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 		log.WithError(err).Error("ViewAndWatch Node failed")
 	}
 
-	if _, err = d.nodes.GetWithSession(nodeID, sessionID); err != nil {
+	rn, err := d.nodes.GetWithSession(nodeID, sessionID)
+	if err != nil {
 		return err
 	}
+	closeStream, err := rn.openStream(d.config.MaxStreamsPerNode)
+	if err != nil {
+		return err
+	}
+	defer closeStream()
 
 	if err := stream.Send(&api.SessionMessage{
 		SessionID:            sessionID,
@@ -1360,13 +2204,42 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 		return err
 	}
 
+	// ask the agent to renew the node's certificate once it is about to
+	// expire, once for each certificate the node gets
+	var (
+		certNotAfter time.Time
+		renewalTimer *time.Timer
+		renewalDue   <-chan time.Time
+	)
+	scheduleCertRenewal := func() {
+		if d.config.CertRenewalThreshold <= 0 || nodeObj == nil {
+			return
+		}
+		notAfter, err := ca.NodeCertNotAfter(nodeObj)
+		if err != nil || notAfter.Equal(certNotAfter) {
+			return
+		}
+		certNotAfter = notAfter
+		if renewalTimer != nil {
+			renewalTimer.Stop()
+		}
+		renewalTimer = time.NewTimer(notAfter.Add(-d.config.CertRenewalThreshold).Sub(time.Now()))
+		renewalDue = renewalTimer.C
+	}
+	defer func() {
+		if renewalTimer != nil {
+			renewalTimer.Stop()
+		}
+	}()
+	scheduleCertRenewal()
+
 	managerUpdates, mgrCancel := d.mgrQueue.Watch()
 	defer mgrCancel()
 	keyMgrUpdates, keyMgrCancel := d.keyMgrQueue.Watch()
 	defer keyMgrCancel()
 
 	// disconnectNode is a helper forcibly shutdown connection
-	disconnectNode := func() error {
+	disconnectNode := func(reason api.SessionMessage_DisconnectReason) error {
 		// force disconnect by shutting down the stream.
 		transportStream, ok := transport.StreamFromContext(stream.Context())
 		if ok {
@@ -1377,8 +2250,11 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 			}
 		}
 
-		if err := d.markNodeNotReady(nodeID, api.NodeStatus_DISCONNECTED, "node is currently trying to find new manager"); err != nil {
-			log.WithError(err).Error("failed to remove node")
+		// a removed node has no status left to update
+		if reason != api.SessionMessage_DisconnectReasonNodeRemoved {
+			if err := d.markNodeNotReady(nodeID, api.NodeStatus_DISCONNECTED, "node is currently trying to find new manager"); err != nil {
+				log.WithError(err).Error("failed to remove node")
+			}
 		}
 		// still return an abort if the transport closure was ineffective.
 		return grpc.Errorf(codes.Aborted, "node must disconnect")
@@ -1394,24 +2270,46 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 		}
 
 		var (
-			disconnect bool
+			disconnect api.SessionMessage_DisconnectReason
 			mgrs       []*api.WeightedPeer
 			netKeys    []*api.EncryptionKey
+			renewCert  bool
+			keepalive  *time.Timer
+			timeout    <-chan time.Time
 		)
+		if d.config.SessionKeepalivePeriod > 0 {
+			keepalive = time.NewTimer(d.config.SessionKeepalivePeriod)
+			timeout = keepalive.C
+		}
 
 		select {
 		case ev := <-managerUpdates:
 			mgrs = ev.([]*api.WeightedPeer)
 		case ev := <-nodeUpdates:
 			nodeObj = ev.(api.EventUpdateNode).Node
+			scheduleCertRenewal()
+		case <-renewalDue:
+			renewCert = true
+			renewalDue = nil
 		case <-stream.Context().Done():
 			return stream.Context().Err()
 		case <-node.Disconnect:
-			disconnect = true
+			disconnect = node.DisconnectReason
 		case <-dctx.Done():
-			disconnect = true
+			// The dispatcher only stops without being told to prepare for
+			// it when this manager is no longer the leader.
+			disconnect = api.SessionMessage_DisconnectReasonLeadershipLost
+			if d.isShuttingDown() {
+				disconnect = api.SessionMessage_DisconnectReasonShutdown
+			}
 		case ev := <-keyMgrUpdates:
 			netKeys = ev.([]*api.EncryptionKey)
+		case <-timeout:
+			// nothing changed, resend the current state so the agent
+			// knows this manager is still there
+		}
+		if keepalive != nil {
+			keepalive.Stop()
 		}
 		if mgrs == nil {
 			mgrs = d.getManagers()
@@ -1425,11 +2323,14 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 			Node:                 nodeObj,
 			Managers:             mgrs,
 			NetworkBootstrapKeys: netKeys,
+			DisconnectReason:     disconnect,
+			RenewCertificate:     renewCert,
 		}); err != nil {
 			return err
 		}
-		if disconnect {
-			return disconnectNode()
+		if disconnect != api.SessionMessage_DisconnectReasonNone {
+			log.WithField("reason", disconnect).Debug("disconnecting node")
+			return disconnectNode(disconnect)
 		}
 	}
 }
@@ -3,7 +3,11 @@ package dispatcher
 import (
 	"crypto/tls"
 	"fmt"
+	"math/rand"
 	"net"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,11 +16,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/docker/go-events"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/ca"
 	"github.com/docker/swarmkit/ca/testutils"
+	"github.com/docker/swarmkit/manager/state"
 	raftutils "github.com/docker/swarmkit/manager/state/raft/testutils"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +37,7 @@ type grpcDispatcher struct {
 	dispatcherServer *Dispatcher
 	conns            []*grpc.ClientConn
 	testCA           *testutils.TestCA
+	testCluster      *testCluster
 }
 
 func (gd *grpcDispatcher) Close() {
@@ -45,6 +53,9 @@ func (gd *grpcDispatcher) Close() {
 type testCluster struct {
 	addr  string
 	store *store.MemoryStore
+
+	mu    sync.Mutex
+	peers chan events.Event
 }
 
 func (t *testCluster) GetMemberlist() map[uint64]*api.RaftMember {
@@ -64,11 +75,29 @@ func (t *testCluster) SubscribePeers() (chan events.Event, func()) {
 			NodeID: "1",
 		},
 	}
+	t.mu.Lock()
+	t.peers = ch
+	t.mu.Unlock()
 	return ch, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.peers == ch {
+			t.peers = nil
+		}
 		close(ch)
 	}
 }
 
+// publishPeers sends a new list of peers to the dispatcher, like raft does
+// when the cluster membership or the address of a member changes.
+func (t *testCluster) publishPeers(peers []*api.Peer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.peers != nil {
+		t.peers <- peers
+	}
+}
+
 func (t *testCluster) MemoryStore() *store.MemoryStore {
 	return t.store
 }
@@ -154,9 +183,198 @@ func startDispatcher(c *Config) (*grpcDispatcher, error) {
 		conns:            conns,
 		grpcServer:       s,
 		testCA:           tca,
+		testCluster:      tc,
 	}, nil
 }
 
+func TestRegisterNodeAddr(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RateLimitPeriod = 0
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	storedAddr := func() string {
+		var addr string
+		gd.Store.View(func(readTx store.ReadTx) {
+			addr = store.GetNode(readTx, nodeID).Status.Addr
+		})
+		return addr
+	}
+
+	// by default, the address the node connects from is recorded
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", storedAddr())
+	stream.CloseSend()
+
+	// an advertised address takes precedence, and is updated on re-registration
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{AdvertiseAddr: "10.0.0.5"})
+	assert.NoError(t, err)
+	msg, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.5", storedAddr())
+	stream.CloseSend()
+
+	// including when resuming an existing session
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{SessionID: msg.SessionID, AdvertiseAddr: "10.0.0.6"})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.6", storedAddr())
+	stream.CloseSend()
+
+	// an advertised address that isn't an IP address is rejected
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{AdvertiseAddr: "not-an-ip"})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Equal(t, "10.0.0.6", storedAddr())
+}
+
+func TestSessionSurvivesLeadershipChange(t *testing.T) {
+	for _, persist := range []bool{false, true} {
+		cfg := DefaultConfig()
+		cfg.PersistSessions = persist
+		gd, err := startDispatcher(cfg)
+		assert.NoError(t, err)
+		defer gd.Close()
+
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		msg, err := stream.Recv()
+		assert.NoError(t, err)
+		sessionID, nodeID := msg.SessionID, msg.Node.ID
+
+		var node *api.Node
+		gd.Store.View(func(readTx store.ReadTx) {
+			node = store.GetNode(readTx, nodeID)
+		})
+		if persist {
+			assert.Equal(t, sessionID, node.SessionID)
+		} else {
+			assert.Empty(t, node.SessionID)
+		}
+
+		// leadership moves away and back
+		d := gd.dispatcherServer
+		assert.NoError(t, d.Stop())
+		go d.Run(context.Background())
+		assert.NoError(t, raftutils.PollFuncWithTimeout(nil, func() error {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			if !d.isRunning() {
+				return fmt.Errorf("dispatcher is not running")
+			}
+			return nil
+		}, 5*time.Second))
+
+		_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: sessionID})
+		if !persist {
+			// the node has to register again
+			assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+			gd.Store.View(func(readTx store.ReadTx) {
+				node = store.GetNode(readTx, nodeID)
+			})
+			assert.Equal(t, api.NodeStatus_UNKNOWN, node.Status.State)
+			continue
+		}
+
+		// the session was adopted
+		assert.NoError(t, err)
+		stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{SessionID: sessionID})
+		assert.NoError(t, err)
+		msg, err = stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, sessionID, msg.SessionID)
+		assert.Equal(t, api.NodeStatus_READY, msg.Node.Status.State)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	// nodes from the store are expected to register again, so they count
+	// towards capacity right away
+	var nodes []*api.Node
+	gd.Store.View(func(readTx store.ReadTx) {
+		nodes, err = store.FindNodes(readTx, store.All)
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, nodes)
+
+	status := gd.dispatcherServer.Health()
+	assert.True(t, status.Healthy())
+	assert.True(t, status.Running)
+	assert.True(t, status.StoreReady)
+	assert.False(t, status.AtCapacity)
+	assert.Equal(t, len(nodes), status.Nodes)
+
+	gd.dispatcherServer.config.MaxNodes = len(nodes) + 1
+	assert.True(t, gd.dispatcherServer.Health().Healthy())
+	gd.dispatcherServer.config.MaxNodes = len(nodes)
+	status = gd.dispatcherServer.Health()
+	assert.False(t, status.Healthy())
+	assert.True(t, status.AtCapacity)
+
+	// the dispatcher is stopped when leadership is lost
+	gd.dispatcherServer.config.MaxNodes = 0
+	gd.dispatcherServer.Stop()
+	status = gd.dispatcherServer.Health()
+	assert.False(t, status.Healthy())
+	assert.False(t, status.Running)
+}
+
+func TestCapacity(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+	d := gd.dispatcherServer
+
+	// without MaxNodes, the capacity is unlimited
+	status := d.Capacity()
+	nodes := status.Nodes
+	assert.NotZero(t, nodes)
+	assert.Equal(t, CapacityStatus{Nodes: nodes, Headroom: -1}, status)
+
+	d.config.MaxNodes = nodes + 2
+	status = d.Capacity()
+	assert.Equal(t, nodes+2, status.MaxNodes)
+	assert.Equal(t, 2, status.Headroom)
+	assert.InDelta(t, float64(nodes)/float64(nodes+2), status.Utilization, 0.001)
+
+	// the headroom shrinks as nodes register, and stays at zero past
+	// MaxNodes
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, d.nodes.AddUnknown(&api.Node{ID: fmt.Sprintf("extra%d", i)}, func() {}))
+		status = d.Capacity()
+		assert.Equal(t, nodes+i, status.Nodes)
+		if i <= 2 {
+			assert.Equal(t, 2-i, status.Headroom)
+		} else {
+			assert.Equal(t, 0, status.Headroom)
+			assert.True(t, status.Utilization > 1)
+		}
+	}
+
+	// and grows again as they go away
+	d.nodes.Delete("extra1")
+	d.nodes.Delete("extra2")
+	d.nodes.Delete("extra3")
+	assert.Equal(t, 2, d.Capacity().Headroom)
+
+	// a stopped dispatcher serves no nodes
+	d.Stop()
+	status = d.Capacity()
+	assert.Equal(t, 0, status.Nodes)
+	assert.Equal(t, nodes+2, status.Headroom)
+}
+
 func TestRegisterTwice(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.RateLimitPeriod = 0
@@ -186,6 +404,143 @@ func TestRegisterTwice(t *testing.T) {
 	}
 }
 
+func TestRegisterSessionIDs(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RateLimitPeriod = 0
+	var (
+		mu       sync.Mutex
+		sessions int
+	)
+	cfg.NewSessionID = func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		sessions++
+		return fmt.Sprintf("session-%d", sessions)
+	}
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	register := func() string {
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		msg, err := stream.Recv()
+		assert.NoError(t, err)
+		return msg.SessionID
+	}
+
+	assert.Equal(t, "session-1", register())
+	assert.Equal(t, "session-2", register())
+
+	// registering again invalidated the first session
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: "session-1"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Equal(t, ErrSessionInvalid.Error(), grpc.ErrorDesc(err))
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: "session-2"})
+	assert.NoError(t, err)
+}
+
+func TestUpdateTaskStatusPreviousSession(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RateLimitPeriod = 0
+	cfg.PreviousSessionGrace = time.Second
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	register := func() *api.SessionMessage {
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		msg, err := stream.Recv()
+		assert.NoError(t, err)
+		return msg
+	}
+
+	msg := register()
+	oldSessionID := msg.SessionID
+	task := &api.Task{
+		ID:     "testTask",
+		NodeID: msg.Node.ID,
+		Status: api.TaskStatus{State: api.TaskStateAssigned},
+	}
+	assert.NoError(t, gd.Store.Update(func(tx store.Tx) error {
+		return store.CreateTask(tx, task)
+	}))
+
+	// the node registers again while a status update for its old session
+	// is in flight
+	newSessionID := register().SessionID
+	assert.NotEqual(t, oldSessionID, newSessionID)
+
+	updateStatus := func(sessionID string, state api.TaskState) error {
+		_, err := gd.Clients[0].UpdateTaskStatus(context.Background(), &api.UpdateTaskStatusRequest{
+			SessionID: sessionID,
+			Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
+				{TaskID: task.ID, Status: &api.TaskStatus{State: state}},
+			},
+		})
+		return err
+	}
+	assert.NoError(t, updateStatus(oldSessionID, api.TaskStateRunning))
+	assert.NoError(t, raftutils.PollFuncWithTimeout(nil, func() error {
+		var state api.TaskState
+		gd.Store.View(func(readTx store.ReadTx) {
+			state = store.GetTask(readTx, task.ID).Status.State
+		})
+		if state != api.TaskStateRunning {
+			return fmt.Errorf("task is in state %s", state)
+		}
+		return nil
+	}, 5*time.Second))
+
+	// the old session can't be used to open streams
+	tasks, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: oldSessionID})
+	assert.NoError(t, err)
+	_, err = tasks.Recv()
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// and once the grace has elapsed, it isn't accepted for status updates
+	// either
+	time.Sleep(cfg.PreviousSessionGrace)
+	err = updateStatus(oldSessionID, api.TaskStateCompleted)
+	assert.Error(t, err)
+	assert.Equal(t, ErrSessionInvalid.Error(), grpc.ErrorDesc(err))
+	assert.NoError(t, updateStatus(newSessionID, api.TaskStateCompleted))
+}
+
+func TestRegisterMinAgentVersion(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RateLimitPeriod = 0
+	cfg.MinAgentVersion = "1.2.0"
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	register := func(version string) error {
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{AgentVersion: version})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		_, err = stream.Recv()
+		return err
+	}
+
+	// agents older than the minimum, or of an unknown version, must be
+	// upgraded
+	for _, version := range []string{"1.1.9", ""} {
+		err := register(version)
+		assert.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+		assert.Contains(t, grpc.ErrorDesc(err), "upgrade the agent")
+	}
+
+	assert.NoError(t, register("1.2.0"))
+	assert.NoError(t, register("1.10.1"))
+}
+
 func TestRegisterExceedRateLimit(t *testing.T) {
 	t.Parallel()
 
@@ -274,6 +629,77 @@ func TestHeartbeat(t *testing.T) {
 	})
 }
 
+func TestHeartbeatPeriodRange(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.HeartbeatPeriod = time.Second
+	cfg.HeartbeatEpsilon = 0
+	cfg.MaxHeartbeatPeriod = 30 * time.Second
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	heartbeatPeriod := func(client api.DispatcherClient, min, max time.Duration) time.Duration {
+		stream, err := client.Session(context.Background(), &api.SessionRequest{
+			MinHeartbeatPeriod: min,
+			MaxHeartbeatPeriod: max,
+		})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+
+		msg, err := stream.Recv()
+		assert.NoError(t, err)
+
+		resp, err := client.Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: msg.SessionID})
+		assert.NoError(t, err)
+		return resp.Period
+	}
+
+	// the agent supporting long periods gets the longest one the dispatcher allows
+	wide := heartbeatPeriod(gd.Clients[0], time.Second, time.Minute)
+	assert.Equal(t, 30*time.Second, wide)
+
+	// the agent supporting only short periods gets one within its range
+	narrow := heartbeatPeriod(gd.Clients[1], time.Second, 5*time.Second)
+	assert.True(t, narrow >= time.Second && narrow <= 5*time.Second, "period %v out of range", narrow)
+	assert.True(t, wide > narrow)
+
+	// an invalid range is rejected
+	stream, err := gd.Clients[1].Session(context.Background(), &api.SessionRequest{
+		MinHeartbeatPeriod: 10 * time.Second,
+		MaxHeartbeatPeriod: 5 * time.Second,
+	})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+}
+
+func TestHeartbeatReconnectOnShutdown(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+
+	msg, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, msg.SessionID)
+
+	resp, err := gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: msg.SessionID})
+	assert.NoError(t, err)
+	assert.False(t, resp.Reconnect)
+
+	gd.dispatcherServer.PrepareStop()
+
+	resp, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: msg.SessionID})
+	assert.NoError(t, err)
+	assert.NotZero(t, resp.Period)
+	assert.True(t, resp.Reconnect)
+}
+
 func TestHeartbeatNoCert(t *testing.T) {
 	gd, err := startDispatcher(DefaultConfig())
 	assert.NoError(t, err)
@@ -314,68 +740,285 @@ func TestHeartbeatTimeout(t *testing.T) {
 		assert.Equal(t, api.NodeStatus_DOWN, storeNodes[0].Status.State)
 	})
 
-	// check that node is deregistered
+	// check that node is deregistered, and told to register again
 	resp, err := gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: expectedSessionID})
 	assert.Nil(t, resp)
 	assert.Error(t, err)
-	assert.Equal(t, grpc.ErrorDesc(err), ErrNodeNotRegistered.Error())
-}
-
-func TestHeartbeatUnregistered(t *testing.T) {
-	gd, err := startDispatcher(DefaultConfig())
-	assert.NoError(t, err)
-	defer gd.Close()
-	resp, err := gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{})
-	assert.Nil(t, resp)
-	assert.Error(t, err)
-	assert.Equal(t, ErrSessionInvalid.Error(), grpc.ErrorDesc(err))
+	assert.Equal(t, codes.Aborted, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), ErrNodeExpired.Error())
 }
 
-// If the session ID is not sent as part of the Assignments request, an error is returned to the stream
-func TestAssignmentsErrorsIfNoSessionID(t *testing.T) {
+func TestHeartbeatGraceMatchesAdvertisedPeriod(t *testing.T) {
 	t.Parallel()
 
-	gd, err := startDispatcher(DefaultConfig())
+	cfg := DefaultConfig()
+	cfg.HeartbeatPeriod = 200 * time.Millisecond
+	cfg.HeartbeatEpsilon = 100 * time.Millisecond
+	cfg.GracePeriodMultiplier = 3
+	gd, err := startDispatcher(cfg)
 	assert.NoError(t, err)
 	defer gd.Close()
 
-	// without correct SessionID should fail
-	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{})
+	// the first heartbeat advertises the period the registration grace was
+	// sized from
+	{
+		stream, err := gd.Clients[1].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+
+		rn, err := gd.dispatcherServer.nodes.Get(gd.SecurityConfigs[1].ClientTLSCreds.NodeID())
+		assert.NoError(t, err)
+		registeredPeriod := rn.Period
+
+		hbResp, err := gd.Clients[1].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: resp.SessionID})
+		assert.NoError(t, err)
+		assert.Equal(t, registeredPeriod, hbResp.Period)
+	}
+
+	// a node which registers but never heartbeats is marked down after the
+	// grace derived from its advertised period
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
 	assert.NoError(t, err)
-	assert.NotNil(t, stream)
 	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	registered := time.Now()
 
-	resp, err := stream.Recv()
-	assert.Nil(t, resp)
-	assert.Error(t, err)
-	assert.Equal(t, grpc.Code(err), codes.InvalidArgument)
+	rn, err := gd.dispatcherServer.nodes.Get(nodeID)
+	assert.NoError(t, err)
+	grace := rn.Period * time.Duration(cfg.GracePeriodMultiplier)
+	assert.True(t, rn.Period >= cfg.HeartbeatPeriod-cfg.HeartbeatEpsilon)
+	assert.True(t, rn.Period <= cfg.HeartbeatPeriod+cfg.HeartbeatEpsilon)
+
+	nodeDown := func() bool {
+		var down bool
+		gd.Store.View(func(readTx store.ReadTx) {
+			node := store.GetNode(readTx, nodeID)
+			down = node != nil && node.Status.State == api.NodeStatus_DOWN
+		})
+		return down
+	}
+	for !nodeDown() {
+		if time.Since(registered) > 2*grace {
+			t.Fatalf("node was not marked down within twice its grace of %s", grace)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// allow for the time between registration and receiving the first
+	// session message
+	assert.True(t, time.Since(registered) >= grace-50*time.Millisecond,
+		"node was marked down after %s, before its grace of %s", time.Since(registered), grace)
 }
 
-// Assignments will send down any existing node tasks > ASSIGNED, and any secrets
-// for said tasks that are <= RUNNING (if the secrets exist)
-func TestAssignmentsInitialNodeTasks(t *testing.T) {
+func TestHeartbeatAfterExpiry(t *testing.T) {
 	t.Parallel()
 
-	gd, err := startDispatcher(DefaultConfig())
+	cfg := DefaultConfig()
+	cfg.HeartbeatPeriod = 100 * time.Millisecond
+	cfg.HeartbeatEpsilon = 0
+	gd, err := startDispatcher(cfg)
 	assert.NoError(t, err)
 	defer gd.Close()
 
-	expectedSessionID, nodeID := getSessionAndNodeID(t, gd.Clients[0])
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	msg, err := stream.Recv()
+	assert.NoError(t, err)
+	expiredSessionID := msg.SessionID
 
-	// create the relevant secrets and tasks
-	secrets, tasks := makeTasksAndSecrets(t, nodeID)
-	err = gd.Store.Update(func(tx store.Tx) error {
-		for _, secret := range secrets[:] {
-			assert.NoError(t, store.CreateSecret(tx, secret))
-		}
-		for _, task := range tasks {
-			assert.NoError(t, store.CreateTask(tx, task))
+	// let the node expire without heartbeating
+	for {
+		if _, err := gd.dispatcherServer.downNodes.Get(nodeID); err == nil {
+			break
 		}
-		return nil
-	})
-	assert.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+	}
 
-	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{SessionID: expectedSessionID})
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: expiredSessionID})
+	assert.Error(t, err)
+	assert.Equal(t, codes.Aborted, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), ErrNodeExpired.Error())
+
+	// registering again, even with the expired session, starts a new one
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{SessionID: expiredSessionID})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	msg, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.NotEqual(t, expiredSessionID, msg.SessionID)
+
+	resp, err := gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: msg.SessionID})
+	assert.NoError(t, err)
+	assert.NotZero(t, resp.Period)
+	_, err = gd.dispatcherServer.downNodes.Get(nodeID)
+	assert.Error(t, err)
+}
+
+func TestSessionQuarantine(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.HeartbeatPeriod = 100 * time.Millisecond
+	cfg.HeartbeatEpsilon = 0
+	cfg.QuarantineThreshold = 2
+	cfg.QuarantineBackoff = time.Minute
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+
+	// register and let the node expire without heartbeating, twice
+	for i := 0; i < cfg.QuarantineThreshold; i++ {
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.NoError(t, err)
+		stream.CloseSend()
+
+		for {
+			if _, err := gd.dispatcherServer.downNodes.Get(nodeID); err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), "quarantined")
+	assert.NotZero(t, gd.dispatcherServer.quarantine.Remaining(nodeID, time.Now()))
+}
+
+func TestOnNodeDown(t *testing.T) {
+	t.Parallel()
+
+	downNodes := make(chan string, 1)
+	cfg := DefaultConfig()
+	cfg.HeartbeatPeriod = 100 * time.Millisecond
+	cfg.HeartbeatEpsilon = 0
+	cfg.OnNodeDown = func(nodeID string) {
+		downNodes <- nodeID
+	}
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	// the node never heartbeats, so it times out and the callback fires
+	// once the store has been updated
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	select {
+	case downNodeID := <-downNodes:
+		assert.Equal(t, nodeID, downNodeID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnNodeDown was not called")
+	}
+	gd.Store.View(func(readTx store.ReadTx) {
+		assert.Equal(t, api.NodeStatus_DOWN, store.GetNode(readTx, nodeID).Status.State)
+	})
+}
+
+func TestZeroGracePeriodMultiplier(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	cfg.HeartbeatPeriod = 100 * time.Millisecond
+	cfg.HeartbeatEpsilon = 0
+	cfg.GracePeriodMultiplier = 0
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+	assert.Equal(t, defaultGracePeriodMultiplier, gd.dispatcherServer.config.GracePeriodMultiplier)
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+
+	// with a zero grace the node would already be down, but it should get
+	// the default of three heartbeat periods
+	time.Sleep(100 * time.Millisecond)
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: resp.SessionID})
+	assert.NoError(t, err)
+
+	gd.Store.View(func(readTx store.ReadTx) {
+		node := store.GetNode(readTx, gd.SecurityConfigs[0].ClientTLSCreds.NodeID())
+		assert.NotNil(t, node)
+		assert.Equal(t, api.NodeStatus_READY, node.Status.State)
+	})
+}
+
+func TestHeartbeatUnregistered(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+	resp, err := gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{})
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+	assert.Equal(t, ErrSessionInvalid.Error(), grpc.ErrorDesc(err))
+}
+
+// If the session ID is not sent as part of the Assignments request, an error is returned to the stream
+func TestAssignmentsErrorsIfNoSessionID(t *testing.T) {
+	t.Parallel()
+
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	// without correct SessionID should fail
+	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{})
+	assert.NoError(t, err)
+	assert.NotNil(t, stream)
+	defer stream.CloseSend()
+
+	resp, err := stream.Recv()
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+	assert.Equal(t, grpc.Code(err), codes.InvalidArgument)
+}
+
+// Assignments will send down any existing node tasks > ASSIGNED, and any secrets
+// for said tasks that are <= RUNNING (if the secrets exist)
+func TestAssignmentsInitialNodeTasks(t *testing.T) {
+	t.Parallel()
+
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	expectedSessionID, nodeID := getSessionAndNodeID(t, gd.Clients[0])
+
+	// create the relevant secrets and tasks
+	secrets, tasks := makeTasksAndSecrets(t, nodeID)
+	err = gd.Store.Update(func(tx store.Tx) error {
+		for _, secret := range secrets[:] {
+			assert.NoError(t, store.CreateSecret(tx, secret))
+		}
+		for _, task := range tasks {
+			assert.NoError(t, store.CreateTask(tx, task))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{SessionID: expectedSessionID})
 	assert.NoError(t, err)
 	defer stream.CloseSend()
 
@@ -785,6 +1428,194 @@ func TestTasksBatch(t *testing.T) {
 	assert.Equal(t, api.AssignmentChange_AssignmentActionRemove, resp.Changes[1].Action)
 }
 
+// entriesHook collects the log entries that are emitted
+type entriesHook struct {
+	mu      sync.Mutex
+	entries []*logrus.Entry
+}
+
+func (h *entriesHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *entriesHook) Fire(entry *logrus.Entry) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = append(h.entries, entry)
+	return nil
+}
+
+func (h *entriesHook) find(message string) *logrus.Entry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, entry := range h.entries {
+		if entry.Message == message {
+			return entry
+		}
+	}
+	return nil
+}
+
+func TestNodeLogFields(t *testing.T) {
+	logger := logrus.StandardLogger()
+	hook := &entriesHook{}
+	defer func(level logrus.Level, hooks logrus.LevelHooks) {
+		logger.Level = level
+		logger.Hooks = hooks
+	}(logger.Level, logger.Hooks)
+	logger.Level = logrus.DebugLevel
+	logger.Hooks = make(logrus.LevelHooks)
+	logger.Hooks.Add(hook)
+
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	ctx := metadata.NewContext(context.Background(), metadata.Pairs(requestIDKey, "request-1"))
+	stream, err := gd.Clients[0].Session(ctx, &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	nodeID := resp.Node.ID
+	sessionID := resp.SessionID
+
+	entry := hook.find("node registered")
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, nodeID, entry.Data["node.id"])
+		assert.Equal(t, sessionID, entry.Data["node.session"])
+	}
+
+	assignments, err := gd.Clients[0].Assignments(ctx, &api.AssignmentsRequest{SessionID: sessionID})
+	assert.NoError(t, err)
+	_, err = assignments.Recv()
+	assert.NoError(t, err)
+
+	entry = hook.find("")
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, "(*Dispatcher).Assignments", entry.Data["method"])
+		assert.Equal(t, nodeID, entry.Data["node.id"])
+		assert.Equal(t, sessionID, entry.Data["node.session"])
+		assert.Equal(t, "request-1", entry.Data["request.id"])
+	}
+}
+
+func TestAssignmentsReasons(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	var expectedSessionID string
+	var nodeID string
+	{
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.SessionID)
+		expectedSessionID = resp.SessionID
+		nodeID = resp.Node.ID
+	}
+
+	service := &api.Service{ID: "testService"}
+	serviceTask := &api.Task{
+		NodeID:    nodeID,
+		ID:        "serviceTask",
+		ServiceID: service.ID,
+		Status:    api.TaskStatus{State: api.TaskStateAssigned},
+	}
+	standaloneTask := &api.Task{
+		NodeID: nodeID,
+		ID:     "standaloneTask",
+		Status: api.TaskStatus{State: api.TaskStateAssigned},
+	}
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateService(tx, service))
+		assert.NoError(t, store.CreateTask(tx, serviceTask))
+		assert.NoError(t, store.CreateTask(tx, standaloneTask))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{SessionID: expectedSessionID})
+	assert.NoError(t, err)
+
+	// the full message says why the tasks are on the node
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, api.AssignmentsMessage_COMPLETE, resp.Type)
+	assert.Len(t, resp.Changes, 2)
+	for _, change := range resp.Changes {
+		assert.Equal(t, "scheduled", change.Reason)
+	}
+
+	// the delta says why the tasks were removed
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.DeleteService(tx, service.ID))
+		assert.NoError(t, store.DeleteTask(tx, serviceTask.ID))
+		assert.NoError(t, store.DeleteTask(tx, standaloneTask.ID))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, api.AssignmentsMessage_INCREMENTAL, resp.Type)
+	reasons := make(map[string]string)
+	for _, change := range resp.Changes {
+		assert.Equal(t, api.AssignmentChange_AssignmentActionRemove, change.Action)
+		reasons[change.Assignment.GetTask().ID] = change.Reason
+	}
+	assert.Equal(t, map[string]string{
+		serviceTask.ID:    "service removed",
+		standaloneTask.ID: "task removed",
+	}, reasons)
+}
+
+func TestTasksStreamsPerNodeLimit(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxStreamsPerNode = 3
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	session, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer session.CloseSend()
+	resp, err := session.Recv()
+	assert.NoError(t, err)
+
+	openTasks := func() (api.Dispatcher_TasksClient, context.CancelFunc, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := gd.Clients[0].Tasks(ctx, &api.TasksRequest{SessionID: resp.SessionID})
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		return stream, cancel, err
+	}
+
+	// the session stream and two tasks streams reach the cap
+	_, cancel1, err := openTasks()
+	assert.NoError(t, err)
+	defer cancel1()
+	_, cancel2, err := openTasks()
+	assert.NoError(t, err)
+
+	_, cancel3, err := openTasks()
+	cancel3()
+	assert.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+	assert.Equal(t, ErrTooManyStreams.Error(), grpc.ErrorDesc(err))
+
+	// once a stream is closed, another one can be opened
+	cancel2()
+	assert.NoError(t, raftutils.PollFuncWithTimeout(nil, func() error {
+		_, cancel, err := openTasks()
+		cancel()
+		return err
+	}, 5*time.Second))
+}
+
 func TestTasksNoCert(t *testing.T) {
 	gd, err := startDispatcher(DefaultConfig())
 	assert.NoError(t, err)
@@ -922,43 +1753,174 @@ func TestTaskUpdate(t *testing.T) {
 
 }
 
-func TestTaskUpdateNoCert(t *testing.T) {
+func TestUpdateTaskStatusStream(t *testing.T) {
 	gd, err := startDispatcher(DefaultConfig())
 	assert.NoError(t, err)
 	defer gd.Close()
 
-	testTask1 := &api.Task{
-		ID: "testTask1",
-	}
+	session, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer session.CloseSend()
+	resp, err := session.Recv()
+	assert.NoError(t, err)
+
+	const numTasks = 20
 	err = gd.Store.Update(func(tx store.Tx) error {
-		assert.NoError(t, store.CreateTask(tx, testTask1))
-		return nil
+		for i := 0; i < numTasks; i++ {
+			assert.NoError(t, store.CreateTask(tx, &api.Task{
+				ID:     fmt.Sprintf("task%d", i),
+				NodeID: resp.Node.ID,
+			}))
+		}
+		return store.CreateTask(tx, &api.Task{ID: "otherTask", NodeID: "othernode"})
 	})
 	assert.NoError(t, err)
 
-	testTask1.Status = api.TaskStatus{State: api.TaskStateAssigned}
-	updReq := &api.UpdateTaskStatusRequest{
-		Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
-			{
-				TaskID: testTask1.ID,
-				Status: &testTask1.Status,
-			},
-		},
-	}
-	// without correct SessionID should fail
-	resp, err := gd.Clients[2].UpdateTaskStatus(context.Background(), updReq)
-	assert.Nil(t, resp)
-	assert.Error(t, err)
-	assert.EqualError(t, err, "rpc error: code = 7 desc = Permission denied: unauthorized peer role: rpc error: code = 7 desc = no client certificates in request")
-}
+	commits, cancel := state.Watch(gd.Store.WatchQueue(), state.EventCommit{})
+	defer cancel()
 
-func TestSession(t *testing.T) {
-	cfg := DefaultConfig()
-	gd, err := startDispatcher(cfg)
+	// every task goes through several states, each sent as its own message
+	states := []api.TaskState{api.TaskStateAssigned, api.TaskStateAccepted, api.TaskStatePreparing, api.TaskStateStarting, api.TaskStateRunning}
+	stream, err := gd.Clients[0].UpdateTaskStatusStream(context.Background())
 	assert.NoError(t, err)
-	defer gd.Close()
-
-	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	numMessages := 0
+	for _, taskState := range states {
+		for i := 0; i < numTasks; i++ {
+			assert.NoError(t, stream.Send(&api.UpdateTaskStatusRequest{
+				SessionID: resp.SessionID,
+				Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
+					{TaskID: fmt.Sprintf("task%d", i), Status: &api.TaskStatus{State: taskState}},
+				},
+			}))
+			numMessages++
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	assert.NoError(t, err)
+	gd.dispatcherServer.processUpdates(context.Background())
+
+	gd.Store.View(func(tx store.ReadTx) {
+		for i := 0; i < numTasks; i++ {
+			task := store.GetTask(tx, fmt.Sprintf("task%d", i))
+			assert.NotNil(t, task)
+			assert.Equal(t, api.TaskStateRunning, task.Status.State)
+		}
+	})
+
+	numCommits := 0
+	for done := false; !done; {
+		select {
+		case <-commits:
+			numCommits++
+		case <-time.After(100 * time.Millisecond):
+			done = true
+		}
+	}
+	assert.NotZero(t, numCommits)
+	assert.True(t, numCommits*10 <= numMessages, "%d messages took %d transactions", numMessages, numCommits)
+
+	// updates with an invalid session, or for another node's task, fail the
+	// stream
+	for _, tc := range []struct {
+		request *api.UpdateTaskStatusRequest
+		code    codes.Code
+	}{
+		{request: &api.UpdateTaskStatusRequest{SessionID: "invalid"}, code: codes.InvalidArgument},
+		{request: &api.UpdateTaskStatusRequest{SessionID: resp.SessionID, Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
+			{TaskID: "otherTask", Status: &api.TaskStatus{State: api.TaskStateRunning}},
+		}}, code: codes.PermissionDenied},
+	} {
+		stream, err := gd.Clients[0].UpdateTaskStatusStream(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(tc.request))
+		_, err = stream.CloseAndRecv()
+		assert.Equal(t, tc.code, grpc.Code(err))
+	}
+}
+
+func TestTaskUpdateTerminalState(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.SessionID)
+
+	completed := api.TaskStatus{State: api.TaskStateCompleted, Message: "finished"}
+	err = gd.Store.Update(func(tx store.Tx) error {
+		return store.CreateTask(tx, &api.Task{
+			ID:     "completedTask",
+			NodeID: resp.Node.ID,
+			Status: completed,
+		})
+	})
+	assert.NoError(t, err)
+
+	// a delayed running report, and a delayed duplicate of the completed
+	// report, must not overwrite the final status
+	for _, status := range []api.TaskStatus{
+		{State: api.TaskStateRunning, Message: "started"},
+		{State: api.TaskStateCompleted, Message: "stale"},
+	} {
+		status := status
+		_, err = gd.Clients[0].UpdateTaskStatus(context.Background(), &api.UpdateTaskStatusRequest{
+			SessionID: resp.SessionID,
+			Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
+				{TaskID: "completedTask", Status: &status},
+			},
+		})
+		assert.NoError(t, err)
+		gd.dispatcherServer.processUpdates(context.Background())
+
+		gd.Store.View(func(readTx store.ReadTx) {
+			storeTask := store.GetTask(readTx, "completedTask")
+			assert.NotNil(t, storeTask)
+			assert.Equal(t, completed, storeTask.Status)
+		})
+	}
+}
+
+func TestTaskUpdateNoCert(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	testTask1 := &api.Task{
+		ID: "testTask1",
+	}
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateTask(tx, testTask1))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	testTask1.Status = api.TaskStatus{State: api.TaskStateAssigned}
+	updReq := &api.UpdateTaskStatusRequest{
+		Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
+			{
+				TaskID: testTask1.ID,
+				Status: &testTask1.Status,
+			},
+		},
+	}
+	// without correct SessionID should fail
+	resp, err := gd.Clients[2].UpdateTaskStatus(context.Background(), updReq)
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "rpc error: code = 7 desc = Permission denied: unauthorized peer role: rpc error: code = 7 desc = no client certificates in request")
+}
+
+func TestSession(t *testing.T) {
+	cfg := DefaultConfig()
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
 	assert.NoError(t, err)
 	stream.CloseSend()
 	resp, err := stream.Recv()
@@ -967,6 +1929,343 @@ func TestSession(t *testing.T) {
 	assert.Equal(t, 1, len(resp.Managers))
 }
 
+func TestSessionKeepalive(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SessionKeepalivePeriod = 50 * time.Millisecond
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	sessionID := resp.SessionID
+	assert.NotEmpty(t, sessionID)
+
+	// nothing changes, but session messages keep arriving
+	for i := 0; i < 3; i++ {
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, sessionID, resp.SessionID)
+		assert.Equal(t, api.SessionMessage_DisconnectReasonNone, resp.DisconnectReason)
+	}
+}
+
+func TestSessionManagerAddrChange(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Managers, 1)
+	assert.Equal(t, gd.testCluster.addr, resp.Managers[0].Peer.Addr)
+
+	// the manager's address changes while the dispatcher is running, and the
+	// next session message carries the new address
+	newAddr := "10.0.0.1:4242"
+	gd.testCluster.publishPeers([]*api.Peer{{NodeID: "1", Addr: newAddr}})
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Managers, 1)
+	assert.Equal(t, newAddr, resp.Managers[0].Peer.Addr)
+	assert.Equal(t, newAddr, gd.dispatcherServer.getManagers()[0].Peer.Addr)
+}
+
+func TestSessionManagerUpdatesDebounced(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SessionKeepalivePeriod = 0
+	cfg.ManagerUpdateDebounce = 200 * time.Millisecond
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	msgs := make(chan *api.SessionMessage, 10)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			msgs <- msg
+		}
+	}()
+
+	// the membership changes several times in quick succession, as it may
+	// during an election
+	for i := 0; i < 5; i++ {
+		gd.testCluster.publishPeers([]*api.Peer{{NodeID: "1", Addr: fmt.Sprintf("10.0.0.%d:4242", i)}})
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// only the final list is sent, once it has stabilized
+	select {
+	case msg := <-msgs:
+		assert.Len(t, msg.Managers, 1)
+		assert.Equal(t, "10.0.0.4:4242", msg.Managers[0].Peer.Addr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stabilized list of managers was not sent")
+	}
+	select {
+	case msg := <-msgs:
+		t.Fatalf("unexpected session message with managers %v", msg.Managers)
+	case <-time.After(2 * cfg.ManagerUpdateDebounce):
+	}
+}
+
+func TestSessionManagerUpdatesNotHeldBackForever(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SessionKeepalivePeriod = 0
+	cfg.ManagerUpdateDebounce = 100 * time.Millisecond
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	msgs := make(chan *api.SessionMessage, 10)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			msgs <- msg
+		}
+	}()
+
+	// the membership keeps changing faster than the debounce period
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for i := 0; ; i++ {
+			gd.testCluster.publishPeers([]*api.Peer{{NodeID: "1", Addr: fmt.Sprintf("10.0.%d.%d:4242", i/256%256, i%256)}})
+			select {
+			case <-time.After(20 * time.Millisecond):
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// a list of managers is still sent once the maximum delay has passed
+	select {
+	case msg := <-msgs:
+		assert.Len(t, msg.Managers, 1)
+	case <-time.After(2 * maxManagerUpdateDebounces * cfg.ManagerUpdateDebounce):
+		t.Fatal("the list of managers was held back while it kept changing")
+	}
+}
+
+func TestSessionCertRenewal(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SessionKeepalivePeriod = 0
+	cfg.CertRenewalThreshold = 2 * time.Second
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.False(t, resp.RenewCertificate)
+
+	// the node gets a certificate that is only valid for a few seconds
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+	notBefore := time.Now().Truncate(time.Second)
+	notAfter := notBefore.Add(5 * time.Second)
+	cert, err := gd.testCA.RootCA.ParseValidateAndSignCSRWithValidity(csr, notBefore, notAfter, resp.Node.ID, ca.WorkerRole, gd.testCA.Organization)
+	assert.NoError(t, err)
+	assert.NoError(t, gd.Store.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, resp.Node.ID)
+		node.Certificate.Certificate = cert
+		return store.UpdateNode(tx, node)
+	}))
+
+	// the agent is asked to renew it before it expires
+	for {
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		if err != nil || resp.RenewCertificate {
+			break
+		}
+	}
+	assert.True(t, time.Now().Before(notAfter))
+	assert.True(t, time.Now().After(notAfter.Add(-cfg.CertRenewalThreshold-time.Second)))
+}
+
+func TestSessionAdmissionStaggersNewSessions(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SessionAdmissionWindow = 500 * time.Millisecond
+	cfg.SessionAdmissionThreshold = 0
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	// make the delays predictable
+	gd.dispatcherServer.admission.rand = rand.New(rand.NewSource(1))
+	expected := rand.New(rand.NewSource(1))
+	var delays []time.Duration
+	for range gd.Clients[:2] {
+		delays = append(delays, time.Duration(expected.Int63n(int64(cfg.SessionAdmissionWindow))))
+	}
+	sort.Sort(durations(delays))
+
+	start := time.Now()
+	elapsed := make(chan time.Duration, 2)
+	for _, client := range gd.Clients[:2] {
+		go func(client api.DispatcherClient) {
+			stream, err := client.Session(context.Background(), &api.SessionRequest{})
+			assert.NoError(t, err)
+			defer stream.CloseSend()
+			resp, err := stream.Recv()
+			assert.NoError(t, err)
+			assert.NotEmpty(t, resp.SessionID)
+			elapsed <- time.Since(start)
+		}(client)
+	}
+
+	var served []time.Duration
+	for range gd.Clients[:2] {
+		select {
+		case e := <-elapsed:
+			served = append(served, e)
+		case <-time.After(5 * time.Second):
+			t.Fatal("session was not served")
+		}
+	}
+	sort.Sort(durations(served))
+
+	// each session waited at least as long as its randomized delay
+	for i := range served {
+		assert.True(t, served[i] >= delays[i], "session served after %v, expected to wait %v", served[i], delays[i])
+	}
+}
+
+type durations []time.Duration
+
+func (d durations) Len() int           { return len(d) }
+func (d durations) Less(i, j int) bool { return d[i] < d[j] }
+func (d durations) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+
+func TestSessionDisconnectReasons(t *testing.T) {
+	for _, tc := range []struct {
+		disconnect func(*Dispatcher)
+		reason     api.SessionMessage_DisconnectReason
+	}{
+		{
+			disconnect: func(d *Dispatcher) { d.Drain() },
+			reason:     api.SessionMessage_DisconnectReasonDrain,
+		},
+		{
+			// the manager stops the dispatcher when it loses leadership
+			disconnect: func(d *Dispatcher) { d.Stop() },
+			reason:     api.SessionMessage_DisconnectReasonLeadershipLost,
+		},
+		{
+			disconnect: func(d *Dispatcher) {
+				d.PrepareStop()
+				d.Stop()
+			},
+			reason: api.SessionMessage_DisconnectReasonShutdown,
+		},
+	} {
+		gd, err := startDispatcher(DefaultConfig())
+		assert.NoError(t, err)
+
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.SessionID)
+		assert.Equal(t, api.SessionMessage_DisconnectReasonNone, resp.DisconnectReason)
+
+		tc.disconnect(gd.dispatcherServer)
+
+		resp, err = stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.reason, resp.DisconnectReason)
+
+		_, err = stream.Recv()
+		assert.Error(t, err)
+
+		stream.CloseSend()
+		gd.Close()
+	}
+}
+
+func TestRemovedNodeSessionTerminated(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	sessionID := resp.SessionID
+	assert.NotEmpty(t, sessionID)
+
+	tasksStream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: sessionID})
+	assert.NoError(t, err)
+	tasksResp, err := tasksStream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tasksResp.Tasks))
+
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	assert.NoError(t, gd.Store.Update(func(tx store.Tx) error {
+		return store.DeleteNode(tx, nodeID)
+	}))
+
+	// the session is told why it's being closed
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, api.SessionMessage_DisconnectReasonNodeRemoved, resp.DisconnectReason)
+	_, err = stream.Recv()
+	assert.Error(t, err)
+
+	// and the node's other streams are terminated as well
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := tasksStream.Recv()
+		errCh <- err
+	}()
+	select {
+	case err := <-errCh:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("tasks stream of a removed node was not terminated")
+	}
+
+	// the removed node can't heartbeat or register a new session
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: sessionID})
+	assert.Error(t, err)
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
+
 func TestSessionNoCert(t *testing.T) {
 	cfg := DefaultConfig()
 	gd, err := startDispatcher(cfg)
@@ -1080,6 +2379,122 @@ var taskStatesInOrder = []api.TaskState{
 
 // Ensure we test the old Tasks() API for backwards compat
 
+func TestTasksForNode(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+
+	// the node isn't registered yet
+	_, err = gd.dispatcherServer.TasksForNode(nodeID)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	tasks, err := gd.dispatcherServer.TasksForNode(nodeID)
+	assert.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:     "assignedTask",
+			NodeID: nodeID,
+			Status: api.TaskStatus{State: api.TaskStateAssigned},
+		}))
+		assert.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:     "runningTask",
+			NodeID: nodeID,
+			Status: api.TaskStatus{State: api.TaskStateRunning},
+		}))
+		// not sent to the node yet
+		assert.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:     "pendingTask",
+			NodeID: nodeID,
+			Status: api.TaskStatus{State: api.TaskStatePending},
+		}))
+		// assigned to a different node
+		assert.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:     "otherTask",
+			NodeID: "otherNode",
+			Status: api.TaskStatus{State: api.TaskStateAssigned},
+		}))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	tasks, err = gd.dispatcherServer.TasksForNode(nodeID)
+	assert.NoError(t, err)
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	sort.Strings(ids)
+	assert.Equal(t, []string{"assignedTask", "runningTask"}, ids)
+}
+
+func TestTasksDesiredStateFilter(t *testing.T) {
+	t.Parallel()
+
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	sessionID := resp.SessionID
+	nodeID := resp.Node.ID
+
+	err = gd.Store.Update(func(tx store.Tx) error {
+		for id, desiredState := range map[string]api.TaskState{
+			"readyTask":    api.TaskStateReady,
+			"runningTask":  api.TaskStateRunning,
+			"shutdownTask": api.TaskStateShutdown,
+			"completeTask": api.TaskStateCompleted,
+		} {
+			assert.NoError(t, store.CreateTask(tx, &api.Task{
+				ID:           id,
+				NodeID:       nodeID,
+				Status:       api.TaskStatus{State: api.TaskStateRunning},
+				DesiredState: desiredState,
+			}))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	receivedIDs := func(r *api.TasksRequest) []string {
+		tasksStream, err := gd.Clients[0].Tasks(context.Background(), r)
+		assert.NoError(t, err)
+		defer tasksStream.CloseSend()
+		resp, err := tasksStream.Recv()
+		assert.NoError(t, err)
+		var ids []string
+		for _, task := range resp.Tasks {
+			ids = append(ids, task.ID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	// by default, all the tasks are sent
+	assert.Equal(t, []string{"completeTask", "readyTask", "runningTask", "shutdownTask"}, receivedIDs(&api.TasksRequest{SessionID: sessionID}))
+
+	// with a filter, only those with a requested desired state are
+	assert.Equal(t, []string{"readyTask", "runningTask"}, receivedIDs(&api.TasksRequest{
+		SessionID:     sessionID,
+		DesiredStates: []api.TaskState{api.TaskStateReady, api.TaskStateRunning},
+	}))
+}
+
 func TestOldTasks(t *testing.T) {
 	t.Parallel()
 
@@ -1327,6 +2742,264 @@ func TestOldTasksBatch(t *testing.T) {
 	assert.Equal(t, len(resp.Tasks), 0)
 }
 
+func TestTasksStoreError(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	// reading the node's tasks fails once
+	d := gd.dispatcherServer
+	d.mu.Lock()
+	failed := false
+	d.watchTasks = func(nodeID string) (map[string]*api.Task, chan events.Event, func(), error) {
+		if !failed {
+			failed = true
+			return nil, nil, nil, fmt.Errorf("find failed")
+		}
+		return d.watchNodeTasks(nodeID)
+	}
+	d.mu.Unlock()
+
+	session, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer session.CloseSend()
+	resp, err := session.Recv()
+	assert.NoError(t, err)
+
+	// rather than an empty set of tasks, the agent gets an error telling it
+	// to try again
+	stream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: resp.SessionID})
+	assert.NoError(t, err)
+	msg, err := stream.Recv()
+	assert.Nil(t, msg)
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+
+	// which succeeds once the store can be read
+	stream, err = gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: resp.SessionID})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+}
+
+func TestOldTasksWatchDropped(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	// The first watch for the node's tasks never delivers any events, and
+	// is dropped when the test says so. Later watches are real.
+	dropped := make(chan events.Event)
+	watches := make(chan struct{}, 2)
+	d := gd.dispatcherServer
+	d.mu.Lock()
+	d.watchTasks = func(nodeID string) (map[string]*api.Task, chan events.Event, func(), error) {
+		tasksMap, nodeTasks, cancel, err := d.watchNodeTasks(nodeID)
+		watches <- struct{}{}
+		if len(watches) == 1 {
+			nodeTasks = dropped
+		}
+		return tasksMap, nodeTasks, cancel, err
+	}
+	d.mu.Unlock()
+
+	var expectedSessionID string
+	var nodeID string
+	{
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		expectedSessionID = resp.SessionID
+		nodeID = resp.Node.ID
+	}
+
+	stream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: expectedSessionID})
+	assert.NoError(t, err)
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Tasks, 0)
+
+	// this task is missed by the dropped watch
+	err = gd.Store.Update(func(tx store.Tx) error {
+		return store.CreateTask(tx, &api.Task{
+			ID:     "testTask1",
+			NodeID: nodeID,
+			Status: api.TaskStatus{State: api.TaskStateAssigned},
+		})
+	})
+	assert.NoError(t, err)
+
+	// once the watch is dropped, the stream resyncs from the store
+	close(dropped)
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Tasks, 1)
+	assert.Equal(t, "testTask1", resp.Tasks[0].ID)
+	assert.Len(t, watches, 2)
+
+	// and keeps getting changes from the new watch
+	err = gd.Store.Update(func(tx store.Tx) error {
+		return store.DeleteTask(tx, "testTask1")
+	})
+	assert.NoError(t, err)
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Tasks, 0)
+}
+
+func TestOldTasksWatchDroppedResyncFails(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	// The first watch for the node's tasks is dropped when the test says
+	// so, and watching again fails, as it does once the node is removed.
+	dropped := make(chan events.Event)
+	watches := 0
+	d := gd.dispatcherServer
+	d.mu.Lock()
+	d.watchTasks = func(nodeID string) (map[string]*api.Task, chan events.Event, func(), error) {
+		watches++
+		if watches > 1 {
+			return nil, nil, nil, ErrNodeNotFound
+		}
+		tasksMap, _, cancel, err := d.watchNodeTasks(nodeID)
+		return tasksMap, dropped, cancel, err
+	}
+	d.mu.Unlock()
+
+	session, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer session.CloseSend()
+	resp, err := session.Recv()
+	assert.NoError(t, err)
+
+	stream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: resp.SessionID})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	// the stream ends with an error, rather than the dispatcher panicking
+	close(dropped)
+	_, err = stream.Recv()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrNodeNotFound.Error())
+
+	// and the dispatcher keeps serving the node
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: resp.SessionID})
+	assert.NoError(t, err)
+}
+
+func TestOldTasksVersion(t *testing.T) {
+	t.Parallel()
+
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	var expectedSessionID string
+	var nodeID string
+	{
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		expectedSessionID = resp.SessionID
+		nodeID = resp.Node.ID
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := gd.Clients[0].Tasks(ctx, &api.TasksRequest{SessionID: expectedSessionID})
+	assert.NoError(t, err)
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Tasks, 0)
+	assert.Equal(t, uint64(0), resp.PreviousVersion)
+	assert.NotEqual(t, uint64(0), resp.Version)
+
+	// every update carries a higher version, chained to the previous one
+	lastVersion := resp.Version
+	for _, taskID := range []string{"testTask1", "testTask2"} {
+		err = gd.Store.Update(func(tx store.Tx) error {
+			return store.CreateTask(tx, &api.Task{
+				ID:     taskID,
+				NodeID: nodeID,
+				Status: api.TaskStatus{State: api.TaskStateAssigned},
+			})
+		})
+		assert.NoError(t, err)
+
+		resp, err = stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, lastVersion, resp.PreviousVersion)
+		assert.True(t, resp.Version > lastVersion)
+		lastVersion = resp.Version
+	}
+	cancel()
+
+	// a new stream re-syncs: it starts a new chain, but versions keep
+	// increasing
+	stream, err = gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: expectedSessionID})
+	assert.NoError(t, err)
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.Tasks, 2)
+	assert.Equal(t, uint64(0), resp.PreviousVersion)
+	assert.True(t, resp.Version > lastVersion)
+}
+
+func TestOldTasksStaleSessionOnReregister(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RateLimitPeriod = 0
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	var staleSessionID string
+	{
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.SessionID)
+		staleSessionID = resp.SessionID
+	}
+
+	tasksStream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: staleSessionID})
+	assert.NoError(t, err)
+	resp, err := tasksStream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Tasks))
+
+	// register again, which replaces the session the tasks stream is using
+	{
+		stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+		assert.NoError(t, err)
+		defer stream.CloseSend()
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.NotEqual(t, staleSessionID, resp.SessionID)
+	}
+
+	// there are no task events, so only the session invalidation can end the stream
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := tasksStream.Recv()
+		errCh <- err
+	}()
+	select {
+	case err := <-errCh:
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale tasks stream did not exit after the node re-registered")
+	}
+}
+
 func TestOldTasksNoCert(t *testing.T) {
 	gd, err := startDispatcher(DefaultConfig())
 	assert.NoError(t, err)
@@ -1339,3 +3012,165 @@ func TestOldTasksNoCert(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.EqualError(t, err, "rpc error: code = 7 desc = Permission denied: unauthorized peer role: rpc error: code = 7 desc = no client certificates in request")
 }
+
+func TestNodeIDMismatch(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	otherID := gd.SecurityConfigs[1].ClientTLSCreds.NodeID()
+	assert.NotEqual(t, nodeID, otherID)
+
+	// a node can't start a session for another node
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{NodeID: otherID})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+
+	// but it can for itself
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{NodeID: nodeID})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	sessionID := resp.SessionID
+
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: sessionID, NodeID: otherID})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+	_, err = gd.Clients[0].Heartbeat(context.Background(), &api.HeartbeatRequest{SessionID: sessionID, NodeID: nodeID})
+	assert.NoError(t, err)
+
+	_, err = gd.Clients[0].UpdateTaskStatus(context.Background(), &api.UpdateTaskStatusRequest{SessionID: sessionID, NodeID: otherID})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+
+	updateStream, err := gd.Clients[0].UpdateTaskStatusStream(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, updateStream.Send(&api.UpdateTaskStatusRequest{SessionID: sessionID, NodeID: otherID}))
+	_, err = updateStream.CloseAndRecv()
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+
+	tasksStream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: sessionID, NodeID: otherID})
+	assert.NoError(t, err)
+	_, err = tasksStream.Recv()
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+
+	assignmentsStream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{SessionID: sessionID, NodeID: otherID})
+	assert.NoError(t, err)
+	_, err = assignmentsStream.Recv()
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+}
+
+func TestPartialConfig(t *testing.T) {
+	defaults := DefaultConfig()
+
+	// the fields left unset in a partial config are set to their defaults
+	d := New(&testCluster{}, &Config{PersistSessions: true, HeartbeatPeriod: time.Minute})
+	assert.True(t, d.config.PersistSessions)
+	assert.Equal(t, time.Minute, d.config.HeartbeatPeriod)
+	assert.Equal(t, defaults.HeartbeatEpsilon, d.config.HeartbeatEpsilon)
+	assert.Equal(t, defaults.GracePeriodMultiplier, d.config.GracePeriodMultiplier)
+	assert.Equal(t, defaults.RateLimitPeriod, d.config.RateLimitPeriod)
+	assert.Equal(t, defaults.SessionKeepalivePeriod, d.config.SessionKeepalivePeriod)
+	assert.Equal(t, defaults.MaxStreamsPerNode, d.config.MaxStreamsPerNode)
+	assert.Equal(t, defaults.PreviousSessionGrace, d.config.PreviousSessionGrace)
+
+	// but zero fields in a config obtained from DefaultConfig are kept
+	c := DefaultConfig()
+	c.HeartbeatEpsilon = 0
+	c.RateLimitPeriod = 0
+	d = New(&testCluster{}, c)
+	assert.Equal(t, time.Duration(0), d.config.HeartbeatEpsilon)
+	assert.Equal(t, time.Duration(0), d.config.RateLimitPeriod)
+	assert.Equal(t, defaults.GracePeriodMultiplier, d.config.GracePeriodMultiplier)
+}
+
+func TestRegisterMetadata(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	nodeID := gd.SecurityConfigs[0].ClientTLSCreds.NodeID()
+	storedMetadata := func() map[string]string {
+		var metadata map[string]string
+		gd.Store.View(func(readTx store.ReadTx) {
+			metadata = store.GetNode(readTx, nodeID).Metadata
+		})
+		return metadata
+	}
+
+	metadata := map[string]string{
+		"runtime": "containerd 1.0.0",
+		"os":      "linux",
+		"kernel":  "4.9.0",
+	}
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{Metadata: metadata})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, metadata, storedMetadata())
+	stream.CloseSend()
+
+	// registering again replaces the metadata
+	metadata = map[string]string{
+		"runtime": "containerd 1.1.0",
+		"os":      "linux",
+	}
+	stream, err = gd.Clients[0].Session(context.Background(), &api.SessionRequest{Metadata: metadata})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, metadata, storedMetadata())
+}
+
+func TestTasksSendTimeout(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.TaskSendTimeout = 200 * time.Millisecond
+	// the session and a single tasks stream
+	cfg.MaxStreamsPerNode = 2
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	sessionID := resp.SessionID
+	nodeID := resp.Node.ID
+
+	// the tasks are larger than the stream's flow control window, so sending
+	// them blocks until the agent reads them
+	err = gd.Store.Update(func(tx store.Tx) error {
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, store.CreateTask(tx, &api.Task{
+				ID:     fmt.Sprintf("task%d", i),
+				NodeID: nodeID,
+				Status: api.TaskStatus{State: api.TaskStateAssigned},
+				Annotations: api.Annotations{
+					Labels: map[string]string{"padding": strings.Repeat("x", 50*1024)},
+				},
+			}))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// an agent that doesn't read its tasks has its stream closed, in the
+	// middle of the message that couldn't be sent
+	stalled, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: sessionID})
+	assert.NoError(t, err)
+	time.Sleep(time.Second)
+	_, err = stalled.Recv()
+	assert.Error(t, err)
+
+	// which frees its place for a new stream, on which the tasks are sent
+	tasksStream, err := gd.Clients[0].Tasks(context.Background(), &api.TasksRequest{SessionID: sessionID})
+	assert.NoError(t, err)
+	defer tasksStream.CloseSend()
+	tasksResp, err := tasksStream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, tasksResp.Tasks, 4)
+}
@@ -15,13 +15,50 @@ import (
 const rateLimitCount = 3
 
 type registeredNode struct {
-	SessionID  string
-	Heartbeat  *heartbeat.Heartbeat
-	Registered time.Time
-	Attempts   int
-	Node       *api.Node
-	Disconnect chan struct{} // signal to disconnect
-	mu         sync.Mutex
+	SessionID string
+	Heartbeat *heartbeat.Heartbeat
+	// Period is the heartbeat period advertised to the node. The node's
+	// heartbeat grace is always Period times the grace multiplier, both
+	// right after registration and after each heartbeat, so a node which
+	// never heartbeats expires after the same grace it would have been
+	// given by its first heartbeat.
+	Period time.Duration
+	// HeartbeatRange is the range of heartbeat periods the node supports.
+	// Period is chosen within it, if possible.
+	HeartbeatRange heartbeatRange
+	Registered     time.Time
+	Attempts       int
+	Node           *api.Node
+	Disconnect     chan struct{} // signal to disconnect
+	// DisconnectReason is why the node was told to disconnect. It is set
+	// before Disconnect is closed.
+	DisconnectReason api.SessionMessage_DisconnectReason
+	// Invalidated is closed when this session is replaced by a new
+	// registration or the node is removed, so that streams tied to the
+	// session can exit without waiting for their next event.
+	Invalidated chan struct{}
+	// Added is when the node was last added to the store, which its
+	// heartbeat grace starts from. Unlike Registered, it is not carried
+	// over when the node is added again.
+	Added time.Time
+	// TasksVersion is the Version of the last TasksMessage sent to the
+	// node.
+	TasksVersion uint64
+	// Streams is the number of Session, Tasks and Assignments streams open
+	// with this session.
+	Streams int
+	// PreviousSessionID is the session the node had before it registered
+	// again, which is accepted for task status updates until
+	// PreviousSessionExpiry.
+	PreviousSessionID     string
+	PreviousSessionExpiry time.Time
+	mu                    sync.Mutex
+}
+
+// heartbeatRange is a range of heartbeat periods supported by a node. A zero
+// max means that the node didn't advertise a range.
+type heartbeatRange struct {
+	min, max time.Duration
 }
 
 // checkSessionID determines if the SessionID has changed and returns the
@@ -42,8 +79,53 @@ func (rn *registeredNode) checkSessionID(sessionID string) error {
 	return nil
 }
 
+// isPreviousSession returns whether sessionID is the node's previous session,
+// and is still accepted.
+func (rn *registeredNode) isPreviousSession(sessionID string) bool {
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+	return sessionID != "" && sessionID == rn.PreviousSessionID && time.Now().Before(rn.PreviousSessionExpiry)
+}
+
+// openStream records that a stream was opened with the node's session,
+// unless max streams are already open, and returns a function to call when
+// the stream is closed. A non-positive max means there is no limit.
+func (rn *registeredNode) openStream(max int) (func(), error) {
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+	if max > 0 && rn.Streams >= max {
+		return nil, grpc.Errorf(codes.ResourceExhausted, "%s", ErrTooManyStreams)
+	}
+	rn.Streams++
+	return func() {
+		rn.mu.Lock()
+		rn.Streams--
+		rn.mu.Unlock()
+	}, nil
+}
+
+// nextTasksVersion returns the Version for the next TasksMessage sent to the
+// node, which is greater than the last one sent and at least storeVersion.
+func (rn *registeredNode) nextTasksVersion(storeVersion uint64) uint64 {
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+
+	rn.TasksVersion++
+	if storeVersion > rn.TasksVersion {
+		rn.TasksVersion = storeVersion
+	}
+	return rn.TasksVersion
+}
+
 type nodeStore struct {
-	periodChooser                *periodChooser
+	periodChooser *periodChooser
+	// maxPeriod is the longest heartbeat period given to nodes that support
+	// it. Nodes that don't advertise a range always get the period of
+	// periodChooser.
+	maxPeriod time.Duration
+	// previousSessionGrace is how long the previous session of a node
+	// that registers again is still accepted by GetWithPreviousSession.
+	previousSessionGrace         time.Duration
 	gracePeriodMultiplierNormal  time.Duration
 	gracePeriodMultiplierUnknown time.Duration
 	rateLimitPeriod              time.Duration
@@ -51,27 +133,88 @@ type nodeStore struct {
 	mu                           sync.RWMutex
 }
 
-func newNodeStore(hbPeriod, hbEpsilon time.Duration, graceMultiplier int, rateLimitPeriod time.Duration) *nodeStore {
+func newNodeStore(hbPeriod, hbEpsilon time.Duration, hbEpsilonFraction float64, graceMultiplier int, rateLimitPeriod time.Duration) *nodeStore {
 	return &nodeStore{
 		nodes:                        make(map[string]*registeredNode),
-		periodChooser:                newPeriodChooser(hbPeriod, hbEpsilon),
+		periodChooser:                newPeriodChooser(hbPeriod, hbEpsilon, hbEpsilonFraction),
 		gracePeriodMultiplierNormal:  time.Duration(graceMultiplier),
 		gracePeriodMultiplierUnknown: time.Duration(graceMultiplier) * 2,
 		rateLimitPeriod:              rateLimitPeriod,
 	}
 }
 
-func (s *nodeStore) updatePeriod(hbPeriod, hbEpsilon time.Duration, gracePeriodMultiplier int) {
+func (s *nodeStore) updatePeriod(hbPeriod, hbEpsilon time.Duration, hbEpsilonFraction float64, gracePeriodMultiplier int) {
 	s.mu.Lock()
-	s.periodChooser = newPeriodChooser(hbPeriod, hbEpsilon)
+	s.periodChooser = newPeriodChooser(hbPeriod, hbEpsilon, hbEpsilonFraction)
 	s.gracePeriodMultiplierNormal = time.Duration(gracePeriodMultiplier)
 	s.gracePeriodMultiplierUnknown = s.gracePeriodMultiplierNormal * 2
+	// registered nodes pick up the new period, and the grace derived from
+	// it, on their next heartbeat
+	for _, rn := range s.nodes {
+		rn.mu.Lock()
+		rn.Period = s.choosePeriod(rn.HeartbeatRange)
+		rn.mu.Unlock()
+	}
 	s.mu.Unlock()
 }
 
+// choosePeriod returns the heartbeat period for a node that supports the
+// given range of periods: the longest period allowed by both the range and
+// the store, randomized without leaving either. If they have nothing in
+// common, the node gets the regular period. It expects the store to be
+// locked.
+func (s *nodeStore) choosePeriod(r heartbeatRange) time.Duration {
+	if r.max <= 0 {
+		return s.periodChooser.Choose()
+	}
+	lo, hi := s.periodChooser.period, s.periodChooser.period
+	if s.maxPeriod > hi {
+		hi = s.maxPeriod
+	}
+	if r.min > lo {
+		lo = r.min
+	}
+	if r.max < hi {
+		hi = r.max
+	}
+	if lo > hi {
+		return s.periodChooser.Choose()
+	}
+	period := s.periodChooser.chooseAround(hi)
+	// randomize downwards only, so that the period stays within the range
+	if period > hi {
+		period = 2*hi - period
+	}
+	if period < lo {
+		period = lo
+	}
+	return period
+}
+
+// SetHeartbeatRange records the range of heartbeat periods a registered node
+// supports, and if it changed, chooses its heartbeat period again.
+func (s *nodeStore) SetHeartbeatRange(rn *registeredNode, r heartbeatRange) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+	if rn.HeartbeatRange == r {
+		return
+	}
+	rn.HeartbeatRange = r
+	rn.Period = s.choosePeriod(r)
+	rn.Heartbeat.Update(s.grace(rn.Period))
+}
+
+// grace returns how long a registered node with the given heartbeat period
+// may go without heartbeating before it is considered down.
+func (s *nodeStore) grace(period time.Duration) time.Duration {
+	return period * s.gracePeriodMultiplierNormal
+}
+
 func (s *nodeStore) Len() int {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return len(s.nodes)
 }
 
@@ -106,28 +249,62 @@ func (s *nodeStore) CheckRateLimit(id string) error {
 
 // Add adds new node and returns it, it replaces existing without notification.
 func (s *nodeStore) Add(n *api.Node, expireFunc func()) *registeredNode {
+	return s.AddWithSession(n, identity.NewID(), expireFunc)
+}
+
+// AddWithSession is like Add, but gives the node the given session ID
+// instead of a new one.
+func (s *nodeStore) AddWithSession(n *api.Node, sessionID string, expireFunc func()) *registeredNode {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	var attempts int
 	var registered time.Time
+	var previousSessionID string
 	if existRn, ok := s.nodes[n.ID]; ok {
 		attempts = existRn.Attempts
 		registered = existRn.Registered
+		existRn.mu.Lock()
+		previousSessionID = existRn.SessionID
+		existRn.mu.Unlock()
 		existRn.Heartbeat.Stop()
+		existRn.invalidate()
 		delete(s.nodes, n.ID)
 	}
 	if registered.IsZero() {
 		registered = time.Now()
 	}
 	rn := &registeredNode{
-		SessionID:  identity.NewID(), // session ID is local to the dispatcher.
-		Node:       n,
-		Registered: registered,
-		Attempts:   attempts,
-		Disconnect: make(chan struct{}),
+		SessionID:   sessionID,
+		Period:      s.periodChooser.Choose(),
+		Node:        n,
+		Registered:  registered,
+		Added:       time.Now(),
+		Attempts:    attempts,
+		Disconnect:  make(chan struct{}),
+		Invalidated: make(chan struct{}),
+	}
+	if previousSessionID != "" && previousSessionID != sessionID && s.previousSessionGrace > 0 {
+		rn.PreviousSessionID = previousSessionID
+		rn.PreviousSessionExpiry = time.Now().Add(s.previousSessionGrace)
 	}
 	s.nodes[n.ID] = rn
-	rn.Heartbeat = heartbeat.New(s.periodChooser.Choose()*s.gracePeriodMultiplierNormal, expireFunc)
+	rn.Heartbeat = heartbeat.New(s.grace(rn.Period), expireFunc)
+	return rn
+}
+
+// Adopt adds a node with a session that was created by another dispatcher,
+// so that the node can keep using it. Since the node first has to find the
+// new leader, it is given the same grace period as a node in the unknown
+// state until its next heartbeat.
+func (s *nodeStore) Adopt(n *api.Node, sessionID string, expireFunc func()) *registeredNode {
+	rn := s.AddWithSession(n, sessionID, expireFunc)
+	s.mu.RLock()
+	grace := rn.Period * s.gracePeriodMultiplierUnknown
+	s.mu.RUnlock()
+	rn.mu.Lock()
+	rn.Heartbeat.Update(grace)
+	rn.Heartbeat.Beat()
+	rn.mu.Unlock()
 	return rn
 }
 
@@ -151,39 +328,123 @@ func (s *nodeStore) GetWithSession(id, sid string) (*registeredNode, error) {
 	return rn, rn.checkSessionID(sid)
 }
 
+// GetWithPreviousSession is like GetWithSession, but also accepts the session
+// the node had before it last registered, for a short grace after it did.
+func (s *nodeStore) GetWithPreviousSession(id, sid string) (*registeredNode, error) {
+	rn, err := s.GetWithSession(id, sid)
+	if err != nil && rn != nil && rn.isPreviousSession(sid) {
+		return rn, nil
+	}
+	return rn, err
+}
+
 func (s *nodeStore) Heartbeat(id, sid string) (time.Duration, error) {
 	rn, err := s.GetWithSession(id, sid)
 	if err != nil {
 		return 0, err
 	}
-	period := s.periodChooser.Choose() // base period for node
-	grace := period * time.Duration(s.gracePeriodMultiplierNormal)
 	rn.mu.Lock()
-	rn.Heartbeat.Update(grace)
+	period := rn.Period
+	rn.Heartbeat.Update(s.grace(period))
 	rn.Heartbeat.Beat()
 	rn.mu.Unlock()
 	return period, nil
 }
 
+// RemainingGrace returns how long the node has left until it expires if it
+// doesn't heartbeat, if it's in the store. It is meant for downNodes, whose
+// nodes never heartbeat, so their grace runs from when they were last
+// marked down.
+func (s *nodeStore) RemainingGrace(id string) (time.Duration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rn, ok := s.nodes[id]
+	if !ok {
+		return 0, false
+	}
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+	remaining := s.grace(rn.Period) - time.Since(rn.Added)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
 func (s *nodeStore) Delete(id string) *registeredNode {
 	s.mu.Lock()
 	var node *registeredNode
 	if rn, ok := s.nodes[id]; ok {
 		delete(s.nodes, id)
 		rn.Heartbeat.Stop()
+		rn.invalidate()
 		node = rn
 	}
 	s.mu.Unlock()
 	return node
 }
 
-func (s *nodeStore) Disconnect(id string) {
+func (s *nodeStore) Disconnect(id string, reason api.SessionMessage_DisconnectReason) {
 	s.mu.Lock()
 	if rn, ok := s.nodes[id]; ok {
-		close(rn.Disconnect)
+		rn.disconnect(reason)
+	}
+	s.mu.Unlock()
+}
+
+// Remove tells a node to disconnect for the given reason, invalidates its
+// session and forgets about it, so that none of its streams or heartbeats
+// are served anymore.
+func (s *nodeStore) Remove(id string, reason api.SessionMessage_DisconnectReason) *registeredNode {
+	s.mu.Lock()
+	var node *registeredNode
+	if rn, ok := s.nodes[id]; ok {
+		delete(s.nodes, id)
+		rn.disconnect(reason)
 		rn.Heartbeat.Stop()
+		rn.invalidate()
+		node = rn
 	}
 	s.mu.Unlock()
+	return node
+}
+
+// DisconnectAll tells every registered node to disconnect.
+func (s *nodeStore) DisconnectAll(reason api.SessionMessage_DisconnectReason) {
+	s.mu.Lock()
+	for _, rn := range s.nodes {
+		rn.disconnect(reason)
+	}
+	s.mu.Unlock()
+}
+
+func (rn *registeredNode) disconnect(reason api.SessionMessage_DisconnectReason) {
+	if rn.Disconnect == nil {
+		// nodes in unknown state have no session to disconnect
+		return
+	}
+	select {
+	case <-rn.Disconnect:
+		// already disconnecting
+	default:
+		rn.DisconnectReason = reason
+		close(rn.Disconnect)
+		rn.Heartbeat.Stop()
+	}
+}
+
+// invalidate signals streams using this node's session that the session is
+// no longer valid.
+func (rn *registeredNode) invalidate() {
+	if rn.Invalidated == nil {
+		// nodes in unknown state have no session to invalidate
+		return
+	}
+	select {
+	case <-rn.Invalidated:
+	default:
+		close(rn.Invalidated)
+	}
 }
 
 // Clean removes all nodes and stops their heartbeats.
@@ -192,6 +453,7 @@ func (s *nodeStore) Clean() {
 	s.mu.Lock()
 	for _, rn := range s.nodes {
 		rn.Heartbeat.Stop()
+		rn.invalidate()
 	}
 	s.nodes = make(map[string]*registeredNode)
 	s.mu.Unlock()
@@ -0,0 +1,97 @@
+package dispatcher
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNodeStore(n int) (*nodeStore, []*registeredNode) {
+	s := newNodeStore(time.Minute, time.Second, 0, defaultGracePeriodMultiplier, defaultRateLimitPeriod)
+	nodes := make([]*registeredNode, n)
+	for i := range nodes {
+		nodes[i] = s.Add(&api.Node{ID: fmt.Sprintf("node-%d", i)}, func() {})
+	}
+	return s, nodes
+}
+
+// TestNodeStoreConcurrentHeartbeats is mostly useful with -race
+func TestNodeStoreConcurrentHeartbeats(t *testing.T) {
+	s, nodes := newTestNodeStore(100)
+	defer s.Clean()
+
+	var (
+		wg       sync.WaitGroup
+		failures int32
+	)
+	for _, rn := range nodes {
+		wg.Add(1)
+		go func(id, sessionID string) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				period, err := s.Heartbeat(id, sessionID)
+				if err != nil || period <= 0 {
+					atomic.AddInt32(&failures, 1)
+				}
+			}
+		}(rn.Node.ID, rn.SessionID)
+	}
+
+	// registrations and deletions happen concurrently with heartbeats
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			rn := s.Add(&api.Node{ID: fmt.Sprintf("other-%d", i)}, func() {})
+			s.Heartbeat(rn.Node.ID, rn.SessionID)
+			s.Delete(rn.Node.ID)
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, int32(0), failures)
+	assert.Equal(t, len(nodes), s.Len())
+}
+
+func BenchmarkNodeStoreHeartbeat(b *testing.B) {
+	s, nodes := newTestNodeStore(1000)
+	defer s.Clean()
+
+	var next int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			rn := nodes[int(atomic.AddInt64(&next, 1))%len(nodes)]
+			if _, err := s.Heartbeat(rn.Node.ID, rn.SessionID); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestNodeStoreRemainingGrace(t *testing.T) {
+	s := newNodeStore(time.Minute, 0, 0, 1, time.Hour)
+	defer s.Clean()
+
+	_, ok := s.RemainingGrace("node")
+	assert.False(t, ok)
+
+	rn := s.Add(&api.Node{ID: "node"}, func() {})
+	remaining, ok := s.RemainingGrace("node")
+	assert.True(t, ok)
+	assert.True(t, remaining > 0 && remaining <= time.Minute, "remaining grace %s", remaining)
+
+	// a node added again, like one marked down again, gets a full grace,
+	// although it keeps its registration time
+	rn.Registered = time.Now().Add(-time.Hour)
+	rn = s.Add(&api.Node{ID: "node"}, func() {})
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), rn.Registered, time.Second)
+	remaining, ok = s.RemainingGrace("node")
+	assert.True(t, ok)
+	assert.True(t, remaining > 59*time.Second, "remaining grace %s", remaining)
+}
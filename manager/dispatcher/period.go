@@ -1,28 +1,67 @@
 package dispatcher
 
 import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
 	"math/rand"
+	"sync"
 	"time"
 )
 
 type periodChooser struct {
 	period  time.Duration
 	epsilon time.Duration
-	rand    *rand.Rand
+	// epsilonFraction, if positive, is used instead of epsilon, as a
+	// fraction of period.
+	epsilonFraction float64
+
+	// mu protects rand, which isn't safe for concurrent use
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+func newPeriodChooser(period, eps time.Duration, epsFraction float64) *periodChooser {
+	return newPeriodChooserWithSeed(period, eps, epsFraction, randomSeed())
 }
 
-func newPeriodChooser(period, eps time.Duration) *periodChooser {
+// newPeriodChooserWithSeed is like newPeriodChooser, but randomizes periods
+// deterministically from the given seed.
+func newPeriodChooserWithSeed(period, eps time.Duration, epsFraction float64, seed int64) *periodChooser {
 	return &periodChooser{
-		period:  period,
-		epsilon: eps,
-		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		period:          period,
+		epsilon:         eps,
+		epsilonFraction: epsFraction,
+		rand:            rand.New(rand.NewSource(seed)),
 	}
 }
 
+// randomSeed returns a seed from crypto/rand, so that dispatchers started at
+// the same time don't choose the same periods. It falls back to the current
+// time if crypto/rand fails.
+func randomSeed() int64 {
+	var b [8]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
+
 func (pc *periodChooser) Choose() time.Duration {
+	return pc.chooseAround(pc.period)
+}
+
+// chooseAround randomizes the given period like Choose randomizes the
+// configured one.
+func (pc *periodChooser) chooseAround(period time.Duration) time.Duration {
+	epsilon := pc.epsilon
+	if pc.epsilonFraction > 0 {
+		epsilon = time.Duration(pc.epsilonFraction * float64(period))
+	}
 	var adj int64
-	if pc.epsilon > 0 {
-		adj = rand.Int63n(int64(2*pc.epsilon)) - int64(pc.epsilon)
+	if epsilon > 0 {
+		pc.mu.Lock()
+		adj = pc.rand.Int63n(int64(2*epsilon)) - int64(epsilon)
+		pc.mu.Unlock()
 	}
-	return pc.period + time.Duration(adj)
+	return period + time.Duration(adj)
 }
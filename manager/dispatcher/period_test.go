@@ -1,6 +1,7 @@
 package dispatcher
 
 import (
+	"math/rand"
 	"testing"
 	"time"
 )
@@ -8,7 +9,7 @@ import (
 func TestPeriodChooser(t *testing.T) {
 	period := 100 * time.Millisecond
 	epsilon := 50 * time.Millisecond
-	pc := newPeriodChooser(period, epsilon)
+	pc := newPeriodChooser(period, epsilon, 0)
 	for i := 0; i < 1024; i++ {
 		ttl := pc.Choose()
 		if ttl < period-epsilon {
@@ -18,3 +19,52 @@ func TestPeriodChooser(t *testing.T) {
 		}
 	}
 }
+
+func TestPeriodChooserEpsilonFraction(t *testing.T) {
+	for _, period := range []time.Duration{100 * time.Millisecond, time.Second, time.Minute} {
+		// the absolute epsilon is ignored in favor of the fraction
+		pc := newPeriodChooser(period, time.Millisecond, 0.25)
+		epsilon := period / 4
+		var maxAdj time.Duration
+		for i := 0; i < 1024; i++ {
+			ttl := pc.Choose()
+			if ttl < period-epsilon {
+				t.Fatalf("ttl elected below epsilon range for period %v: %v", period, ttl)
+			} else if ttl > period+epsilon {
+				t.Fatalf("ttl elected above epsilon range for period %v: %v", period, ttl)
+			}
+			adj := ttl - period
+			if adj < 0 {
+				adj = -adj
+			}
+			if adj > maxAdj {
+				maxAdj = adj
+			}
+		}
+		if maxAdj < epsilon/2 {
+			t.Fatalf("ttl range for period %v doesn't scale with the period: largest adjustment is %v", period, maxAdj)
+		}
+	}
+}
+
+func TestPeriodChooserSeed(t *testing.T) {
+	period := 100 * time.Millisecond
+	epsilon := 50 * time.Millisecond
+	seed := int64(42)
+
+	// the periods only depend on the seed, and are drawn from the chooser's
+	// own source, whatever else uses the global one
+	expected := rand.New(rand.NewSource(seed))
+	first := newPeriodChooserWithSeed(period, epsilon, 0, seed)
+	second := newPeriodChooserWithSeed(period, epsilon, 0, seed)
+	for i := 0; i < 100; i++ {
+		ttl := first.Choose()
+		if want := period + time.Duration(expected.Int63n(int64(2*epsilon))-int64(epsilon)); ttl != want {
+			t.Fatalf("period %d is %v instead of %v", i, ttl, want)
+		}
+		rand.Int63()
+		if other := second.Choose(); other != ttl {
+			t.Fatalf("period %d isn't reproducible: %v and %v", i, ttl, other)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package dispatcher
+
+import (
+	"sync"
+	"time"
+)
+
+// maxQuarantineBackoff caps how long a flapping node is kept out, however
+// many times it was quarantined.
+const maxQuarantineBackoff = 10 * time.Minute
+
+// quarantine keeps nodes that repeatedly register and then fail their
+// heartbeats from registering again right away, so that a flapping node
+// doesn't cause churn. Once a node has timed out threshold times within the
+// window, it is quarantined for backoff, and for twice as long each further
+// time it is quarantined, until it stays up for a whole window.
+type quarantine struct {
+	mu        sync.Mutex
+	threshold int
+	window    time.Duration
+	backoff   time.Duration
+	nodes     map[string]*quarantinedNode
+}
+
+type quarantinedNode struct {
+	// timeouts holds the times at which the node's heartbeats timed out
+	// during the last window, oldest first
+	timeouts []time.Time
+	// quarantines is how many times the node was quarantined without
+	// staying up for a whole window in between
+	quarantines int
+	until       time.Time
+}
+
+func newQuarantine(threshold int, window, backoff time.Duration) *quarantine {
+	return &quarantine{
+		threshold: threshold,
+		window:    window,
+		backoff:   backoff,
+		nodes:     make(map[string]*quarantinedNode),
+	}
+}
+
+// RecordTimeout records that the node's heartbeats timed out at now, and
+// quarantines it if that happened too often.
+func (q *quarantine) RecordTimeout(nodeID string, now time.Time) {
+	if q.threshold <= 0 {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := q.node(nodeID, now)
+	cutoff := now.Add(-q.window)
+	expired := 0
+	for expired < len(n.timeouts) && !n.timeouts[expired].After(cutoff) {
+		expired++
+	}
+	n.timeouts = append(n.timeouts[expired:], now)
+	if len(n.timeouts) < q.threshold {
+		return
+	}
+
+	backoff := q.backoff
+	for i := 0; i < n.quarantines && backoff < maxQuarantineBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxQuarantineBackoff {
+		backoff = maxQuarantineBackoff
+	}
+	n.quarantines++
+	n.until = now.Add(backoff)
+	n.timeouts = nil
+}
+
+// Remaining returns how much longer the node is quarantined for at now, or
+// zero if it may register.
+func (q *quarantine) Remaining(nodeID string, now time.Time) time.Duration {
+	if q.threshold <= 0 {
+		return 0
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n, ok := q.nodes[nodeID]
+	if !ok || !now.Before(n.until) {
+		return 0
+	}
+	return n.until.Sub(now)
+}
+
+// node returns the quarantine state of a node, which is forgotten once the
+// node has stayed up for a whole window after its last quarantine and
+// timeout. It expects the quarantine to be locked.
+func (q *quarantine) node(nodeID string, now time.Time) *quarantinedNode {
+	n, ok := q.nodes[nodeID]
+	if ok {
+		last := n.until
+		if len(n.timeouts) > 0 && n.timeouts[len(n.timeouts)-1].After(last) {
+			last = n.timeouts[len(n.timeouts)-1]
+		}
+		if now.Sub(last) > q.window {
+			ok = false
+		}
+	}
+	if !ok {
+		n = &quarantinedNode{}
+		q.nodes[nodeID] = n
+	}
+	return n
+}
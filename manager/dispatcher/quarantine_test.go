@@ -0,0 +1,59 @@
+package dispatcher
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuarantine(t *testing.T) {
+	window := time.Minute
+	backoff := time.Second
+	q := newQuarantine(3, window, backoff)
+
+	now := time.Now()
+	flap := func() {
+		for i := 0; i < 3; i++ {
+			now = now.Add(time.Second)
+			q.RecordTimeout("node", now)
+		}
+	}
+
+	// timing out fewer times than the threshold doesn't quarantine a node
+	q.RecordTimeout("other", now)
+	q.RecordTimeout("other", now.Add(time.Second))
+	if remaining := q.Remaining("other", now.Add(time.Second)); remaining != 0 {
+		t.Fatalf("node was quarantined below the threshold: %v", remaining)
+	}
+
+	// each time it keeps flapping, the node is quarantined for longer
+	var last time.Duration
+	for i := 0; i < 3; i++ {
+		flap()
+		remaining := q.Remaining("node", now)
+		if remaining <= last {
+			t.Fatalf("quarantine %d of %v isn't longer than the previous one of %v", i, remaining, last)
+		}
+		now = now.Add(remaining)
+		if remaining := q.Remaining("node", now); remaining != 0 {
+			t.Fatalf("node was still quarantined after its backoff: %v", remaining)
+		}
+		last = remaining
+	}
+	if last != 4*backoff {
+		t.Fatalf("the backoff didn't double each time: %v", last)
+	}
+
+	// once the node stays up for a whole window, it starts over
+	now = now.Add(2 * window)
+	flap()
+	if remaining := q.Remaining("node", now); remaining != backoff {
+		t.Fatalf("the backoff didn't reset: %v", remaining)
+	}
+
+	// a zero threshold disables quarantine
+	q = newQuarantine(0, window, backoff)
+	flap()
+	if remaining := q.Remaining("node", now); remaining != 0 {
+		t.Fatalf("node was quarantined with quarantine disabled: %v", remaining)
+	}
+}
@@ -0,0 +1,44 @@
+package dispatcher
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// agentVersion is a MAJOR.MINOR.PATCH version reported by an agent.
+type agentVersion [3]int
+
+// parseAgentVersion parses a version such as "1.2.3", "v1.2" or
+// "1.2.3-rc1+build". Missing minor and patch numbers are zero, and
+// pre-release and build suffixes are ignored.
+func parseAgentVersion(s string) (agentVersion, error) {
+	var v agentVersion
+	trimmed := strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	parts := strings.Split(trimmed, ".")
+	if len(parts) > len(v) {
+		return agentVersion{}, errors.Errorf("invalid version %q", s)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return agentVersion{}, errors.Errorf("invalid version %q", s)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+// less returns whether v is older than other.
+func (v agentVersion) less(other agentVersion) bool {
+	for i := range v {
+		if v[i] != other[i] {
+			return v[i] < other[i]
+		}
+	}
+	return false
+}
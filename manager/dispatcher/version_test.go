@@ -0,0 +1,31 @@
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAgentVersion(t *testing.T) {
+	for s, expected := range map[string]agentVersion{
+		"1.2.3":          {1, 2, 3},
+		"v1.2":           {1, 2, 0},
+		"2":              {2, 0, 0},
+		"1.13.0-rc1":     {1, 13, 0},
+		"17.06.1+build5": {17, 6, 1},
+	} {
+		v, err := parseAgentVersion(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, v, s)
+	}
+
+	for _, s := range []string{"", "v", "1.2.3.4", "1.x", "-1.0", "cba102b+unknown"} {
+		_, err := parseAgentVersion(s)
+		assert.Error(t, err, s)
+	}
+
+	assert.True(t, agentVersion{1, 2, 3}.less(agentVersion{1, 10, 0}))
+	assert.True(t, agentVersion{1, 2, 3}.less(agentVersion{1, 2, 4}))
+	assert.False(t, agentVersion{1, 2, 3}.less(agentVersion{1, 2, 3}))
+	assert.False(t, agentVersion{2, 0, 0}.less(agentVersion{1, 99, 99}))
+}
@@ -49,6 +49,10 @@ import (
 const (
 	// defaultTaskHistoryRetentionLimit is the number of tasks to keep.
 	defaultTaskHistoryRetentionLimit = 5
+
+	// rootCAExpiryCheckInterval is how often the manager checks whether the
+	// root CA certificate expires soon.
+	rootCAExpiryCheckInterval = time.Hour
 )
 
 // RemoteAddrs provides a listening address and an optional advertise address
@@ -111,6 +115,11 @@ type Config struct {
 
 	// PluginGetter provides access to docker's plugin inventory.
 	PluginGetter plugingetter.PluginGetter
+
+	// RootCAExpiryWarningThreshold is how long before the root CA
+	// certificate expires the manager starts warning about it. Zero means
+	// ca.DefaultRootCAExpiryWarningThreshold.
+	RootCAExpiryWarningThreshold time.Duration
 }
 
 // Manager is the cluster manager for Swarm.
@@ -366,6 +375,16 @@ func (m *Manager) Run(parent context.Context) error {
 
 	go m.handleLeadershipEvents(ctx, leadershipCh)
 
+	rootCAExpiryWarningThreshold := m.config.RootCAExpiryWarningThreshold
+	if rootCAExpiryWarningThreshold == 0 {
+		rootCAExpiryWarningThreshold = ca.DefaultRootCAExpiryWarningThreshold
+	}
+	go func() {
+		// the warnings are already logged
+		for range ca.MonitorRootCAExpiry(ctx, m.config.SecurityConfig, rootCAExpiryWarningThreshold, rootCAExpiryCheckInterval) {
+		}
+	}()
+
 	authorize := func(ctx context.Context, roles []string) error {
 		var (
 			blacklistedCerts map[string]*api.BlacklistedCertificate
@@ -571,6 +590,9 @@ func (m *Manager) Stop(ctx context.Context, clearData bool) {
 
 	m.raftNode.Cancel()
 
+	// Stop follows right away, so agents wouldn't get another heartbeat
+	// telling them to reconnect elsewhere; close their sessions instead.
+	m.dispatcher.Drain()
 	m.dispatcher.Stop()
 	m.logbroker.Stop()
 	m.caserver.Stop()
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/net/context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/docker/swarmkit/api"
@@ -108,6 +109,13 @@ func TestManager(t *testing.T) {
 	_, err = client.Heartbeat(context.Background(), &api.HeartbeatRequest{})
 	assert.Contains(t, grpc.ErrorDesc(err), "Permission denied: unauthorized peer role: rpc error: code = 7 desc = Permission denied: remote certificate not part of organization")
 
+	// a node of another cluster can't register either, and is told which cluster it reached
+	stream, err := client.Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), "not part of organization: "+tc.Organization+", it belongs to organization another-org")
+
 	// Verify that requests to the various GRPC services running on TCP
 	// are rejected if they don't have certs.
 	opts = []grpc.DialOption{
@@ -502,6 +510,27 @@ func TestManagerUpdatesSecurityConfig(t *testing.T) {
 		return nil
 	}, 1*time.Second))
 
+	// Rotate the root key material in the store, and the manager should switch to signing with the new key
+	rotatedCert, rotatedKey, err := testutils.CreateRootCertAndKey("rootRotated")
+	require.NoError(t, err)
+	require.NoError(t, m.raftNode.MemoryStore().Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, clusters[0].ID)
+		cluster.RootCA.CACert = rotatedCert
+		cluster.RootCA.CAKey = rotatedKey
+		return store.UpdateCluster(tx, cluster)
+	}))
+
+	require.NoError(t, raftutils.PollFuncWithTimeout(nil, func() error {
+		signer, err := managerSecurityConfig.RootCA().Signer()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(signer.Key, rotatedKey) {
+			return fmt.Errorf("signer not updated yet")
+		}
+		return nil
+	}, 1*time.Second))
+
 	m.Stop(ctx, false)
 
 	// After stopping we should MAY receive an error from ListenAndServe if